@@ -0,0 +1,16 @@
+package log_test
+
+import (
+	"testing"
+
+	log "github.com/echocat/slf4g"
+	"github.com/echocat/slf4g/testing/discard"
+)
+
+func BenchmarkFallback(b *testing.B) {
+	discard.Benchmark(b, log.NewDiscardingFallbackProvider())
+}
+
+func BenchmarkDiscard(b *testing.B) {
+	discard.Benchmark(b, discard.NewProvider())
+}
@@ -0,0 +1,25 @@
+// Package grpclog provides methods to use slf4g as the backend of the logging
+// of google.golang.org/grpc ([google.golang.org/grpc/grpclog.LoggerV2]).
+//
+// It lives in its own Go module to keep the core of slf4g free of any
+// dependencies.
+//
+// # Usage
+//
+// Configure gRPC to log everything to the slf4g logger named
+// [DefaultLoggerName]:
+//
+//	grpclog.Configure()
+//
+// As the info logs of gRPC are very noisy, they are logged by default with
+// [github.com/echocat/slf4g/level.Debug]. This can be changed using
+// [LevelMapperImpl]:
+//
+//	grpclog.Configure(func(l *grpclog.Logger) {
+//		l.LevelMapper = &grpclog.LevelMapperImpl{Info: level.Info}
+//	})
+//
+// Remember that [google.golang.org/grpc/grpclog.SetLoggerV2] (and so
+// [Configure]) is not thread-safe and should be called before any gRPC
+// functions.
+package grpclog
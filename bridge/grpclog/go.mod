@@ -0,0 +1,10 @@
+module github.com/echocat/slf4g/bridge/grpclog
+
+go 1.23.0
+
+replace github.com/echocat/slf4g => ../../
+
+require (
+	github.com/echocat/slf4g v0.0.0
+	google.golang.org/grpc v1.72.0
+)
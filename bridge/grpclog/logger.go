@@ -0,0 +1,242 @@
+package grpclog
+
+import (
+	"fmt"
+	"os"
+	"strings"
+
+	"google.golang.org/grpc/grpclog"
+
+	log "github.com/echocat/slf4g"
+)
+
+// DefaultLoggerName is the name of the logger of slf4g which will be used if
+// Logger.Delegate is not set.
+const DefaultLoggerName = "google.golang.org/grpc"
+
+// NewLogger creates a new instance of Logger which forwards everything to the
+// given target.
+func NewLogger(target log.CoreLogger, customizer ...func(*Logger)) *Logger {
+	result := &Logger{
+		Delegate: target,
+	}
+
+	for _, c := range customizer {
+		c(result)
+	}
+
+	return result
+}
+
+// Logger is an implementation of grpclog.LoggerV2 and grpclog.DepthLoggerV2
+// which forwards all of its entries to a log.CoreLogger of slf4g.
+type Logger struct {
+	// Delegate is the log.CoreLogger of the slf4g framework where to forward
+	// all logged entries of this implementation to.
+	//
+	// If empty the result of log.GetLogger(DefaultLoggerName) will be used.
+	Delegate log.CoreLogger
+
+	// LevelMapper holds the mapper which is used to transform the severities
+	// and verbosities of gRPC to level.Level.
+	//
+	// If empty DefaultLevelMapper will be used.
+	LevelMapper LevelMapper
+
+	// OnFatal defines what happens when someone calls one of the
+	// Logger.Fatal(), Logger.Fatalf(), Logger.Fatalln() or Logger.FatalDepth()
+	// methods after the event was logged.
+	//
+	// If empty DefaultOnFatal will be used.
+	OnFatal func(log.Event)
+}
+
+// Info implements grpclog.LoggerV2.Info()
+func (instance *Logger) Info(args ...interface{}) {
+	instance.log(SeverityInfo, 1, sprint, "", args)
+}
+
+// Infoln implements grpclog.LoggerV2.Infoln()
+func (instance *Logger) Infoln(args ...interface{}) {
+	instance.log(SeverityInfo, 1, sprintln, "", args)
+}
+
+// Infof implements grpclog.LoggerV2.Infof()
+func (instance *Logger) Infof(format string, args ...interface{}) {
+	instance.log(SeverityInfo, 1, fmt.Sprintf, format, args)
+}
+
+// InfoDepth implements grpclog.DepthLoggerV2.InfoDepth()
+func (instance *Logger) InfoDepth(depth int, args ...interface{}) {
+	instance.log(SeverityInfo, uint16(depth)+1, sprintln, "", args)
+}
+
+// Warning implements grpclog.LoggerV2.Warning()
+func (instance *Logger) Warning(args ...interface{}) {
+	instance.log(SeverityWarning, 1, sprint, "", args)
+}
+
+// Warningln implements grpclog.LoggerV2.Warningln()
+func (instance *Logger) Warningln(args ...interface{}) {
+	instance.log(SeverityWarning, 1, sprintln, "", args)
+}
+
+// Warningf implements grpclog.LoggerV2.Warningf()
+func (instance *Logger) Warningf(format string, args ...interface{}) {
+	instance.log(SeverityWarning, 1, fmt.Sprintf, format, args)
+}
+
+// WarningDepth implements grpclog.DepthLoggerV2.WarningDepth()
+func (instance *Logger) WarningDepth(depth int, args ...interface{}) {
+	instance.log(SeverityWarning, uint16(depth)+1, sprintln, "", args)
+}
+
+// Error implements grpclog.LoggerV2.Error()
+func (instance *Logger) Error(args ...interface{}) {
+	instance.log(SeverityError, 1, sprint, "", args)
+}
+
+// Errorln implements grpclog.LoggerV2.Errorln()
+func (instance *Logger) Errorln(args ...interface{}) {
+	instance.log(SeverityError, 1, sprintln, "", args)
+}
+
+// Errorf implements grpclog.LoggerV2.Errorf()
+func (instance *Logger) Errorf(format string, args ...interface{}) {
+	instance.log(SeverityError, 1, fmt.Sprintf, format, args)
+}
+
+// ErrorDepth implements grpclog.DepthLoggerV2.ErrorDepth()
+func (instance *Logger) ErrorDepth(depth int, args ...interface{}) {
+	instance.log(SeverityError, uint16(depth)+1, sprintln, "", args)
+}
+
+// Fatal implements grpclog.LoggerV2.Fatal()
+//
+// Like required by the contract of grpclog.LoggerV2 this will call
+// Logger.OnFatal afterwards, which calls os.Exit(1) by default.
+func (instance *Logger) Fatal(args ...interface{}) {
+	e := instance.log(SeverityFatal, 1, sprint, "", args)
+	instance.getOnFatal()(e)
+}
+
+// Fatalln implements grpclog.LoggerV2.Fatalln()
+//
+// Like required by the contract of grpclog.LoggerV2 this will call
+// Logger.OnFatal afterwards, which calls os.Exit(1) by default.
+func (instance *Logger) Fatalln(args ...interface{}) {
+	e := instance.log(SeverityFatal, 1, sprintln, "", args)
+	instance.getOnFatal()(e)
+}
+
+// Fatalf implements grpclog.LoggerV2.Fatalf()
+//
+// Like required by the contract of grpclog.LoggerV2 this will call
+// Logger.OnFatal afterwards, which calls os.Exit(1) by default.
+func (instance *Logger) Fatalf(format string, args ...interface{}) {
+	e := instance.log(SeverityFatal, 1, fmt.Sprintf, format, args)
+	instance.getOnFatal()(e)
+}
+
+// FatalDepth implements grpclog.DepthLoggerV2.FatalDepth()
+//
+// Like required by the contract of grpclog.LoggerV2 this will call
+// Logger.OnFatal afterwards, which calls os.Exit(1) by default.
+func (instance *Logger) FatalDepth(depth int, args ...interface{}) {
+	e := instance.log(SeverityFatal, uint16(depth)+1, sprintln, "", args)
+	instance.getOnFatal()(e)
+}
+
+// V implements grpclog.LoggerV2.V()
+func (instance *Logger) V(verbosity int) bool {
+	l, err := instance.getLevelMapper().FromVerbosity(verbosity)
+	if err != nil {
+		return false
+	}
+	return instance.getDelegate().IsLevelEnabled(l)
+}
+
+// log only formats the message if the level is enabled; which is important
+// as gRPC is quite noisy. Fatal events are always created, because they are
+// required by Logger.OnFatal.
+func (instance *Logger) log(severity Severity, skipFrames uint16, format func(string, ...interface{}) string, template string, args []interface{}) log.Event {
+	delegate := instance.getDelegate()
+	helperOf(delegate)()
+
+	l, err := instance.getLevelMapper().FromSeverity(severity)
+	if err != nil {
+		return nil
+	}
+
+	enabled := delegate.IsLevelEnabled(l)
+	if !enabled && severity != SeverityFatal {
+		return nil
+	}
+
+	e := delegate.NewEvent(l, map[string]interface{}{
+		delegate.GetProvider().GetFieldKeysSpec().GetMessage(): format(template, args...),
+	})
+	if enabled {
+		delegate.Log(e, skipFrames+1)
+	}
+	return e
+}
+
+func (instance *Logger) getDelegate() log.CoreLogger {
+	if v := instance.Delegate; v != nil {
+		return v
+	}
+	return log.GetLogger(DefaultLoggerName)
+}
+
+func (instance *Logger) getLevelMapper() LevelMapper {
+	if v := instance.LevelMapper; v != nil {
+		return v
+	}
+	return DefaultLevelMapper
+}
+
+func (instance *Logger) getOnFatal() func(log.Event) {
+	if v := instance.OnFatal; v != nil {
+		return v
+	}
+	return DefaultOnFatal
+}
+
+func sprint(_ string, args ...interface{}) string {
+	return fmt.Sprint(args...)
+}
+
+func sprintln(_ string, args ...interface{}) string {
+	return strings.TrimSuffix(fmt.Sprintln(args...), "\n")
+}
+
+func helperOf(instance log.CoreLogger) func() {
+	if wh, ok := instance.(interface {
+		Helper() func()
+	}); ok {
+		return wh.Helper()
+	}
+	return func() {}
+}
+
+// DefaultOnFatal defines what happens by default when someone calls one of the
+// Logger.Fatal(), Logger.Fatalf(), Logger.Fatalln() or Logger.FatalDepth()
+// methods. The initial behavior will be that it exit with error code 1 after
+// logging the event, like required by the contract of grpclog.LoggerV2.
+var DefaultOnFatal = func(log.Event) {
+	os.Exit(1)
+}
+
+// Configure configures gRPC to use slf4g with the logger named
+// DefaultLoggerName.
+func Configure(customizer ...func(*Logger)) {
+	ConfigureWith(nil, customizer...)
+}
+
+// ConfigureWith configures gRPC to use slf4g with the given target logger.
+// If the target logger is nil, the result of log.GetLogger(DefaultLoggerName)
+// will be used.
+func ConfigureWith(target log.CoreLogger, customizer ...func(*Logger)) {
+	grpclog.SetLoggerV2(NewLogger(target, customizer...))
+}
@@ -0,0 +1,170 @@
+package grpclog
+
+import (
+	"runtime"
+	"testing"
+
+	"google.golang.org/grpc/grpclog"
+
+	log "github.com/echocat/slf4g"
+	"github.com/echocat/slf4g/internal/test/assert"
+	"github.com/echocat/slf4g/level"
+	"github.com/echocat/slf4g/testing/recording"
+)
+
+func TestNewLogger(t *testing.T) {
+	aLogger := recording.NewCoreLogger()
+	aLevelMapper := &LevelMapperImpl{}
+
+	actual := NewLogger(aLogger, func(v *Logger) {
+		v.LevelMapper = aLevelMapper
+	})
+
+	assert.ToBeNotNil(t, actual)
+	assert.ToBeSame(t, aLogger, actual.Delegate)
+	assert.ToBeSame(t, aLevelMapper, actual.LevelMapper)
+}
+
+func TestLogger_logging(t *testing.T) {
+	cases := []struct {
+		name            string
+		call            func(grpclog.DepthLoggerV2)
+		expectedLevel   level.Level
+		expectedMessage string
+	}{
+		{"Info", func(l grpclog.DepthLoggerV2) { l.Info("a", 1, 2, "b") }, level.Debug, "a1 2b"},
+		{"Infoln", func(l grpclog.DepthLoggerV2) { l.Infoln("a", 1, 2, "b") }, level.Debug, "a 1 2 b"},
+		{"Infof", func(l grpclog.DepthLoggerV2) { l.Infof("a%d", 1) }, level.Debug, "a1"},
+		{"InfoDepth", func(l grpclog.DepthLoggerV2) { l.InfoDepth(0, "a", 1) }, level.Debug, "a 1"},
+		{"Warning", func(l grpclog.DepthLoggerV2) { l.Warning("a", 1) }, level.Warn, "a1"},
+		{"Warningln", func(l grpclog.DepthLoggerV2) { l.Warningln("a", 1) }, level.Warn, "a 1"},
+		{"Warningf", func(l grpclog.DepthLoggerV2) { l.Warningf("a%d", 1) }, level.Warn, "a1"},
+		{"WarningDepth", func(l grpclog.DepthLoggerV2) { l.WarningDepth(0, "a", 1) }, level.Warn, "a 1"},
+		{"Error", func(l grpclog.DepthLoggerV2) { l.Error("a", 1) }, level.Error, "a1"},
+		{"Errorln", func(l grpclog.DepthLoggerV2) { l.Errorln("a", 1) }, level.Error, "a 1"},
+		{"Errorf", func(l grpclog.DepthLoggerV2) { l.Errorf("a%d", 1) }, level.Error, "a1"},
+		{"ErrorDepth", func(l grpclog.DepthLoggerV2) { l.ErrorDepth(0, "a", 1) }, level.Error, "a 1"},
+		{"Fatal", func(l grpclog.DepthLoggerV2) { l.Fatal("a", 1) }, level.Fatal, "a1"},
+		{"Fatalln", func(l grpclog.DepthLoggerV2) { l.Fatalln("a", 1) }, level.Fatal, "a 1"},
+		{"Fatalf", func(l grpclog.DepthLoggerV2) { l.Fatalf("a%d", 1) }, level.Fatal, "a1"},
+		{"FatalDepth", func(l grpclog.DepthLoggerV2) { l.FatalDepth(0, "a", 1) }, level.Fatal, "a 1"},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			aLogger := recording.NewCoreLogger()
+			aLogger.SetLevel(level.Debug)
+			var fatalEvent log.Event
+
+			instance := &Logger{
+				Delegate: aLogger,
+				OnFatal: func(e log.Event) {
+					fatalEvent = e
+				},
+			}
+
+			c.call(instance)
+
+			assert.ToBeEqual(t, 1, aLogger.Len())
+			actual := aLogger.Get(0)
+			assert.ToBeEqual(t, c.expectedLevel, actual.GetLevel())
+			assert.ToBeEqual(t, c.expectedMessage, *log.GetMessageOf(actual, aLogger.GetProvider()))
+			if c.expectedLevel == level.Fatal {
+				assert.ToBeNotNil(t, fatalEvent)
+			} else {
+				assert.ToBeNil(t, fatalEvent)
+			}
+		})
+	}
+}
+
+func TestLogger_logging_disabled(t *testing.T) {
+	aLogger := recording.NewCoreLogger()
+	aLogger.SetLevel(level.Info)
+
+	instance := &Logger{Delegate: aLogger}
+	instance.Info("ignored")
+	instance.Warning("aMessage")
+
+	assert.ToBeEqual(t, 1, aLogger.Len())
+	assert.ToBeEqual(t, level.Warn, aLogger.Get(0).GetLevel())
+}
+
+func TestLogger_logging_disabledDoesNotFormat(t *testing.T) {
+	aLogger := recording.NewCoreLogger()
+	aLogger.SetLevel(level.Info)
+	given := &countingStringer{}
+	var fatalEvent log.Event
+	instance := &Logger{Delegate: aLogger, OnFatal: func(e log.Event) {
+		fatalEvent = e
+	}}
+
+	instance.Info(given)
+	instance.Infoln(given)
+	instance.Infof("%v", given)
+	instance.InfoDepth(0, given)
+
+	assert.ToBeEqual(t, 0, given.calls)
+	assert.ToBeEqual(t, 0, aLogger.Len())
+
+	aLogger.SetLevel(level.Level(^uint16(0)))
+	instance.Fatal(given)
+
+	assert.ToBeEqual(t, 1, given.calls)
+	assert.ToBeNotNil(t, fatalEvent)
+	assert.ToBeEqual(t, 0, aLogger.Len())
+}
+
+type countingStringer struct {
+	calls int
+}
+
+func (instance *countingStringer) String() string {
+	instance.calls++
+	return "foo"
+}
+
+func TestLogger_V(t *testing.T) {
+	aLogger := recording.NewCoreLogger()
+	aLogger.SetLevel(level.Debug)
+
+	instance := &Logger{Delegate: aLogger}
+
+	assert.ToBeEqual(t, true, instance.V(0))
+	assert.ToBeEqual(t, false, instance.V(1))
+	assert.ToBeEqual(t, false, instance.V(-1))
+}
+
+func TestLogger_skipFrames(t *testing.T) {
+	aLogger := &callerRecordingCoreLogger{CoreLogger: recording.NewCoreLogger()}
+	instance := &Logger{Delegate: aLogger, LevelMapper: &LevelMapperImpl{Info: level.Info}}
+
+	instance.Info("foo")
+	assert.ToBeEqual(t, "github.com/echocat/slf4g/bridge/grpclog.TestLogger_skipFrames", aLogger.caller)
+
+	func() {
+		instance.InfoDepth(1, "foo")
+	}()
+	assert.ToBeEqual(t, "github.com/echocat/slf4g/bridge/grpclog.TestLogger_skipFrames", aLogger.caller)
+}
+
+func TestConfigureWith(t *testing.T) {
+	aLogger := recording.NewCoreLogger()
+
+	ConfigureWith(aLogger)
+	grpclog.Warning("aMessage")
+
+	assert.ToBeEqual(t, 1, aLogger.Len())
+	assert.ToBeEqual(t, level.Warn, aLogger.Get(0).GetLevel())
+}
+
+type callerRecordingCoreLogger struct {
+	*recording.CoreLogger
+	caller string
+}
+
+func (instance *callerRecordingCoreLogger) Log(_ log.Event, skipFrames uint16) {
+	if pc, _, _, ok := runtime.Caller(int(skipFrames) + 1); ok {
+		instance.caller = runtime.FuncForPC(pc).Name()
+	}
+}
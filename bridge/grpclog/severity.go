@@ -0,0 +1,131 @@
+package grpclog
+
+import (
+	"fmt"
+
+	"github.com/echocat/slf4g/level"
+)
+
+// Severity represents the severity of a log entry in gRPC. This is modelled
+// after the methods of grpclog.LoggerV2.
+type Severity uint8
+
+const (
+	// SeverityInfo represents grpclog.LoggerV2.Info()
+	SeverityInfo Severity = iota
+	// SeverityWarning represents grpclog.LoggerV2.Warning()
+	SeverityWarning
+	// SeverityError represents grpclog.LoggerV2.Error()
+	SeverityError
+	// SeverityFatal represents grpclog.LoggerV2.Fatal()
+	SeverityFatal
+)
+
+// String prints a human-readable version of this Severity.
+func (instance Severity) String() string {
+	switch instance {
+	case SeverityInfo:
+		return "INFO"
+	case SeverityWarning:
+		return "WARNING"
+	case SeverityError:
+		return "ERROR"
+	case SeverityFatal:
+		return "FATAL"
+	default:
+		return fmt.Sprintf("UNKNOWN(%d)", instance)
+	}
+}
+
+// LevelMapper transforms the Severity and the verbosity of gRPC into
+// level.Level.
+type LevelMapper interface {
+	// FromSeverity maps the given Severity to level.Level.
+	FromSeverity(Severity) (level.Level, error)
+
+	// FromVerbosity maps the given verbosity (see grpclog.LoggerV2.V()) to
+	// level.Level.
+	FromVerbosity(int) (level.Level, error)
+}
+
+// DefaultLevelMapper is the default instance of LevelMapper which should cover
+// the most of the cases.
+var DefaultLevelMapper LevelMapper = &LevelMapperImpl{}
+
+// LevelMapperImpl is the default implementation of LevelMapper. Each of its
+// fields defines to which level.Level the corresponding Severity is mapped.
+type LevelMapperImpl struct {
+	// Info defines the level.Level for SeverityInfo (and verbosity 0).
+	// If empty level.Debug will be used, as gRPC is very noisy on info.
+	Info level.Level
+
+	// Warning defines the level.Level for SeverityWarning.
+	// If empty level.Warn will be used.
+	Warning level.Level
+
+	// Error defines the level.Level for SeverityError.
+	// If empty level.Error will be used.
+	Error level.Level
+
+	// Fatal defines the level.Level for SeverityFatal.
+	// If empty level.Fatal will be used.
+	Fatal level.Level
+
+	// Verbose defines the level.Level for every verbosity above 0.
+	// If empty level.Trace will be used.
+	Verbose level.Level
+}
+
+// FromSeverity implements LevelMapper.FromSeverity()
+func (instance *LevelMapperImpl) FromSeverity(v Severity) (level.Level, error) {
+	switch v {
+	case SeverityInfo:
+		return orDefault(instance.Info, level.Debug), nil
+	case SeverityWarning:
+		return orDefault(instance.Warning, level.Warn), nil
+	case SeverityError:
+		return orDefault(instance.Error, level.Error), nil
+	case SeverityFatal:
+		return orDefault(instance.Fatal, level.Fatal), nil
+	default:
+		return 0, fmt.Errorf("unknown gRPC severity: %d", v)
+	}
+}
+
+// FromVerbosity implements LevelMapper.FromVerbosity()
+func (instance *LevelMapperImpl) FromVerbosity(v int) (level.Level, error) {
+	switch {
+	case v < 0:
+		return 0, fmt.Errorf("illegal verbosity: %d", v)
+	case v == 0:
+		return instance.FromSeverity(SeverityInfo)
+	default:
+		return orDefault(instance.Verbose, level.Trace), nil
+	}
+}
+
+func orDefault(v, def level.Level) level.Level {
+	if v != 0 {
+		return v
+	}
+	return def
+}
+
+// NewLevelMapperFacade creates a facade of LevelMapper using the given provider.
+func NewLevelMapperFacade(provider func() LevelMapper) LevelMapper {
+	return levelMapperFacade(provider)
+}
+
+type levelMapperFacade func() LevelMapper
+
+func (instance levelMapperFacade) FromSeverity(v Severity) (level.Level, error) {
+	return instance.Unwrap().FromSeverity(v)
+}
+
+func (instance levelMapperFacade) FromVerbosity(v int) (level.Level, error) {
+	return instance.Unwrap().FromVerbosity(v)
+}
+
+func (instance levelMapperFacade) Unwrap() LevelMapper {
+	return instance()
+}
@@ -0,0 +1,96 @@
+package grpclog
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/echocat/slf4g/internal/test/assert"
+	"github.com/echocat/slf4g/level"
+)
+
+func TestSeverity_String(t *testing.T) {
+	assert.ToBeEqual(t, "INFO", SeverityInfo.String())
+	assert.ToBeEqual(t, "WARNING", SeverityWarning.String())
+	assert.ToBeEqual(t, "ERROR", SeverityError.String())
+	assert.ToBeEqual(t, "FATAL", SeverityFatal.String())
+	assert.ToBeEqual(t, "UNKNOWN(66)", Severity(66).String())
+}
+
+func TestLevelMapperImpl_FromSeverity(t *testing.T) {
+	cases := []struct {
+		instance    *LevelMapperImpl
+		input       Severity
+		expected    level.Level
+		expectedErr string
+	}{
+		{&LevelMapperImpl{}, SeverityInfo, level.Debug, ""},
+		{&LevelMapperImpl{}, SeverityWarning, level.Warn, ""},
+		{&LevelMapperImpl{}, SeverityError, level.Error, ""},
+		{&LevelMapperImpl{}, SeverityFatal, level.Fatal, ""},
+		{&LevelMapperImpl{}, 66, 0, "unknown gRPC severity: 66"},
+		{&LevelMapperImpl{Info: level.Info}, SeverityInfo, level.Info, ""},
+		{&LevelMapperImpl{Warning: level.Info}, SeverityWarning, level.Info, ""},
+		{&LevelMapperImpl{Error: level.Warn}, SeverityError, level.Warn, ""},
+		{&LevelMapperImpl{Fatal: level.Error}, SeverityFatal, level.Error, ""},
+	}
+
+	for _, c := range cases {
+		t.Run(fmt.Sprintf("%v-%v", *c.instance, c.input), func(t *testing.T) {
+			actual, actualErr := c.instance.FromSeverity(c.input)
+			if c.expectedErr == "" {
+				assert.ToBeNoError(t, actualErr)
+				assert.ToBeEqual(t, c.expected, actual)
+			} else {
+				assert.ToBeMatching(t, c.expectedErr, actualErr)
+				assert.ToBeEqual(t, level.Level(0), actual)
+			}
+		})
+	}
+}
+
+func TestLevelMapperImpl_FromVerbosity(t *testing.T) {
+	cases := []struct {
+		instance    *LevelMapperImpl
+		input       int
+		expected    level.Level
+		expectedErr string
+	}{
+		{&LevelMapperImpl{}, 0, level.Debug, ""},
+		{&LevelMapperImpl{}, 1, level.Trace, ""},
+		{&LevelMapperImpl{}, 5, level.Trace, ""},
+		{&LevelMapperImpl{}, -1, 0, "illegal verbosity: -1"},
+		{&LevelMapperImpl{Info: level.Info}, 0, level.Info, ""},
+		{&LevelMapperImpl{Verbose: level.Debug}, 2, level.Debug, ""},
+	}
+
+	for _, c := range cases {
+		t.Run(fmt.Sprintf("%v-%v", *c.instance, c.input), func(t *testing.T) {
+			actual, actualErr := c.instance.FromVerbosity(c.input)
+			if c.expectedErr == "" {
+				assert.ToBeNoError(t, actualErr)
+				assert.ToBeEqual(t, c.expected, actual)
+			} else {
+				assert.ToBeMatching(t, c.expectedErr, actualErr)
+				assert.ToBeEqual(t, level.Level(0), actual)
+			}
+		})
+	}
+}
+
+func TestNewLevelMapperFacade(t *testing.T) {
+	givenMapper := &LevelMapperImpl{Info: level.Info}
+
+	actual := NewLevelMapperFacade(func() LevelMapper {
+		return givenMapper
+	})
+
+	assert.ToBeSame(t, givenMapper, actual.(levelMapperFacade).Unwrap())
+
+	actualLevel, actualErr := actual.FromSeverity(SeverityInfo)
+	assert.ToBeNoError(t, actualErr)
+	assert.ToBeEqual(t, level.Info, actualLevel)
+
+	actualLevel, actualErr = actual.FromVerbosity(1)
+	assert.ToBeNoError(t, actualErr)
+	assert.ToBeEqual(t, level.Trace, actualLevel)
+}
@@ -0,0 +1,28 @@
+// Package logr provides methods to use slf4g together with
+// github.com/go-logr/logr ([github.com/go-logr/logr.Logger]) in both
+// directions:
+//
+//  1. [Sink] implements [github.com/go-logr/logr.LogSink] and forwards
+//     everything logged by a [github.com/go-logr/logr.Logger] to slf4g.
+//  2. [Provider] implements [github.com/echocat/slf4g.Provider] and forwards
+//     everything logged by slf4g to an existing
+//     [github.com/go-logr/logr.Logger].
+//
+// It lives in its own Go module to keep the core of slf4g free of any
+// dependencies.
+//
+// # Usage
+//
+// Create a [github.com/go-logr/logr.Logger] which forwards all of its entries
+// to the root logger of slf4g:
+//
+//	logger := logr.New(nil)
+//
+// ... or register a [Provider] which forwards everything logged by slf4g to an
+// existing [github.com/go-logr/logr.Logger]:
+//
+//	log.SetProvider(logr.NewProvider(existingLogger))
+//
+// The verbosity levels of logr are mapped to [github.com/echocat/slf4g/level.Level]
+// using a [VerbosityMapper]; see [DefaultVerbosityMapper].
+package logr
@@ -0,0 +1,13 @@
+package logr
+
+import (
+	"github.com/go-logr/logr"
+
+	log "github.com/echocat/slf4g"
+)
+
+// New creates an instance of logr.Logger using the given target logger.
+// If the target logger is nil, the result of log.GetRootLogger() will be used.
+func New(target log.CoreLogger, customizer ...func(*Sink)) logr.Logger {
+	return logr.New(NewSink(target, customizer...))
+}
@@ -0,0 +1,122 @@
+package logr
+
+import (
+	"github.com/go-logr/logr"
+
+	log "github.com/echocat/slf4g"
+	"github.com/echocat/slf4g/fields"
+	"github.com/echocat/slf4g/internal/events"
+	"github.com/echocat/slf4g/level"
+)
+
+// CoreLogger implements log.CoreLogger of the slf4g framework which forwards
+// all logged events into a logr.Logger.
+//
+// You cannot create a working instance of this by yourself. It can only be done
+// by the Provider instance.
+type CoreLogger struct {
+	provider *Provider
+	name     string
+	target   logr.Logger
+}
+
+// Log implements log.CoreLogger#Log()
+func (instance *CoreLogger) Log(event log.Event, skipFrames uint16) {
+	if event == nil {
+		return
+	}
+	l := event.GetLevel()
+	if !instance.IsLevelEnabled(l) {
+		return
+	}
+
+	spec := instance.provider.GetFieldKeysSpec()
+	var msg string
+	if v := log.GetMessageOf(event, instance.provider); v != nil {
+		msg = *v
+	}
+	err := log.GetErrorOf(event, instance.provider)
+	keysAndValues := instance.keysAndValuesOf(event, spec)
+
+	target := instance.target.WithCallDepth(int(skipFrames) + 1)
+	if l >= level.Error {
+		target.Error(err, msg, keysAndValues...)
+		return
+	}
+
+	verbosity, vErr := instance.provider.getVerbosityMapper().ToVerbosity(l)
+	if vErr != nil {
+		return
+	}
+	if err != nil {
+		keysAndValues = append(keysAndValues, spec.GetError(), err)
+	}
+	target.V(verbosity).Info(msg, keysAndValues...)
+}
+
+func (instance *CoreLogger) keysAndValuesOf(event log.Event, spec fields.KeysSpec) []interface{} {
+	result := make([]interface{}, 0, event.Len()*2)
+	_ = fields.SortedForEach(event, nil, func(k string, v interface{}) error {
+		switch k {
+		case spec.GetMessage(), spec.GetError(), spec.GetLogger(), spec.GetTimestamp():
+			return nil
+		}
+		if vf, ok := v.(fields.Filtered); ok {
+			fv, shouldBeRespected := vf.Filter(event)
+			if !shouldBeRespected {
+				return nil
+			}
+			v = fv
+		} else if vl, ok := v.(fields.Lazy); ok {
+			v = vl.Get()
+		}
+		if v == fields.Exclude {
+			return nil
+		}
+		result = append(result, k, v)
+		return nil
+	})
+	return result
+}
+
+// IsLevelEnabled implements log.CoreLogger#IsLevelEnabled()
+func (instance *CoreLogger) IsLevelEnabled(l level.Level) bool {
+	if l >= level.Error {
+		return instance.target.Enabled()
+	}
+	verbosity, err := instance.provider.getVerbosityMapper().ToVerbosity(l)
+	if err != nil {
+		return false
+	}
+	return instance.target.V(verbosity).Enabled()
+}
+
+// GetName implements log.CoreLogger#GetName()
+func (instance *CoreLogger) GetName() string {
+	return instance.name
+}
+
+// NewEvent implements log.CoreLogger#NewEvent()
+func (instance *CoreLogger) NewEvent(l level.Level, values map[string]interface{}) log.Event {
+	return instance.NewEventWithFields(l, fields.WithAll(values))
+}
+
+// NewEventWithFields provides a shortcut if an event should directly be created
+// from fields.
+func (instance *CoreLogger) NewEventWithFields(l level.Level, f fields.ForEachEnabled) log.Event {
+	asFields, err := fields.AsFields(f)
+	if err != nil {
+		panic(err)
+	}
+	return events.New(instance.provider, asFields, l)
+}
+
+// Accepts implements log.CoreLogger#Accepts()
+func (instance *CoreLogger) Accepts(e log.Event) bool {
+	return e != nil
+}
+
+// GetProvider implements log.CoreLogger#GetProvider()
+func (instance *CoreLogger) GetProvider() log.Provider {
+	return instance.provider
+}
@@ -0,0 +1,51 @@
+package logr
+
+import (
+	"testing"
+
+	"github.com/go-logr/logr/funcr"
+
+	"github.com/echocat/slf4g/fields"
+	"github.com/echocat/slf4g/internal/test/assert"
+	"github.com/echocat/slf4g/level"
+)
+
+func TestCoreLogger_IsLevelEnabled(t *testing.T) {
+	instance := NewProvider(funcr.New(func(string, string) {}, funcr.Options{Verbosity: 1})).
+		GetRootLogger()
+
+	assert.ToBeEqual(t, false, instance.IsLevelEnabled(level.Trace))
+	assert.ToBeEqual(t, true, instance.IsLevelEnabled(level.Debug))
+	assert.ToBeEqual(t, true, instance.IsLevelEnabled(level.Info))
+	assert.ToBeEqual(t, true, instance.IsLevelEnabled(level.Error))
+	assert.ToBeEqual(t, false, instance.IsLevelEnabled(666))
+}
+
+func TestCoreLogger_Log_fields(t *testing.T) {
+	var lines []string
+	instance := NewProvider(funcr.New(func(_, args string) {
+		lines = append(lines, args)
+	}, funcr.Options{})).GetRootLogger()
+
+	instance.Log(instance.NewEvent(level.Info, map[string]interface{}{
+		"message":   "aMessage",
+		"b":         fields.LazyFunc(func() interface{} { return 2 }),
+		"a":         1,
+		"excluded":  fields.Exclude,
+		"filtered":  fields.RequireMaximalLevel(level.Debug, 3),
+		"timestamp": 666,
+	}), 0)
+	instance.Log(nil, 0)
+
+	assert.ToBeEqual(t, []string{
+		`"level"=0 "msg"="aMessage" "a"=1 "b"=2`,
+	}, lines)
+}
+
+func TestCoreLogger_Accepts(t *testing.T) {
+	instance := NewProvider(funcr.New(func(string, string) {}, funcr.Options{})).
+		GetRootLogger()
+
+	assert.ToBeEqual(t, true, instance.Accepts(instance.NewEvent(level.Info, nil)))
+	assert.ToBeEqual(t, false, instance.Accepts(nil))
+}
@@ -0,0 +1,129 @@
+package logr
+
+import (
+	"sync"
+
+	"github.com/go-logr/logr"
+
+	log "github.com/echocat/slf4g"
+	"github.com/echocat/slf4g/fields"
+	"github.com/echocat/slf4g/level"
+)
+
+const (
+	rootLoggerName = "ROOT"
+)
+
+// NewProvider creates a new instance of Provider which forwards everything to
+// the given target.
+func NewProvider(target logr.Logger, customizer ...func(*Provider)) *Provider {
+	result := &Provider{
+		Target: target,
+	}
+
+	for _, c := range customizer {
+		c(result)
+	}
+
+	return result
+}
+
+// Provider implements log.Provider of the slf4g framework which forwards all
+// logged events into an existing logr.Logger.
+//
+// Each named logger is forwarded to logr.Logger.WithName() of Provider.Target.
+// Events with level.Error and above are logged using logr.Logger.Error(), all
+// others using logr.Logger.V() with the verbosity resolved by
+// Provider.VerbosityMapper.
+type Provider struct {
+	// Target is the logr.Logger where to forward all logged events to.
+	Target logr.Logger
+
+	// Name represents the name of this Provider. If empty it will be "logr"
+	// by default.
+	Name string
+
+	// VerbosityMapper holds the mapper which is used to transform
+	// level.Level to the verbosity of logr.
+	//
+	// If empty DefaultVerbosityMapper will be used.
+	VerbosityMapper VerbosityMapper
+
+	// LevelProvider is used to determine the log.Levels support by this
+	// Provider and all of its managed loggers. If this is not set it will be
+	// level.GetProvider() by default.
+	LevelProvider level.Provider
+
+	// FieldKeysSpec defines what are the keys of the major fields managed by
+	// this Provider and its managed loggers. If this is not set it will be
+	// fields.KeysSpecImpl by default.
+	FieldKeysSpec fields.KeysSpec
+
+	cacheOnce sync.Once
+	cache     log.LoggerCache
+}
+
+// GetName implements log.Provider#GetName()
+func (instance *Provider) GetName() string {
+	if v := instance.Name; v != "" {
+		return v
+	}
+	return "logr"
+}
+
+// GetRootLogger implements log.Provider#GetRootLogger()
+func (instance *Provider) GetRootLogger() log.Logger {
+	return instance.getCache().GetRootLogger()
+}
+
+// GetLogger implements log.Provider#GetLogger()
+func (instance *Provider) GetLogger(name string) log.Logger {
+	return instance.getCache().GetLogger(name)
+}
+
+// GetAllLevels implements log.Provider#GetAllLevels()
+func (instance *Provider) GetAllLevels() level.Levels {
+	p := instance.LevelProvider
+	if p == nil {
+		p = level.GetProvider()
+	}
+	return p.GetLevels()
+}
+
+// GetFieldKeysSpec implements log.Provider#GetFieldKeysSpec()
+func (instance *Provider) GetFieldKeysSpec() fields.KeysSpec {
+	if v := instance.FieldKeysSpec; v != nil {
+		return v
+	}
+	return fields.KeysSpecImpl{}
+}
+
+func (instance *Provider) getVerbosityMapper() VerbosityMapper {
+	if v := instance.VerbosityMapper; v != nil {
+		return v
+	}
+	return DefaultVerbosityMapper
+}
+
+func (instance *Provider) getCache() log.LoggerCache {
+	instance.cacheOnce.Do(func() {
+		instance.cache = log.NewLoggerCache(instance.rootFactory, instance.factory)
+	})
+	return instance.cache
+}
+
+func (instance *Provider) rootFactory() log.Logger {
+	return log.NewLogger(&CoreLogger{
+		provider: instance,
+		name:     rootLoggerName,
+		target:   instance.Target,
+	})
+}
+
+func (instance *Provider) factory(name string) log.Logger {
+	return log.NewLogger(&CoreLogger{
+		provider: instance,
+		name:     name,
+		target:   instance.Target.WithName(name),
+	})
+}
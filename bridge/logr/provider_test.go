@@ -0,0 +1,84 @@
+package logr
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/go-logr/logr"
+	"github.com/go-logr/logr/funcr"
+
+	"github.com/echocat/slf4g/fields"
+	"github.com/echocat/slf4g/internal/test/assert"
+	"github.com/echocat/slf4g/level"
+)
+
+func TestNewProvider(t *testing.T) {
+	target := logr.Discard()
+
+	actual := NewProvider(target, func(v *Provider) {
+		v.Name = "foo"
+	})
+
+	assert.ToBeNotNil(t, actual)
+	assert.ToBeEqual(t, target, actual.Target)
+	assert.ToBeEqual(t, "foo", actual.GetName())
+}
+
+func TestProvider_GetName(t *testing.T) {
+	assert.ToBeEqual(t, "logr", (&Provider{}).GetName())
+	assert.ToBeEqual(t, "foo", (&Provider{Name: "foo"}).GetName())
+}
+
+func TestProvider_GetAllLevels(t *testing.T) {
+	assert.ToBeEqual(t, level.GetProvider().GetLevels(), (&Provider{}).GetAllLevels())
+}
+
+func TestProvider_GetFieldKeysSpec(t *testing.T) {
+	givenSpec := &fields.KeysSpecImpl{Message: "msg"}
+
+	assert.ToBeEqual(t, fields.KeysSpecImpl{}, (&Provider{}).GetFieldKeysSpec())
+	assert.ToBeSame(t, givenSpec, (&Provider{FieldKeysSpec: givenSpec}).GetFieldKeysSpec())
+}
+
+func TestProvider_GetLogger(t *testing.T) {
+	var lines []string
+	instance := NewProvider(funcr.New(func(prefix, args string) {
+		lines = append(lines, prefix+" "+args)
+	}, funcr.Options{Verbosity: 1}))
+
+	root := instance.GetRootLogger()
+	assert.ToBeSame(t, root, instance.GetRootLogger())
+	assert.ToBeEqual(t, rootLoggerName, root.GetName())
+
+	foo := instance.GetLogger("foo")
+	assert.ToBeSame(t, foo, instance.GetLogger("foo"))
+	assert.ToBeEqual(t, "foo", foo.GetName())
+
+	root.Info("aMessage")
+	foo.With("a", 1).Debug("anotherMessage")
+	foo.Trace("ignored")
+	foo.WithError(errors.New("expected")).Warn("aWarning")
+	foo.WithError(errors.New("expected")).Error("anError")
+
+	assert.ToBeEqual(t, []string{
+		` "level"=0 "msg"="aMessage"`,
+		`foo "level"=1 "msg"="anotherMessage" "a"=1`,
+		`foo "level"=0 "msg"="aWarning" "error"="expected"`,
+		`foo "msg"="anError" "error"="expected"`,
+	}, lines)
+}
+
+func TestCoreLogger_Log_callDepth(t *testing.T) {
+	var lines []string
+	instance := NewProvider(funcr.New(func(_, args string) {
+		lines = append(lines, args)
+	}, funcr.Options{LogCaller: funcr.All, LogCallerFunc: true}))
+
+	root := instance.GetRootLogger()
+	root.Log(root.NewEvent(level.Info, map[string]interface{}{
+		"message": "aMessage",
+	}), 0)
+
+	assert.ToBeEqual(t, 1, len(lines))
+	assert.ToBeMatching(t, `"caller"={"file"="provider_test.go" "line"=\d+ "function"="github.com/echocat/slf4g/bridge/logr.TestCoreLogger_Log_callDepth"}`, lines[0])
+}
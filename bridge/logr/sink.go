@@ -0,0 +1,195 @@
+package logr
+
+import (
+	"fmt"
+
+	"github.com/go-logr/logr"
+
+	log "github.com/echocat/slf4g"
+	"github.com/echocat/slf4g/fields"
+	"github.com/echocat/slf4g/level"
+)
+
+// NewSink creates a new instance of Sink which forwards everything to the
+// given target.
+func NewSink(target log.CoreLogger, customizer ...func(*Sink)) *Sink {
+	result := &Sink{
+		Delegate: target,
+	}
+
+	for _, c := range customizer {
+		c(result)
+	}
+
+	return result
+}
+
+// Sink is an implementation of logr.LogSink (and logr.CallDepthLogSink) which
+// forwards all of its entries to a log.CoreLogger of slf4g.
+//
+// * logr.Logger.V() is mapped to level.Level using Sink.VerbosityMapper.
+// * logr.Logger.WithName() is mapped to a logger of slf4g with the name of
+// the current logger and the given name joined by a dot.
+// * logr.Logger.WithValues() is mapped to fields.
+// * logr.Logger.Error() is logged with level.Error and the given error is
+// added in the same way like log.Logger.WithError() does.
+type Sink struct {
+	// Delegate is the log.CoreLogger of the slf4g framework where to forward
+	// all logged entries of this implementation to.
+	//
+	// If empty the result of log.GetRootLogger() will be used.
+	Delegate log.CoreLogger
+
+	// VerbosityMapper holds the mapper which is used to transform the
+	// verbosity of logr to level.Level.
+	//
+	// If empty DefaultVerbosityMapper will be used.
+	VerbosityMapper VerbosityMapper
+
+	fields    fields.Fields
+	callDepth int
+}
+
+// Init implements logr.LogSink.Init()
+func (instance *Sink) Init(info logr.RuntimeInfo) {
+	instance.callDepth = info.CallDepth
+}
+
+// Enabled implements logr.LogSink.Enabled()
+func (instance *Sink) Enabled(verbosity int) bool {
+	l, err := instance.getVerbosityMapper().FromVerbosity(verbosity)
+	if err != nil {
+		return false
+	}
+	return instance.getDelegate().IsLevelEnabled(l)
+}
+
+// Info implements logr.LogSink.Info()
+func (instance *Sink) Info(verbosity int, msg string, keysAndValues ...interface{}) {
+	delegate := instance.getDelegate()
+	helperOf(delegate)()
+
+	l, err := instance.getVerbosityMapper().FromVerbosity(verbosity)
+	if err != nil || !delegate.IsLevelEnabled(l) {
+		return
+	}
+
+	e := instance.newEvent(delegate, l, msg, keysAndValues)
+	delegate.Log(e, uint16(instance.callDepth+1))
+}
+
+// Error implements logr.LogSink.Error()
+func (instance *Sink) Error(err error, msg string, keysAndValues ...interface{}) {
+	delegate := instance.getDelegate()
+	helperOf(delegate)()
+
+	if !delegate.IsLevelEnabled(level.Error) {
+		return
+	}
+
+	if err != nil {
+		errorKey := delegate.GetProvider().GetFieldKeysSpec().GetError()
+		keysAndValues = append(keysAndValues[:len(keysAndValues):len(keysAndValues)], errorKey, err)
+	}
+	e := instance.newEvent(delegate, level.Error, msg, keysAndValues)
+	delegate.Log(e, uint16(instance.callDepth+1))
+}
+
+// WithValues implements logr.LogSink.WithValues()
+func (instance *Sink) WithValues(keysAndValues ...interface{}) logr.LogSink {
+	result := instance.clone()
+	result.fields = instance.withKeysAndValues(keysAndValues)
+	return result
+}
+
+// WithName implements logr.LogSink.WithName()
+func (instance *Sink) WithName(name string) logr.LogSink {
+	delegate := instance.getDelegate()
+	provider := delegate.GetProvider()
+
+	if current := delegate.GetName(); current != provider.GetRootLogger().GetName() {
+		name = current + "." + name
+	}
+
+	result := instance.clone()
+	result.Delegate = provider.GetLogger(name)
+	return result
+}
+
+// WithCallDepth implements logr.CallDepthLogSink.WithCallDepth()
+func (instance *Sink) WithCallDepth(depth int) logr.LogSink {
+	result := instance.clone()
+	result.callDepth += depth
+	return result
+}
+
+func (instance *Sink) newEvent(logger log.CoreLogger, l level.Level, msg string, keysAndValues []interface{}) log.Event {
+	fds := instance.withKeysAndValues(keysAndValues)
+	if fds == nil {
+		fds = fields.Empty()
+	}
+	return log.NewEventWithFields(logger, l, fds.
+		With(logger.GetProvider().GetFieldKeysSpec().GetMessage(), msg))
+}
+
+func (instance *Sink) withKeysAndValues(keysAndValues []interface{}) fields.Fields {
+	if len(keysAndValues) == 0 {
+		return instance.fields
+	}
+
+	vs := make(map[string]interface{}, (len(keysAndValues)+1)/2)
+	for i := 0; i < len(keysAndValues); i += 2 {
+		var key string
+		if k, ok := keysAndValues[i].(string); ok {
+			key = k
+		} else {
+			key = fmt.Sprint(keysAndValues[i])
+		}
+		if i+1 < len(keysAndValues) {
+			vs[key] = keysAndValues[i+1]
+		} else {
+			vs[key] = MissingValue
+		}
+	}
+
+	if parent := instance.fields; parent != nil {
+		return fields.NewLineage(fields.WithAll(vs), parent)
+	}
+	return fields.WithAll(vs)
+}
+
+// MissingValue will be used as the value of the last key, if an odd number of
+// keys and values was provided.
+const MissingValue = "<no-value>"
+
+func (instance *Sink) clone() *Sink {
+	return &Sink{
+		Delegate:        instance.Delegate,
+		VerbosityMapper: instance.VerbosityMapper,
+		fields:          instance.fields,
+		callDepth:       instance.callDepth,
+	}
+}
+
+func (instance *Sink) getDelegate() log.CoreLogger {
+	if v := instance.Delegate; v != nil {
+		return v
+	}
+	return log.GetRootLogger()
+}
+
+func (instance *Sink) getVerbosityMapper() VerbosityMapper {
+	if v := instance.VerbosityMapper; v != nil {
+		return v
+	}
+	return DefaultVerbosityMapper
+}
+
+func helperOf(instance log.CoreLogger) func() {
+	if wh, ok := instance.(interface {
+		Helper() func()
+	}); ok {
+		return wh.Helper()
+	}
+	return func() {}
+}
@@ -0,0 +1,185 @@
+package logr
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/go-logr/logr"
+
+	log "github.com/echocat/slf4g"
+	"github.com/echocat/slf4g/fields"
+	"github.com/echocat/slf4g/internal/test/assert"
+	"github.com/echocat/slf4g/level"
+	"github.com/echocat/slf4g/testing/recording"
+)
+
+func TestNewSink(t *testing.T) {
+	aLogger := recording.NewCoreLogger()
+	aVerbosityMapper := NewVerbosityMapper()
+
+	actual := NewSink(aLogger, func(v *Sink) {
+		v.VerbosityMapper = aVerbosityMapper
+	})
+
+	assert.ToBeNotNil(t, actual)
+	assert.ToBeSame(t, aLogger, actual.Delegate)
+	assert.ToBeSame(t, aVerbosityMapper, actual.VerbosityMapper)
+}
+
+func TestSink_Enabled(t *testing.T) {
+	aLogger := recording.NewCoreLogger()
+	aLogger.SetLevel(level.Debug)
+
+	instance := &Sink{Delegate: aLogger}
+
+	assert.ToBeEqual(t, true, instance.Enabled(0))
+	assert.ToBeEqual(t, true, instance.Enabled(1))
+	assert.ToBeEqual(t, false, instance.Enabled(2))
+	assert.ToBeEqual(t, false, instance.Enabled(-1))
+}
+
+func TestSink_Info(t *testing.T) {
+	aLogger := recording.NewCoreLogger()
+	aLogger.SetLevel(level.Debug)
+	helperWasCalled := false
+
+	instance := &Sink{Delegate: &delegateCoreLoggerWithHelper{aLogger, func() {
+		helperWasCalled = true
+	}}}
+
+	instance.Info(1, "aMessage", "foo", "bar", 1, 2, "odd")
+	instance.Info(2, "ignored")
+	instance.Info(-1, "ignored")
+
+	assert.ToBeEqual(t, true, helperWasCalled)
+	assert.ToBeEqual(t, 1, aLogger.Len())
+	actual := aLogger.Get(0)
+	assert.ToBeEqual(t, level.Debug, actual.GetLevel())
+
+	actualAsMap, err := fields.AsMap(actual)
+	assert.ToBeNoError(t, err)
+	delete(actualAsMap, "timestamp")
+	assert.ToBeEqual(t, map[string]interface{}{
+		"logger":  aLogger,
+		"message": "aMessage",
+		"foo":     "bar",
+		"1":       2,
+		"odd":     MissingValue,
+	}, actualAsMap)
+}
+
+func TestSink_Error(t *testing.T) {
+	aLogger := recording.NewCoreLogger()
+	anError := errors.New("expected")
+
+	instance := &Sink{Delegate: aLogger}
+
+	instance.Error(anError, "aMessage", "foo", "bar")
+	instance.Error(nil, "anotherMessage")
+
+	assert.ToBeEqual(t, 2, aLogger.Len())
+
+	actual := aLogger.Get(0)
+	assert.ToBeEqual(t, level.Error, actual.GetLevel())
+	actualAsMap, err := fields.AsMap(actual)
+	assert.ToBeNoError(t, err)
+	delete(actualAsMap, "timestamp")
+	assert.ToBeEqual(t, map[string]interface{}{
+		"logger":  aLogger,
+		"message": "aMessage",
+		"error":   anError,
+		"foo":     "bar",
+	}, actualAsMap)
+
+	actual = aLogger.Get(1)
+	actualAsMap, err = fields.AsMap(actual)
+	assert.ToBeNoError(t, err)
+	delete(actualAsMap, "timestamp")
+	assert.ToBeEqual(t, map[string]interface{}{
+		"logger":  aLogger,
+		"message": "anotherMessage",
+	}, actualAsMap)
+}
+
+func TestSink_Error_disabled(t *testing.T) {
+	aLogger := recording.NewCoreLogger()
+	aLogger.SetLevel(level.Fatal)
+
+	instance := &Sink{Delegate: aLogger}
+	instance.Error(errors.New("expected"), "aMessage")
+
+	assert.ToBeEqual(t, 0, aLogger.Len())
+}
+
+func TestSink_WithValues(t *testing.T) {
+	aLogger := recording.NewCoreLogger()
+
+	instance := New(aLogger).
+		WithValues("a", 1).
+		WithValues("b", 2)
+
+	instance.Info("aMessage", "c", 3)
+
+	assert.ToBeEqual(t, 1, aLogger.Len())
+	actualAsMap, err := fields.AsMap(aLogger.Get(0))
+	assert.ToBeNoError(t, err)
+	delete(actualAsMap, "timestamp")
+	assert.ToBeEqual(t, map[string]interface{}{
+		"logger":  aLogger,
+		"message": "aMessage",
+		"a":       1,
+		"b":       2,
+		"c":       3,
+	}, actualAsMap)
+}
+
+func TestSink_WithName(t *testing.T) {
+	provider := recording.NewProvider()
+
+	instance := New(provider.GetRootLogger()).
+		WithName("foo").
+		WithName("bar")
+
+	instance.Info("aMessage")
+
+	assert.ToBeEqual(t, 0, len(provider.GetAllRoot()))
+	assert.ToBeEqual(t, 1, len(provider.GetAllOf("foo.bar")))
+}
+
+func TestSink_WithCallDepth(t *testing.T) {
+	instance := &Sink{}
+	instance.Init(logr.RuntimeInfo{CallDepth: 1})
+
+	actual := instance.WithCallDepth(2)
+
+	assert.ToBeEqual(t, 1, instance.callDepth)
+	assert.ToBeEqual(t, 3, actual.(*Sink).callDepth)
+}
+
+func TestSink_Init(t *testing.T) {
+	instance := &Sink{}
+
+	instance.Init(logr.RuntimeInfo{CallDepth: 1})
+	instance.Init(logr.RuntimeInfo{CallDepth: 1})
+
+	assert.ToBeEqual(t, 1, instance.callDepth)
+}
+
+func TestNew(t *testing.T) {
+	aLogger := recording.NewCoreLogger()
+
+	actual := New(aLogger)
+
+	assert.ToBeOfType(t, (*Sink)(nil), actual.GetSink())
+	assert.ToBeSame(t, aLogger, actual.GetSink().(*Sink).Delegate)
+	assert.ToBeEqual(t, 1, actual.GetSink().(*Sink).callDepth)
+}
+
+type delegateCoreLoggerWithHelper struct {
+	log.CoreLogger
+	helper func()
+}
+
+func (instance delegateCoreLoggerWithHelper) Helper() func() {
+	return instance.helper
+}
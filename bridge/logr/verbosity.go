@@ -0,0 +1,81 @@
+package logr
+
+import (
+	"fmt"
+
+	"github.com/echocat/slf4g/level"
+)
+
+// VerbosityMapper transforms the verbosity levels of logr (see
+// logr.Logger.V()) from and to level.Level.
+type VerbosityMapper interface {
+	// FromVerbosity maps the given verbosity of logr to level.Level.
+	FromVerbosity(int) (level.Level, error)
+
+	// ToVerbosity maps the given level.Level to a verbosity of logr. This is
+	// not used for level.Error and above, as they are logged using
+	// logr.Logger.Error().
+	ToVerbosity(level.Level) (int, error)
+}
+
+// DefaultVerbosityMapper is the default instance of VerbosityMapper which
+// should cover the most of the cases.
+var DefaultVerbosityMapper VerbosityMapper = NewVerbosityMapper()
+
+// NewVerbosityMapper creates a new instance of the default VerbosityMapper
+// implementation.
+//
+// The verbosity 0 will be mapped to level.Info, 1 to level.Debug and every
+// verbosity above to level.Trace. In the other direction level.Warn will be
+// mapped to 0, too, as logr does not know something like a warn level.
+func NewVerbosityMapper() VerbosityMapper {
+	return &defaultVerbosityMapper{}
+}
+
+type defaultVerbosityMapper struct{}
+
+func (instance *defaultVerbosityMapper) FromVerbosity(v int) (level.Level, error) {
+	switch {
+	case v < 0:
+		return 0, fmt.Errorf("illegal verbosity: %d", v)
+	case v == 0:
+		return level.Info, nil
+	case v == 1:
+		return level.Debug, nil
+	default:
+		return level.Trace, nil
+	}
+}
+
+func (instance *defaultVerbosityMapper) ToVerbosity(v level.Level) (int, error) {
+	switch v {
+	case level.Trace:
+		return 2, nil
+	case level.Debug:
+		return 1, nil
+	case level.Info, level.Warn, level.Error, level.Fatal:
+		return 0, nil
+	default:
+		return 0, fmt.Errorf("unknown log level: %d", v)
+	}
+}
+
+// NewVerbosityMapperFacade creates a facade of VerbosityMapper using the given
+// provider.
+func NewVerbosityMapperFacade(provider func() VerbosityMapper) VerbosityMapper {
+	return verbosityMapperFacade(provider)
+}
+
+type verbosityMapperFacade func() VerbosityMapper
+
+func (instance verbosityMapperFacade) FromVerbosity(v int) (level.Level, error) {
+	return instance.Unwrap().FromVerbosity(v)
+}
+
+func (instance verbosityMapperFacade) ToVerbosity(v level.Level) (int, error) {
+	return instance.Unwrap().ToVerbosity(v)
+}
+
+func (instance verbosityMapperFacade) Unwrap() VerbosityMapper {
+	return instance()
+}
@@ -0,0 +1,93 @@
+package logr
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/echocat/slf4g/internal/test/assert"
+	"github.com/echocat/slf4g/level"
+)
+
+func TestNewVerbosityMapper(t *testing.T) {
+	actual := NewVerbosityMapper()
+	assert.ToBeNotNil(t, actual)
+	assert.ToBeOfType(t, (*defaultVerbosityMapper)(nil), actual)
+}
+
+func TestDefaultVerbosityMapper_FromVerbosity(t *testing.T) {
+	instance := &defaultVerbosityMapper{}
+
+	cases := []struct {
+		input       int
+		expected    level.Level
+		expectedErr string
+	}{
+		{0, level.Info, ""},
+		{1, level.Debug, ""},
+		{2, level.Trace, ""},
+		{10, level.Trace, ""},
+		{-1, 0, "illegal verbosity: -1"},
+	}
+
+	for _, c := range cases {
+		t.Run(fmt.Sprint(c.input), func(t *testing.T) {
+			actual, actualErr := instance.FromVerbosity(c.input)
+			if c.expectedErr == "" {
+				assert.ToBeNoError(t, actualErr)
+				assert.ToBeEqual(t, c.expected, actual)
+			} else {
+				assert.ToBeMatching(t, c.expectedErr, actualErr)
+				assert.ToBeEqual(t, level.Level(0), actual)
+			}
+		})
+	}
+}
+
+func TestDefaultVerbosityMapper_ToVerbosity(t *testing.T) {
+	instance := &defaultVerbosityMapper{}
+
+	cases := []struct {
+		input       level.Level
+		expected    int
+		expectedErr string
+	}{
+		{level.Trace, 2, ""},
+		{level.Debug, 1, ""},
+		{level.Info, 0, ""},
+		{level.Warn, 0, ""},
+		{level.Error, 0, ""},
+		{level.Fatal, 0, ""},
+		{666, 0, "unknown log level: 666"},
+	}
+
+	for _, c := range cases {
+		t.Run(fmt.Sprint(c.input), func(t *testing.T) {
+			actual, actualErr := instance.ToVerbosity(c.input)
+			if c.expectedErr == "" {
+				assert.ToBeNoError(t, actualErr)
+				assert.ToBeEqual(t, c.expected, actual)
+			} else {
+				assert.ToBeMatching(t, c.expectedErr, actualErr)
+				assert.ToBeEqual(t, 0, actual)
+			}
+		})
+	}
+}
+
+func TestNewVerbosityMapperFacade(t *testing.T) {
+	givenMapper := &defaultVerbosityMapper{}
+
+	actual := NewVerbosityMapperFacade(func() VerbosityMapper {
+		return givenMapper
+	})
+
+	assert.ToBeSame(t, givenMapper, actual.(verbosityMapperFacade).Unwrap())
+
+	actualLevel, actualErr := actual.FromVerbosity(1)
+	assert.ToBeNoError(t, actualErr)
+	assert.ToBeEqual(t, level.Debug, actualLevel)
+
+	actualVerbosity, actualErr := actual.ToVerbosity(level.Debug)
+	assert.ToBeNoError(t, actualErr)
+	assert.ToBeEqual(t, 1, actualVerbosity)
+}
@@ -0,0 +1,20 @@
+// Package logrus provides methods to use slf4g as the backend of
+// github.com/sirupsen/logrus ([github.com/sirupsen/logrus.Logger]).
+//
+// It lives in its own Go module to keep the core of slf4g free of any
+// dependencies.
+//
+// # Usage
+//
+// The simplest way is to configure the [github.com/sirupsen/logrus.StandardLogger]
+// to forward all of its entries to the root logger of slf4g:
+//
+//	logrus.Configure()
+//
+// ... or create a new [github.com/sirupsen/logrus.Logger] which does the same:
+//
+//	logger := logrus.New(nil)
+//
+// If you only need the [github.com/sirupsen/logrus.Hook] (for example to add
+// it to an already existing logger) use [NewHook].
+package logrus
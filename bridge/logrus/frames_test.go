@@ -0,0 +1,46 @@
+package logrus_test
+
+import (
+	"io"
+	"runtime"
+	"testing"
+
+	"github.com/sirupsen/logrus"
+
+	bridge "github.com/echocat/slf4g/bridge/logrus"
+	"github.com/echocat/slf4g/internal/test/assert"
+)
+
+func Test_detectSkipFramesFromLogrus(t *testing.T) {
+	hook := &dummyHook{detectSkipFramesUsing: bridge.DefaultDetectSkipFrames}
+
+	logger := logrus.New()
+	logger.SetOutput(io.Discard)
+	logger.AddHook(hook)
+
+	logger.Info("info")
+	assert.ToBeEqual(t, uint16(7), hook.detectedSkipFrames)
+	assert.ToBeEqual(t, "github.com/echocat/slf4g/bridge/logrus_test.Test_detectSkipFramesFromLogrus", hook.detectedFunction)
+
+	logger.WithField("foo", "bar").Info("info")
+	assert.ToBeEqual(t, uint16(6), hook.detectedSkipFrames)
+	assert.ToBeEqual(t, "github.com/echocat/slf4g/bridge/logrus_test.Test_detectSkipFramesFromLogrus", hook.detectedFunction)
+}
+
+type dummyHook struct {
+	detectedSkipFrames    uint16
+	detectedFunction      string
+	detectSkipFramesUsing bridge.DetectSkipFrames
+}
+
+func (instance *dummyHook) Levels() []logrus.Level {
+	return logrus.AllLevels
+}
+
+func (instance *dummyHook) Fire(*logrus.Entry) error {
+	instance.detectedSkipFrames = instance.detectSkipFramesUsing(1)
+	if pc, _, _, ok := runtime.Caller(int(instance.detectedSkipFrames)); ok {
+		instance.detectedFunction = runtime.FuncForPC(pc).Name()
+	}
+	return nil
+}
@@ -0,0 +1,12 @@
+module github.com/echocat/slf4g/bridge/logrus
+
+go 1.23.0
+
+replace github.com/echocat/slf4g => ../../
+
+require (
+	github.com/echocat/slf4g v0.0.0
+	github.com/sirupsen/logrus v1.9.3
+)
+
+require golang.org/x/sys v0.0.0-20220715151400-c0bba94af5f8 // indirect
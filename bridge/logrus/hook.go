@@ -0,0 +1,129 @@
+package logrus
+
+import (
+	"fmt"
+
+	"github.com/sirupsen/logrus"
+
+	log "github.com/echocat/slf4g"
+	"github.com/echocat/slf4g/fields"
+	"github.com/echocat/slf4g/level"
+)
+
+// NewHook creates a new instance of Hook which forwards everything to the
+// given target.
+func NewHook(target log.CoreLogger, customizer ...func(*Hook)) *Hook {
+	result := &Hook{
+		Delegate: target,
+	}
+
+	for _, c := range customizer {
+		c(result)
+	}
+
+	return result
+}
+
+// Hook is an implementation of logrus.Hook which forwards all fired entries
+// to a log.CoreLogger of slf4g.
+type Hook struct {
+	// Delegate is the log.CoreLogger of the slf4g framework where to forward
+	// all fired entries of this implementation to.
+	//
+	// If empty the result of log.GetRootLogger() will be used.
+	Delegate log.CoreLogger
+
+	// LevelMapper holds the mapper which is used to transform the levels
+	// between logrus.Level and level.Level.
+	//
+	// If empty DefaultLevelMapper will be used.
+	LevelMapper LevelMapper
+
+	// DetectSkipFrames defines for how much frames an element log.Event should
+	// be skipped while reporting to Hook.Delegate.
+	//
+	// If empty DefaultDetectSkipFrames will be used.
+	DetectSkipFrames DetectSkipFrames
+}
+
+// Levels implements logrus.Hook.Levels()
+func (instance *Hook) Levels() []logrus.Level {
+	return logrus.AllLevels
+}
+
+// Fire implements logrus.Hook.Fire()
+func (instance *Hook) Fire(entry *logrus.Entry) error {
+	delegate := instance.getDelegate()
+	helperOf(delegate)()
+
+	l, err := instance.mapFromLogrusLevel(entry.Level)
+	if err != nil {
+		return err
+	}
+	if !delegate.IsLevelEnabled(l) {
+		return nil
+	}
+
+	e := log.NewEventWithFields(delegate, l, instance.fieldsOfEntry(delegate, entry))
+
+	skipFrames := instance.getDetectSkipFrames()(1)
+	delegate.Log(e, skipFrames)
+	return nil
+}
+
+func (instance *Hook) fieldsOfEntry(logger log.CoreLogger, entry *logrus.Entry) fields.Fields {
+	fdsSpec := logger.GetProvider().GetFieldKeysSpec()
+
+	vs := make(map[string]interface{}, len(entry.Data)+2)
+	for k, v := range entry.Data {
+		if k == logrus.ErrorKey {
+			k = fdsSpec.GetError()
+		}
+		vs[k] = v
+	}
+
+	vs[fdsSpec.GetMessage()] = entry.Message
+	if !entry.Time.IsZero() {
+		vs[fdsSpec.GetTimestamp()] = entry.Time
+	}
+
+	return fields.WithAll(vs)
+}
+
+func (instance *Hook) mapFromLogrusLevel(ll logrus.Level) (level.Level, error) {
+	l, err := instance.getLevelMapper().FromLogrus(ll)
+	if err != nil {
+		return 0, fmt.Errorf("cannot map logrus' level %d to slf4g's level: %w", ll, err)
+	}
+	return l, nil
+}
+
+func (instance *Hook) getDelegate() log.CoreLogger {
+	if v := instance.Delegate; v != nil {
+		return v
+	}
+	return log.GetRootLogger()
+}
+
+func (instance *Hook) getLevelMapper() LevelMapper {
+	if v := instance.LevelMapper; v != nil {
+		return v
+	}
+	return DefaultLevelMapper
+}
+
+func (instance *Hook) getDetectSkipFrames() DetectSkipFrames {
+	if v := instance.DetectSkipFrames; v != nil {
+		return v
+	}
+	return DefaultDetectSkipFrames
+}
+
+func helperOf(instance log.CoreLogger) func() {
+	if wh, ok := instance.(interface {
+		Helper() func()
+	}); ok {
+		return wh.Helper()
+	}
+	return func() {}
+}
@@ -0,0 +1,112 @@
+package logrus
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/sirupsen/logrus"
+
+	log "github.com/echocat/slf4g"
+	"github.com/echocat/slf4g/fields"
+	"github.com/echocat/slf4g/internal/test/assert"
+	"github.com/echocat/slf4g/level"
+	"github.com/echocat/slf4g/testing/recording"
+)
+
+func TestNewHook(t *testing.T) {
+	aLogger := recording.NewCoreLogger()
+	aLevelMapper := NewLevelMapper()
+
+	actual := NewHook(aLogger, func(v *Hook) {
+		v.LevelMapper = aLevelMapper
+	})
+
+	assert.ToBeNotNil(t, actual)
+	assert.ToBeSame(t, aLogger, actual.Delegate)
+	assert.ToBeSame(t, aLevelMapper, actual.LevelMapper)
+}
+
+func TestHook_Levels(t *testing.T) {
+	actual := (&Hook{}).Levels()
+	assert.ToBeEqual(t, logrus.AllLevels, actual)
+}
+
+func TestHook_Fire(t *testing.T) {
+	aTime, err := time.Parse(time.RFC3339, "2025-10-01T15:30:15Z")
+	assert.ToBeNoError(t, err)
+	anError := errors.New("expected")
+
+	cases := []struct {
+		name          string
+		givenLevel    logrus.Level
+		expectedLevel level.Level
+		expectedError string
+	}{
+		{"regular", logrus.InfoLevel, level.Info, ""},
+		{"panic", logrus.PanicLevel, level.Fatal, ""},
+		{"disabled", logrus.DebugLevel, 0, ""},
+		{"failing", 66, 0, "unknown logrus level: 66"},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			baseLogger := recording.NewCoreLogger()
+			baseLogger.SetLevel(level.Info)
+			helperWasCalled := false
+
+			instance := &Hook{
+				Delegate: &delegateCoreLoggerWithHelper{baseLogger, func() {
+					helperWasCalled = true
+				}},
+				DetectSkipFrames: func(skip uint16) uint16 {
+					return skip + 1
+				},
+			}
+
+			actualErr := instance.Fire(&logrus.Entry{
+				Time:    aTime,
+				Message: "aMessage",
+				Level:   c.givenLevel,
+				Data: logrus.Fields{
+					"foo":           "bar",
+					logrus.ErrorKey: anError,
+				},
+			})
+			assert.ToBeEqual(t, true, helperWasCalled)
+			if expectedErr := c.expectedError; expectedErr != "" {
+				assert.ToBeMatching(t, expectedErr, actualErr)
+				assert.ToBeEqual(t, 0, baseLogger.Len())
+			} else if c.expectedLevel == 0 {
+				assert.ToBeNoError(t, actualErr)
+				assert.ToBeEqual(t, 0, baseLogger.Len())
+			} else {
+				assert.ToBeNoError(t, actualErr)
+				assert.ToBeEqual(t, 1, baseLogger.Len())
+
+				actual := baseLogger.Get(0)
+				assert.ToBeEqual(t, c.expectedLevel, actual.GetLevel())
+
+				actualAsMap, err := fields.AsMap(actual)
+				assert.ToBeNoError(t, err)
+
+				assert.ToBeEqual(t, map[string]interface{}{
+					"logger":    baseLogger,
+					"timestamp": aTime,
+					"message":   "aMessage",
+					"foo":       "bar",
+					"error":     anError,
+				}, actualAsMap)
+			}
+		})
+	}
+}
+
+type delegateCoreLoggerWithHelper struct {
+	log.CoreLogger
+	helper func()
+}
+
+func (instance delegateCoreLoggerWithHelper) Helper() func() {
+	return instance.helper
+}
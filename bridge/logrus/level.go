@@ -0,0 +1,87 @@
+package logrus
+
+import (
+	"fmt"
+
+	"github.com/sirupsen/logrus"
+
+	"github.com/echocat/slf4g/level"
+)
+
+// LevelMapper transforms the levels between logrus.Level and level.Level.
+type LevelMapper interface {
+	FromLogrus(logrus.Level) (level.Level, error)
+	ToLogrus(level.Level) (logrus.Level, error)
+}
+
+// DefaultLevelMapper is the default instance of LevelMapper which should cover
+// the most of the cases.
+var DefaultLevelMapper LevelMapper = NewLevelMapper()
+
+// NewLevelMapper creates a new instance of the default LevelMapper
+// implementation.
+//
+// logrus.PanicLevel will be mapped to level.Fatal, because slf4g does not know
+// something like a panic level.
+func NewLevelMapper() LevelMapper {
+	return &defaultLevelMapper{}
+}
+
+type defaultLevelMapper struct{}
+
+func (instance *defaultLevelMapper) FromLogrus(v logrus.Level) (level.Level, error) {
+	switch v {
+	case logrus.TraceLevel:
+		return level.Trace, nil
+	case logrus.DebugLevel:
+		return level.Debug, nil
+	case logrus.InfoLevel:
+		return level.Info, nil
+	case logrus.WarnLevel:
+		return level.Warn, nil
+	case logrus.ErrorLevel:
+		return level.Error, nil
+	case logrus.FatalLevel, logrus.PanicLevel:
+		return level.Fatal, nil
+	default:
+		return 0, fmt.Errorf("unknown logrus level: %d", v)
+	}
+}
+
+func (instance *defaultLevelMapper) ToLogrus(v level.Level) (logrus.Level, error) {
+	switch v {
+	case level.Trace:
+		return logrus.TraceLevel, nil
+	case level.Debug:
+		return logrus.DebugLevel, nil
+	case level.Info:
+		return logrus.InfoLevel, nil
+	case level.Warn:
+		return logrus.WarnLevel, nil
+	case level.Error:
+		return logrus.ErrorLevel, nil
+	case level.Fatal:
+		return logrus.FatalLevel, nil
+	default:
+		return 0, fmt.Errorf("unknown log level: %d", v)
+	}
+}
+
+// NewLevelMapperFacade creates a facade of LevelMapper using the given provider.
+func NewLevelMapperFacade(provider func() LevelMapper) LevelMapper {
+	return levelMapperFacade(provider)
+}
+
+type levelMapperFacade func() LevelMapper
+
+func (instance levelMapperFacade) FromLogrus(v logrus.Level) (level.Level, error) {
+	return instance.Unwrap().FromLogrus(v)
+}
+
+func (instance levelMapperFacade) ToLogrus(v level.Level) (logrus.Level, error) {
+	return instance.Unwrap().ToLogrus(v)
+}
+
+func (instance levelMapperFacade) Unwrap() LevelMapper {
+	return instance()
+}
@@ -0,0 +1,98 @@
+package logrus
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/sirupsen/logrus"
+
+	"github.com/echocat/slf4g/internal/test/assert"
+	"github.com/echocat/slf4g/level"
+)
+
+func TestNewLevelMapper(t *testing.T) {
+	actual := NewLevelMapper()
+	assert.ToBeNotNil(t, actual)
+	assert.ToBeOfType(t, (*defaultLevelMapper)(nil), actual)
+}
+
+func TestDefaultLevelMapper_FromLogrus(t *testing.T) {
+	instance := &defaultLevelMapper{}
+
+	cases := []struct {
+		input       logrus.Level
+		expected    level.Level
+		expectedErr string
+	}{
+		{logrus.TraceLevel, level.Trace, ""},
+		{logrus.DebugLevel, level.Debug, ""},
+		{logrus.InfoLevel, level.Info, ""},
+		{logrus.WarnLevel, level.Warn, ""},
+		{logrus.ErrorLevel, level.Error, ""},
+		{logrus.FatalLevel, level.Fatal, ""},
+		{logrus.PanicLevel, level.Fatal, ""},
+		{66, 0, "unknown logrus level: 66"},
+	}
+
+	for _, c := range cases {
+		t.Run(fmt.Sprint(uint32(c.input)), func(t *testing.T) {
+			actual, actualErr := instance.FromLogrus(c.input)
+			if c.expectedErr == "" {
+				assert.ToBeNoError(t, actualErr)
+				assert.ToBeEqual(t, c.expected, actual)
+			} else {
+				assert.ToBeMatching(t, c.expectedErr, actualErr)
+				assert.ToBeEqual(t, level.Level(0), actual)
+			}
+		})
+	}
+}
+
+func TestDefaultLevelMapper_ToLogrus(t *testing.T) {
+	instance := &defaultLevelMapper{}
+
+	cases := []struct {
+		input       level.Level
+		expected    logrus.Level
+		expectedErr string
+	}{
+		{level.Trace, logrus.TraceLevel, ""},
+		{level.Debug, logrus.DebugLevel, ""},
+		{level.Info, logrus.InfoLevel, ""},
+		{level.Warn, logrus.WarnLevel, ""},
+		{level.Error, logrus.ErrorLevel, ""},
+		{level.Fatal, logrus.FatalLevel, ""},
+		{666, 0, "unknown log level: 666"},
+	}
+
+	for _, c := range cases {
+		t.Run(fmt.Sprint(c.input), func(t *testing.T) {
+			actual, actualErr := instance.ToLogrus(c.input)
+			if c.expectedErr == "" {
+				assert.ToBeNoError(t, actualErr)
+				assert.ToBeEqual(t, c.expected, actual)
+			} else {
+				assert.ToBeMatching(t, c.expectedErr, actualErr)
+				assert.ToBeEqual(t, logrus.Level(0), actual)
+			}
+		})
+	}
+}
+
+func TestNewLevelMapperFacade(t *testing.T) {
+	givenMapper := &defaultLevelMapper{}
+
+	actual := NewLevelMapperFacade(func() LevelMapper {
+		return givenMapper
+	})
+
+	assert.ToBeSame(t, givenMapper, actual.(levelMapperFacade).Unwrap())
+
+	actualLevel, actualErr := actual.FromLogrus(logrus.WarnLevel)
+	assert.ToBeNoError(t, actualErr)
+	assert.ToBeEqual(t, level.Warn, actualLevel)
+
+	actualLogrusLevel, actualErr := actual.ToLogrus(level.Warn)
+	assert.ToBeNoError(t, actualErr)
+	assert.ToBeEqual(t, logrus.WarnLevel, actualLogrusLevel)
+}
@@ -0,0 +1,59 @@
+package logrus
+
+import (
+	"io"
+
+	"github.com/sirupsen/logrus"
+
+	log "github.com/echocat/slf4g"
+)
+
+// New creates an instance of logrus.Logger using the given target logger.
+// If the target logger is nil, the result of log.GetRootLogger() will be used.
+func New(target log.CoreLogger, customizer ...func(*Hook)) *logrus.Logger {
+	result := logrus.New()
+	ConfigureLogger(result, target, customizer...)
+	return result
+}
+
+// Configure configures the logrus.StandardLogger() to use slf4g with the
+// result of log.GetRootLogger().
+func Configure(customizer ...func(*Hook)) {
+	ConfigureWith(log.GetRootLogger(), customizer...)
+}
+
+// ConfigureWith configures the logrus.StandardLogger() to use slf4g with the
+// given target logger.
+// If the target logger is nil, the result of log.GetRootLogger() will be used.
+func ConfigureWith(target log.CoreLogger, customizer ...func(*Hook)) {
+	ConfigureLogger(logrus.StandardLogger(), target, customizer...)
+}
+
+// ConfigureLogger configures the given logrus.Logger to use slf4g with the
+// given target logger.
+// If the target logger is nil, the result of log.GetRootLogger() will be used.
+//
+// All existing hooks of the given logger will be replaced by one Hook. The
+// output of the logger will be discarded and its level is set to
+// logrus.TraceLevel, because the decision if an entry should be logged or not
+// is now up to the target logger.
+func ConfigureLogger(logger *logrus.Logger, target log.CoreLogger, customizer ...func(*Hook)) {
+	hooks := logrus.LevelHooks{}
+	hooks.Add(NewHook(target, customizer...))
+
+	logger.ReplaceHooks(hooks)
+	logger.SetOutput(io.Discard)
+	logger.SetFormatter(DiscardingFormatter)
+	logger.SetLevel(logrus.TraceLevel)
+}
+
+// DiscardingFormatter is an implementation of logrus.Formatter which does not
+// format anything. It is used by ConfigureLogger() because everything is
+// already forwarded by the Hook.
+var DiscardingFormatter logrus.Formatter = discardingFormatter{}
+
+type discardingFormatter struct{}
+
+func (discardingFormatter) Format(*logrus.Entry) ([]byte, error) {
+	return nil, nil
+}
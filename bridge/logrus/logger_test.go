@@ -0,0 +1,83 @@
+package logrus
+
+import (
+	"io"
+	"testing"
+
+	"github.com/sirupsen/logrus"
+
+	log "github.com/echocat/slf4g"
+	"github.com/echocat/slf4g/internal/test/assert"
+	"github.com/echocat/slf4g/level"
+	"github.com/echocat/slf4g/testing/recording"
+)
+
+func TestNew(t *testing.T) {
+	aLogger := recording.NewCoreLogger()
+
+	actual := New(aLogger)
+	assert.ToBeNotNil(t, actual)
+	assertConfigured(t, actual, aLogger)
+	assert.ToBeSame(t, aLogger, actual.Hooks[logrus.InfoLevel][0].(*Hook).Delegate)
+
+	actual.WithField("foo", "bar").Warn("aMessage")
+
+	assert.ToBeEqual(t, 1, aLogger.Len())
+	actualEvent := aLogger.Get(0)
+	assert.ToBeEqual(t, level.Warn, actualEvent.GetLevel())
+	actualFoo, _ := actualEvent.Get("foo")
+	assert.ToBeEqual(t, "bar", actualFoo)
+	assert.ToBeEqual(t, "aMessage", *log.GetMessageOf(actualEvent, aLogger.GetProvider()))
+}
+
+func TestConfigure(t *testing.T) {
+	defer restoreStandardLogger()()
+
+	Configure()
+
+	assertConfigured(t, logrus.StandardLogger(), log.GetRootLogger())
+}
+
+func TestConfigureWith(t *testing.T) {
+	defer restoreStandardLogger()()
+
+	aLogger := recording.NewCoreLogger()
+
+	ConfigureWith(aLogger)
+
+	assertConfigured(t, logrus.StandardLogger(), aLogger)
+	assert.ToBeSame(t, aLogger, logrus.StandardLogger().Hooks[logrus.InfoLevel][0].(*Hook).Delegate)
+}
+
+func Test_discardingFormatter_Format(t *testing.T) {
+	actual, actualErr := DiscardingFormatter.Format(&logrus.Entry{})
+	assert.ToBeNoError(t, actualErr)
+	assert.ToBeNil(t, actual)
+}
+
+func assertConfigured(t testing.TB, logger *logrus.Logger, expectedDelegate log.CoreLogger) {
+	t.Helper()
+
+	assert.ToBeEqual(t, io.Discard, logger.Out)
+	assert.ToBeEqual(t, DiscardingFormatter, logger.Formatter)
+	assert.ToBeEqual(t, logrus.TraceLevel, logger.GetLevel())
+	for _, l := range logrus.AllLevels {
+		hooks := logger.Hooks[l]
+		assert.ToBeEqual(t, 1, len(hooks))
+		assert.ToBeOfType(t, (*Hook)(nil), hooks[0])
+		assert.ToBeOfType(t, expectedDelegate, hooks[0].(*Hook).Delegate)
+	}
+}
+
+func restoreStandardLogger() func() {
+	logger := logrus.StandardLogger()
+	out, formatter, lvl := logger.Out, logger.Formatter, logger.GetLevel()
+	hooks := logger.ReplaceHooks(logrus.LevelHooks{})
+	logger.ReplaceHooks(hooks)
+	return func() {
+		logger.SetOutput(out)
+		logger.SetFormatter(formatter)
+		logger.SetLevel(lvl)
+		logger.ReplaceHooks(hooks)
+	}
+}
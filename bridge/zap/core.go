@@ -0,0 +1,216 @@
+package zap
+
+import (
+	"fmt"
+
+	"go.uber.org/zap/zapcore"
+
+	log "github.com/echocat/slf4g"
+	"github.com/echocat/slf4g/fields"
+	"github.com/echocat/slf4g/level"
+)
+
+// NewCore creates a new instance of Core which forwards everything to the
+// given target.
+func NewCore(target log.CoreLogger, customizer ...func(*Core)) *Core {
+	result := &Core{
+		Delegate: target,
+	}
+
+	for _, c := range customizer {
+		c(result)
+	}
+
+	return result
+}
+
+// Core is an implementation of zapcore.Core which forwards all of its entries
+// to a log.CoreLogger of slf4g.
+type Core struct {
+	// Delegate is the log.CoreLogger of the slf4g framework where to forward
+	// all written entries of this implementation to.
+	//
+	// If empty the result of log.GetRootLogger() will be used.
+	Delegate log.CoreLogger
+
+	// LevelMapper holds the mapper which is used to transform the levels
+	// between zapcore.Level and level.Level.
+	//
+	// If empty DefaultLevelMapper will be used.
+	LevelMapper LevelMapper
+
+	// DetectSkipFrames defines for how much frames an element log.Event should
+	// be skipped while reporting to Core.Delegate.
+	//
+	// If empty DefaultDetectSkipFrames will be used.
+	DetectSkipFrames DetectSkipFrames
+
+	// RespectLoggerName defines if the name of a zap logger (see
+	// zap.Logger.Named()) should be used to retrieve the logger of slf4g
+	// with the same name from the log.Provider of Core.Delegate. If false
+	// (default) everything will be logged to Core.Delegate directly.
+	RespectLoggerName bool
+
+	fields         fields.Fields
+	fieldKeyPrefix string
+}
+
+// Enabled implements zapcore.Core.Enabled()
+func (instance *Core) Enabled(zl zapcore.Level) bool {
+	l, err := instance.mapFromZapLevel(zl)
+	if err != nil {
+		return false
+	}
+
+	return instance.getDelegate().IsLevelEnabled(l)
+}
+
+// With implements zapcore.Core.With()
+func (instance *Core) With(fs []zapcore.Field) zapcore.Core {
+	fds, prefix := instance.convertFields(fs)
+
+	return &Core{
+		Delegate:          instance.Delegate,
+		LevelMapper:       instance.LevelMapper,
+		DetectSkipFrames:  instance.DetectSkipFrames,
+		RespectLoggerName: instance.RespectLoggerName,
+		fields:            fds,
+		fieldKeyPrefix:    prefix,
+	}
+}
+
+// Check implements zapcore.Core.Check()
+func (instance *Core) Check(entry zapcore.Entry, ce *zapcore.CheckedEntry) *zapcore.CheckedEntry {
+	l, err := instance.mapFromZapLevel(entry.Level)
+	if err != nil {
+		return ce
+	}
+	if !instance.loggerFor(entry).IsLevelEnabled(l) {
+		return ce
+	}
+	return ce.AddCore(entry, instance)
+}
+
+// Write implements zapcore.Core.Write()
+func (instance *Core) Write(entry zapcore.Entry, fs []zapcore.Field) error {
+	logger := instance.loggerFor(entry)
+	helperOf(logger)()
+
+	l, err := instance.mapFromZapLevel(entry.Level)
+	if err != nil {
+		return err
+	}
+
+	e := log.NewEventWithFields(logger, l, instance.fieldsOfEntry(logger, entry, fs))
+
+	skipFrames := instance.getDetectSkipFrames()(1)
+	logger.Log(e, skipFrames)
+	return nil
+}
+
+// Sync implements zapcore.Core.Sync()
+func (instance *Core) Sync() error {
+	return nil
+}
+
+func (instance *Core) fieldsOfEntry(logger log.CoreLogger, entry zapcore.Entry, fs []zapcore.Field) fields.Fields {
+	fdsSpec := logger.GetProvider().GetFieldKeysSpec()
+
+	base := map[string]interface{}{
+		fdsSpec.GetMessage(): entry.Message,
+	}
+	if !entry.Time.IsZero() {
+		base[fdsSpec.GetTimestamp()] = entry.Time
+	}
+	if entry.Stack != "" {
+		base["stack"] = entry.Stack
+	}
+
+	result := fields.WithAll(base)
+	if len(fs) > 0 {
+		fds, _ := instance.convertFields(fs)
+		return fields.NewLineage(result, fds)
+	}
+	if parent := instance.fields; parent != nil {
+		return fields.NewLineage(result, parent)
+	}
+	return result
+}
+
+func (instance *Core) convertFields(fs []zapcore.Field) (fields.Fields, string) {
+	prefix := instance.fieldKeyPrefix
+	errorKey := instance.getDelegate().GetProvider().GetFieldKeysSpec().GetError()
+	vs := map[string]interface{}{}
+
+	for _, f := range fs {
+		switch f.Type {
+		case zapcore.SkipType:
+		case zapcore.NamespaceType:
+			prefix += f.Key + "."
+		case zapcore.ErrorType:
+			key := f.Key
+			if key == "error" {
+				key = errorKey
+			}
+			vs[prefix+key] = f.Interface
+		default:
+			enc := zapcore.NewMapObjectEncoder()
+			f.AddTo(enc)
+			for k, v := range enc.Fields {
+				vs[prefix+k] = v
+			}
+		}
+	}
+
+	var result fields.Fields = fields.WithAll(vs)
+	if parent := instance.fields; parent != nil {
+		result = fields.NewLineage(result, parent)
+	}
+	return result, prefix
+}
+
+func (instance *Core) loggerFor(entry zapcore.Entry) log.CoreLogger {
+	delegate := instance.getDelegate()
+	if instance.RespectLoggerName && entry.LoggerName != "" {
+		return delegate.GetProvider().GetLogger(entry.LoggerName)
+	}
+	return delegate
+}
+
+func (instance *Core) mapFromZapLevel(zl zapcore.Level) (level.Level, error) {
+	l, err := instance.getLevelMapper().FromZap(zl)
+	if err != nil {
+		return 0, fmt.Errorf("cannot map zap's level %d to slf4g's level: %w", zl, err)
+	}
+	return l, nil
+}
+
+func (instance *Core) getDelegate() log.CoreLogger {
+	if v := instance.Delegate; v != nil {
+		return v
+	}
+	return log.GetRootLogger()
+}
+
+func (instance *Core) getLevelMapper() LevelMapper {
+	if v := instance.LevelMapper; v != nil {
+		return v
+	}
+	return DefaultLevelMapper
+}
+
+func (instance *Core) getDetectSkipFrames() DetectSkipFrames {
+	if v := instance.DetectSkipFrames; v != nil {
+		return v
+	}
+	return DefaultDetectSkipFrames
+}
+
+func helperOf(instance log.CoreLogger) func() {
+	if wh, ok := instance.(interface {
+		Helper() func()
+	}); ok {
+		return wh.Helper()
+	}
+	return func() {}
+}
@@ -0,0 +1,198 @@
+package zap
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"go.uber.org/zap/zapcore"
+
+	log "github.com/echocat/slf4g"
+	"github.com/echocat/slf4g/fields"
+	"github.com/echocat/slf4g/internal/test/assert"
+	"github.com/echocat/slf4g/level"
+	"github.com/echocat/slf4g/testing/recording"
+)
+
+func TestNewCore(t *testing.T) {
+	aLogger := recording.NewCoreLogger()
+
+	actual := NewCore(aLogger, func(v *Core) {
+		v.fieldKeyPrefix = "foo"
+	})
+
+	assert.ToBeNotNil(t, actual)
+	assert.ToBeSame(t, aLogger, actual.Delegate)
+	assert.ToBeEqual(t, "foo", actual.fieldKeyPrefix)
+}
+
+func TestCore_Enabled(t *testing.T) {
+	aLogger := recording.NewCoreLogger()
+	aLogger.SetLevel(level.Warn)
+
+	instance := &Core{
+		Delegate: aLogger,
+	}
+
+	cases := []struct {
+		givenLevel zapcore.Level
+		expected   bool
+	}{
+		{LevelDebug, false},
+		{LevelInfo, false},
+		{LevelWarn, true},
+		{zapcore.PanicLevel, true},
+		{LevelFatal, true},
+		{LevelFatal + 10, false},
+	}
+
+	for _, c := range cases {
+		t.Run(c.givenLevel.String(), func(t *testing.T) {
+			actual := instance.Enabled(c.givenLevel)
+			assert.ToBeEqual(t, c.expected, actual)
+		})
+	}
+}
+
+func TestCore_Check(t *testing.T) {
+	aLogger := recording.NewCoreLogger()
+	aLogger.SetLevel(level.Warn)
+
+	instance := &Core{
+		Delegate: aLogger,
+	}
+
+	actual := instance.Check(zapcore.Entry{Level: LevelInfo}, nil)
+	assert.ToBeNil(t, actual)
+
+	actual = instance.Check(zapcore.Entry{Level: LevelWarn}, nil)
+	assert.ToBeNotNil(t, actual)
+}
+
+func TestCore_Write(t *testing.T) {
+	aTime, err := time.Parse(time.RFC3339, "2025-10-01T15:30:15Z")
+	assert.ToBeNoError(t, err)
+
+	cases := []struct {
+		name          string
+		givenLevel    zapcore.Level
+		expectedLevel level.Level
+		expectedError string
+	}{
+		{"regular", LevelInfo, level.Info, ""},
+		{"failing", 66, 0, "unknown zap level: 66"},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			baseLogger := recording.NewCoreLogger()
+			helperWasCalled := false
+
+			instance := &Core{
+				Delegate: &delegateCoreLoggerWithHelper{baseLogger, func() {
+					helperWasCalled = true
+				}},
+				DetectSkipFrames: func(skip uint16) uint16 {
+					return skip + 1
+				},
+			}
+
+			actualErr := instance.Write(zapcore.Entry{
+				Time:    aTime,
+				Message: "aMessage",
+				Level:   c.givenLevel,
+			}, []zapcore.Field{{Key: "foo", Type: zapcore.StringType, String: "bar"}})
+			assert.ToBeEqual(t, true, helperWasCalled)
+			if expectedErr := c.expectedError; expectedErr == "" {
+				assert.ToBeEqual(t, 1, baseLogger.Len())
+
+				actual := baseLogger.Get(0)
+				assert.ToBeEqual(t, c.expectedLevel, actual.GetLevel())
+
+				actualAsMap, err := fields.AsMap(actual)
+				assert.ToBeNoError(t, err)
+
+				assert.ToBeEqual(t, map[string]interface{}{
+					"logger":    baseLogger,
+					"timestamp": aTime,
+					"message":   "aMessage",
+					"foo":       "bar",
+				}, actualAsMap)
+			} else {
+				assert.ToBeMatching(t, expectedErr, actualErr)
+				assert.ToBeEqual(t, 0, baseLogger.Len())
+			}
+		})
+	}
+}
+
+func TestCore_Write_respectsLoggerName(t *testing.T) {
+	provider := recording.NewProvider()
+	instance := &Core{
+		Delegate:          provider.GetRootLogger(),
+		RespectLoggerName: true,
+	}
+
+	actualErr := instance.Write(zapcore.Entry{
+		LoggerName: "foo.bar",
+		Message:    "aMessage",
+		Level:      LevelWarn,
+	}, nil)
+	assert.ToBeNoError(t, actualErr)
+
+	assert.ToBeEqual(t, 0, len(provider.GetAllRoot()))
+	assert.ToBeEqual(t, 1, len(provider.GetAllOf("foo.bar")))
+}
+
+func TestCore_With(t *testing.T) {
+	aTime, err := time.Parse(time.RFC3339, "2025-10-01T15:30:15Z")
+	assert.ToBeNoError(t, err)
+	anError := errors.New("expected")
+	baseLogger := recording.NewCoreLogger()
+
+	instance := (&Core{
+		Delegate: baseLogger,
+		DetectSkipFrames: func(skip uint16) uint16 {
+			return skip + 1
+		},
+	}).With([]zapcore.Field{
+		{Key: "a", Type: zapcore.Int64Type, Integer: 1},
+		{Key: "error", Type: zapcore.ErrorType, Interface: anError},
+		{Key: "ns", Type: zapcore.NamespaceType},
+	}).With([]zapcore.Field{
+		{Key: "b", Type: zapcore.BoolType, Integer: 1},
+		{Key: "skipped", Type: zapcore.SkipType},
+	})
+
+	actualErr := instance.Write(zapcore.Entry{
+		Time:    aTime,
+		Message: "aMessage",
+		Level:   LevelError,
+	}, []zapcore.Field{
+		{Key: "c", Type: zapcore.StringType, String: "foo"},
+	})
+	assert.ToBeNoError(t, actualErr)
+
+	assert.ToBeEqual(t, 1, baseLogger.Len())
+	actualAsMap, err := fields.AsMap(baseLogger.Get(0))
+	assert.ToBeNoError(t, err)
+
+	assert.ToBeEqual(t, map[string]interface{}{
+		"logger":    baseLogger,
+		"timestamp": aTime,
+		"message":   "aMessage",
+		"a":         int64(1),
+		"error":     anError,
+		"ns.b":      true,
+		"ns.c":      "foo",
+	}, actualAsMap)
+}
+
+type delegateCoreLoggerWithHelper struct {
+	log.CoreLogger
+	helper func()
+}
+
+func (instance delegateCoreLoggerWithHelper) Helper() func() {
+	return instance.helper
+}
@@ -0,0 +1,20 @@
+// Package zap provides methods to use slf4g as the backend of
+// go.uber.org/zap ([go.uber.org/zap.Logger]).
+//
+// It lives in its own Go module to keep the core of slf4g free of any
+// dependencies.
+//
+// # Usage
+//
+// The simplest way is to create a [go.uber.org/zap.Logger] which forwards
+// all of its entries to the root logger of slf4g:
+//
+//	logger := zap.New(nil)
+//
+// ... or to replace the global loggers of zap:
+//
+//	zap.Configure()
+//
+// If you need only the [go.uber.org/zap/zapcore.Core] (for example to
+// tee it together with other cores) use [NewCore].
+package zap
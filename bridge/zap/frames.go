@@ -0,0 +1,18 @@
+package zap
+
+import "github.com/echocat/slf4g/internal/frames"
+
+// DetectSkipFrames defines a function handler to detect how many frames should be
+// skipped while creating the log message.
+type DetectSkipFrames func(skip uint16) uint16
+
+// DefaultDetectSkipFrames is the default setting for DetectSkipFrames.
+//
+// By default, it ignores several relevant packages of the SDK, of zap and this
+// package.
+var DefaultDetectSkipFrames DetectSkipFrames = frames.NewDetector(
+	"testing",
+	"go.uber.org/zap",
+	"go.uber.org/zap/zapcore",
+	"github.com/echocat/slf4g/bridge/zap",
+)
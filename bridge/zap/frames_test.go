@@ -0,0 +1,47 @@
+package zap_test
+
+import (
+	"testing"
+
+	"go.uber.org/zap"
+	"go.uber.org/zap/zapcore"
+
+	bridge "github.com/echocat/slf4g/bridge/zap"
+	"github.com/echocat/slf4g/internal/test/assert"
+)
+
+func Test_detectSkipFramesFromZap(t *testing.T) {
+	core := &dummyCore{detectSkipFramesUsing: bridge.DefaultDetectSkipFrames}
+
+	zap.New(core).Info("info")
+	assert.ToBeEqual(t, uint16(3), core.detectedSkipFrames)
+
+	zap.New(core).Sugar().Infof("info")
+	assert.ToBeEqual(t, uint16(4), core.detectedSkipFrames)
+}
+
+type dummyCore struct {
+	detectedSkipFrames    uint16
+	detectSkipFramesUsing bridge.DetectSkipFrames
+}
+
+func (instance *dummyCore) Enabled(zapcore.Level) bool {
+	return true
+}
+
+func (instance *dummyCore) With([]zapcore.Field) zapcore.Core {
+	panic("should never be called")
+}
+
+func (instance *dummyCore) Check(entry zapcore.Entry, ce *zapcore.CheckedEntry) *zapcore.CheckedEntry {
+	return ce.AddCore(entry, instance)
+}
+
+func (instance *dummyCore) Write(zapcore.Entry, []zapcore.Field) error {
+	instance.detectedSkipFrames = instance.detectSkipFramesUsing(1)
+	return nil
+}
+
+func (instance *dummyCore) Sync() error {
+	return nil
+}
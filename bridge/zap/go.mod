@@ -0,0 +1,12 @@
+module github.com/echocat/slf4g/bridge/zap
+
+go 1.23.0
+
+replace github.com/echocat/slf4g => ../../
+
+require (
+	github.com/echocat/slf4g v0.0.0
+	go.uber.org/zap v1.28.0
+)
+
+require go.uber.org/multierr v1.10.0 // indirect
@@ -0,0 +1,100 @@
+package zap
+
+import (
+	"fmt"
+
+	"go.uber.org/zap/zapcore"
+
+	"github.com/echocat/slf4g/level"
+)
+
+const (
+	// LevelTrace is the zapcore.Level which is used to represent level.Trace
+	// as zap itself does not know something like a trace level.
+	LevelTrace = zapcore.DebugLevel - 1
+	LevelDebug = zapcore.DebugLevel
+	LevelInfo  = zapcore.InfoLevel
+	LevelWarn  = zapcore.WarnLevel
+	LevelError = zapcore.ErrorLevel
+	LevelFatal = zapcore.FatalLevel
+)
+
+// LevelMapper transforms the levels between zapcore.Level and level.Level.
+type LevelMapper interface {
+	FromZap(zapcore.Level) (level.Level, error)
+	ToZap(level.Level) (zapcore.Level, error)
+}
+
+// DefaultLevelMapper is the default instance of LevelMapper which should cover
+// the most of the cases.
+var DefaultLevelMapper LevelMapper = NewLevelMapper()
+
+// NewLevelMapper creates a new instance of the default LevelMapper
+// implementation.
+//
+// zapcore.DPanicLevel will be mapped to level.Error and zapcore.PanicLevel to
+// level.Fatal. Everything lower than zapcore.DebugLevel is mapped to
+// level.Trace.
+func NewLevelMapper() LevelMapper {
+	return &defaultLevelMapper{}
+}
+
+type defaultLevelMapper struct{}
+
+func (instance *defaultLevelMapper) FromZap(v zapcore.Level) (level.Level, error) {
+	switch v {
+	case LevelDebug:
+		return level.Debug, nil
+	case LevelInfo:
+		return level.Info, nil
+	case LevelWarn:
+		return level.Warn, nil
+	case LevelError, zapcore.DPanicLevel:
+		return level.Error, nil
+	case zapcore.PanicLevel, LevelFatal:
+		return level.Fatal, nil
+	default:
+		if v < LevelDebug {
+			return level.Trace, nil
+		}
+		return 0, fmt.Errorf("unknown zap level: %d", v)
+	}
+}
+
+func (instance *defaultLevelMapper) ToZap(v level.Level) (zapcore.Level, error) {
+	switch v {
+	case level.Trace:
+		return LevelTrace, nil
+	case level.Debug:
+		return LevelDebug, nil
+	case level.Info:
+		return LevelInfo, nil
+	case level.Warn:
+		return LevelWarn, nil
+	case level.Error:
+		return LevelError, nil
+	case level.Fatal:
+		return LevelFatal, nil
+	default:
+		return 0, fmt.Errorf("unknown log level: %d", v)
+	}
+}
+
+// NewLevelMapperFacade creates a facade of LevelMapper using the given provider.
+func NewLevelMapperFacade(provider func() LevelMapper) LevelMapper {
+	return levelMapperFacade(provider)
+}
+
+type levelMapperFacade func() LevelMapper
+
+func (instance levelMapperFacade) FromZap(v zapcore.Level) (level.Level, error) {
+	return instance.Unwrap().FromZap(v)
+}
+
+func (instance levelMapperFacade) ToZap(v level.Level) (zapcore.Level, error) {
+	return instance.Unwrap().ToZap(v)
+}
+
+func (instance levelMapperFacade) Unwrap() LevelMapper {
+	return instance()
+}
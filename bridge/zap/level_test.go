@@ -0,0 +1,100 @@
+package zap
+
+import (
+	"fmt"
+	"testing"
+
+	"go.uber.org/zap/zapcore"
+
+	"github.com/echocat/slf4g/internal/test/assert"
+	"github.com/echocat/slf4g/level"
+)
+
+func TestNewLevelMapper(t *testing.T) {
+	actual := NewLevelMapper()
+	assert.ToBeNotNil(t, actual)
+	assert.ToBeOfType(t, (*defaultLevelMapper)(nil), actual)
+}
+
+func TestDefaultLevelMapper_FromZap(t *testing.T) {
+	instance := &defaultLevelMapper{}
+
+	cases := []struct {
+		input       zapcore.Level
+		expected    level.Level
+		expectedErr string
+	}{
+		{LevelTrace - 1, level.Trace, ""},
+		{LevelTrace, level.Trace, ""},
+		{LevelDebug, level.Debug, ""},
+		{LevelInfo, level.Info, ""},
+		{LevelWarn, level.Warn, ""},
+		{LevelError, level.Error, ""},
+		{zapcore.DPanicLevel, level.Error, ""},
+		{zapcore.PanicLevel, level.Fatal, ""},
+		{LevelFatal, level.Fatal, ""},
+		{66, 0, "unknown zap level: 66"},
+	}
+
+	for _, c := range cases {
+		t.Run(c.input.String(), func(t *testing.T) {
+			actual, actualErr := instance.FromZap(c.input)
+			if c.expectedErr == "" {
+				assert.ToBeNoError(t, actualErr)
+				assert.ToBeEqual(t, c.expected, actual)
+			} else {
+				assert.ToBeMatching(t, c.expectedErr, actualErr)
+				assert.ToBeEqual(t, level.Level(0), actual)
+			}
+		})
+	}
+}
+
+func TestDefaultLevelMapper_ToZap(t *testing.T) {
+	instance := &defaultLevelMapper{}
+
+	cases := []struct {
+		input       level.Level
+		expected    zapcore.Level
+		expectedErr string
+	}{
+		{level.Trace, LevelTrace, ""},
+		{level.Debug, LevelDebug, ""},
+		{level.Info, LevelInfo, ""},
+		{level.Warn, LevelWarn, ""},
+		{level.Error, LevelError, ""},
+		{level.Fatal, LevelFatal, ""},
+		{666, 0, "unknown log level: 666"},
+	}
+
+	for _, c := range cases {
+		t.Run(fmt.Sprint(c.input), func(t *testing.T) {
+			actual, actualErr := instance.ToZap(c.input)
+			if c.expectedErr == "" {
+				assert.ToBeNoError(t, actualErr)
+				assert.ToBeEqual(t, c.expected, actual)
+			} else {
+				assert.ToBeMatching(t, c.expectedErr, actualErr)
+				assert.ToBeEqual(t, zapcore.Level(0), actual)
+			}
+		})
+	}
+}
+
+func TestNewLevelMapperFacade(t *testing.T) {
+	givenMapper := &defaultLevelMapper{}
+
+	actual := NewLevelMapperFacade(func() LevelMapper {
+		return givenMapper
+	})
+
+	assert.ToBeSame(t, givenMapper, actual.(levelMapperFacade).Unwrap())
+
+	actualLevel, actualErr := actual.FromZap(LevelWarn)
+	assert.ToBeNoError(t, actualErr)
+	assert.ToBeEqual(t, level.Warn, actualLevel)
+
+	actualZapLevel, actualErr := actual.ToZap(level.Warn)
+	assert.ToBeNoError(t, actualErr)
+	assert.ToBeEqual(t, LevelWarn, actualZapLevel)
+}
@@ -0,0 +1,32 @@
+package zap
+
+import (
+	"go.uber.org/zap"
+
+	log "github.com/echocat/slf4g"
+)
+
+// New creates an instance of zap.Logger using the given target logger.
+// If the target logger is nil, the result of log.GetRootLogger() will be used.
+func New(target log.CoreLogger, customizer ...func(*Core)) *zap.Logger {
+	c := NewCore(target, customizer...)
+	return zap.New(c)
+}
+
+// Configure configures the global loggers of zap (see zap.L() and zap.S()) to
+// use slf4g with the result of log.GetRootLogger().
+//
+// The returned function restores the previous global loggers.
+func Configure(customizer ...func(*Core)) func() {
+	return ConfigureWith(log.GetRootLogger(), customizer...)
+}
+
+// ConfigureWith configures the global loggers of zap (see zap.L() and zap.S())
+// to use slf4g with the given target logger.
+// If the target logger is nil, the result of log.GetRootLogger() will be used.
+//
+// The returned function restores the previous global loggers.
+func ConfigureWith(target log.CoreLogger, customizer ...func(*Core)) func() {
+	logger := New(target, customizer...)
+	return zap.ReplaceGlobals(logger)
+}
@@ -0,0 +1,56 @@
+package zap
+
+import (
+	"testing"
+
+	"go.uber.org/zap"
+
+	log "github.com/echocat/slf4g"
+	"github.com/echocat/slf4g/internal/test/assert"
+	"github.com/echocat/slf4g/testing/recording"
+)
+
+func TestNew(t *testing.T) {
+	aLogger := recording.NewCoreLogger()
+
+	actual := New(aLogger, func(v *Core) {
+		v.fieldKeyPrefix = "foo"
+	})
+
+	assert.ToBeNotNil(t, actual)
+	actualCore := actual.Core()
+	assert.ToBeOfType(t, (*Core)(nil), actualCore)
+	cActualCore := actualCore.(*Core)
+	assert.ToBeSame(t, aLogger, cActualCore.Delegate)
+	assert.ToBeEqual(t, "foo", cActualCore.fieldKeyPrefix)
+}
+
+func TestConfigure(t *testing.T) {
+	rootLogger := log.GetRootLogger()
+
+	restore := Configure(func(v *Core) {
+		v.fieldKeyPrefix = "foo"
+	})
+	defer restore()
+
+	actualCore := zap.L().Core()
+	assert.ToBeOfType(t, (*Core)(nil), actualCore)
+	cActualCore := actualCore.(*Core)
+	assert.ToBeOfType(t, rootLogger, cActualCore.Delegate)
+	assert.ToBeEqual(t, "foo", cActualCore.fieldKeyPrefix)
+}
+
+func TestConfigureWith(t *testing.T) {
+	aLogger := recording.NewCoreLogger()
+
+	restore := ConfigureWith(aLogger, func(v *Core) {
+		v.fieldKeyPrefix = "foo"
+	})
+	defer restore()
+
+	actualCore := zap.S().Desugar().Core()
+	assert.ToBeOfType(t, (*Core)(nil), actualCore)
+	cActualCore := actualCore.(*Core)
+	assert.ToBeSame(t, aLogger, cActualCore.Delegate)
+	assert.ToBeEqual(t, "foo", cActualCore.fieldKeyPrefix)
+}
@@ -0,0 +1,21 @@
+// Package zerolog provides methods to use slf4g as the backend of
+// github.com/rs/zerolog ([github.com/rs/zerolog.Logger]).
+//
+// It lives in its own Go module to keep the core of slf4g free of any
+// dependencies.
+//
+// In contrast to [github.com/echocat/slf4g.LoggingWriter], which forwards
+// every written line as the message of an event, the [Writer] of this package
+// parses the JSON output of zerolog back into structured events. The level,
+// message, error, time and all other fields are preserved.
+//
+// # Usage
+//
+// The simplest way is to create a [github.com/rs/zerolog.Logger] which
+// forwards all of its events to the root logger of slf4g:
+//
+//	logger := zerolog.New(nil)
+//
+// If you need only the [io.Writer] (for example to combine it with other
+// writers using [github.com/rs/zerolog.MultiLevelWriter]) use [NewWriter].
+package zerolog
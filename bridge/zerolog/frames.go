@@ -0,0 +1,17 @@
+package zerolog
+
+import "github.com/echocat/slf4g/internal/frames"
+
+// DetectSkipFrames defines a function handler to detect how many frames should be
+// skipped while creating the log message.
+type DetectSkipFrames func(skip uint16) uint16
+
+// DefaultDetectSkipFrames is the default setting for DetectSkipFrames.
+//
+// By default, it ignores several relevant packages of the SDK, of zerolog and this
+// package.
+var DefaultDetectSkipFrames DetectSkipFrames = frames.NewDetector(
+	"testing",
+	"github.com/rs/zerolog",
+	"github.com/echocat/slf4g/bridge/zerolog",
+)
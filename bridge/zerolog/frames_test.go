@@ -0,0 +1,35 @@
+package zerolog_test
+
+import (
+	"runtime"
+	"testing"
+
+	"github.com/rs/zerolog"
+
+	bridge "github.com/echocat/slf4g/bridge/zerolog"
+	"github.com/echocat/slf4g/internal/test/assert"
+)
+
+func Test_detectSkipFramesFromZerolog(t *testing.T) {
+	w := &dummyWriter{detectSkipFramesUsing: bridge.DefaultDetectSkipFrames}
+
+	logger := zerolog.New(w)
+
+	logger.Info().Msg("info")
+	assert.ToBeEqual(t, "github.com/echocat/slf4g/bridge/zerolog_test.Test_detectSkipFramesFromZerolog", w.detectedFunction)
+
+	logger.Info().Str("foo", "bar").Send()
+	assert.ToBeEqual(t, "github.com/echocat/slf4g/bridge/zerolog_test.Test_detectSkipFramesFromZerolog", w.detectedFunction)
+}
+
+type dummyWriter struct {
+	detectedFunction      string
+	detectSkipFramesUsing bridge.DetectSkipFrames
+}
+
+func (instance *dummyWriter) Write(p []byte) (int, error) {
+	if pc, _, _, ok := runtime.Caller(int(instance.detectSkipFramesUsing(1))); ok {
+		instance.detectedFunction = runtime.FuncForPC(pc).Name()
+	}
+	return len(p), nil
+}
@@ -0,0 +1,88 @@
+package zerolog
+
+import (
+	"fmt"
+
+	"github.com/rs/zerolog"
+
+	"github.com/echocat/slf4g/level"
+)
+
+// LevelMapper transforms the levels between zerolog.Level and level.Level.
+type LevelMapper interface {
+	FromZerolog(zerolog.Level) (level.Level, error)
+	ToZerolog(level.Level) (zerolog.Level, error)
+}
+
+// DefaultLevelMapper is the default instance of LevelMapper which should cover
+// the most of the cases.
+var DefaultLevelMapper LevelMapper = NewLevelMapper()
+
+// NewLevelMapper creates a new instance of the default LevelMapper
+// implementation.
+//
+// zerolog.PanicLevel will be mapped to level.Fatal, because slf4g does not know
+// something like a panic level. Events without a level (zerolog.NoLevel, see
+// zerolog.Logger.Log()) will be mapped to level.Info.
+func NewLevelMapper() LevelMapper {
+	return &defaultLevelMapper{}
+}
+
+type defaultLevelMapper struct{}
+
+func (instance *defaultLevelMapper) FromZerolog(v zerolog.Level) (level.Level, error) {
+	switch v {
+	case zerolog.TraceLevel:
+		return level.Trace, nil
+	case zerolog.DebugLevel:
+		return level.Debug, nil
+	case zerolog.InfoLevel, zerolog.NoLevel:
+		return level.Info, nil
+	case zerolog.WarnLevel:
+		return level.Warn, nil
+	case zerolog.ErrorLevel:
+		return level.Error, nil
+	case zerolog.FatalLevel, zerolog.PanicLevel:
+		return level.Fatal, nil
+	default:
+		return 0, fmt.Errorf("unknown zerolog level: %d", v)
+	}
+}
+
+func (instance *defaultLevelMapper) ToZerolog(v level.Level) (zerolog.Level, error) {
+	switch v {
+	case level.Trace:
+		return zerolog.TraceLevel, nil
+	case level.Debug:
+		return zerolog.DebugLevel, nil
+	case level.Info:
+		return zerolog.InfoLevel, nil
+	case level.Warn:
+		return zerolog.WarnLevel, nil
+	case level.Error:
+		return zerolog.ErrorLevel, nil
+	case level.Fatal:
+		return zerolog.FatalLevel, nil
+	default:
+		return 0, fmt.Errorf("unknown log level: %d", v)
+	}
+}
+
+// NewLevelMapperFacade creates a facade of LevelMapper using the given provider.
+func NewLevelMapperFacade(provider func() LevelMapper) LevelMapper {
+	return levelMapperFacade(provider)
+}
+
+type levelMapperFacade func() LevelMapper
+
+func (instance levelMapperFacade) FromZerolog(v zerolog.Level) (level.Level, error) {
+	return instance.Unwrap().FromZerolog(v)
+}
+
+func (instance levelMapperFacade) ToZerolog(v level.Level) (zerolog.Level, error) {
+	return instance.Unwrap().ToZerolog(v)
+}
+
+func (instance levelMapperFacade) Unwrap() LevelMapper {
+	return instance()
+}
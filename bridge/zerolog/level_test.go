@@ -0,0 +1,99 @@
+package zerolog
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/rs/zerolog"
+
+	"github.com/echocat/slf4g/internal/test/assert"
+	"github.com/echocat/slf4g/level"
+)
+
+func TestNewLevelMapper(t *testing.T) {
+	actual := NewLevelMapper()
+	assert.ToBeNotNil(t, actual)
+	assert.ToBeOfType(t, (*defaultLevelMapper)(nil), actual)
+}
+
+func TestDefaultLevelMapper_FromZerolog(t *testing.T) {
+	instance := &defaultLevelMapper{}
+
+	cases := []struct {
+		input       zerolog.Level
+		expected    level.Level
+		expectedErr string
+	}{
+		{zerolog.TraceLevel, level.Trace, ""},
+		{zerolog.DebugLevel, level.Debug, ""},
+		{zerolog.InfoLevel, level.Info, ""},
+		{zerolog.WarnLevel, level.Warn, ""},
+		{zerolog.ErrorLevel, level.Error, ""},
+		{zerolog.FatalLevel, level.Fatal, ""},
+		{zerolog.PanicLevel, level.Fatal, ""},
+		{zerolog.NoLevel, level.Info, ""},
+		{66, 0, "unknown zerolog level: 66"},
+	}
+
+	for _, c := range cases {
+		t.Run(fmt.Sprint(int8(c.input)), func(t *testing.T) {
+			actual, actualErr := instance.FromZerolog(c.input)
+			if c.expectedErr == "" {
+				assert.ToBeNoError(t, actualErr)
+				assert.ToBeEqual(t, c.expected, actual)
+			} else {
+				assert.ToBeMatching(t, c.expectedErr, actualErr)
+				assert.ToBeEqual(t, level.Level(0), actual)
+			}
+		})
+	}
+}
+
+func TestDefaultLevelMapper_ToZerolog(t *testing.T) {
+	instance := &defaultLevelMapper{}
+
+	cases := []struct {
+		input       level.Level
+		expected    zerolog.Level
+		expectedErr string
+	}{
+		{level.Trace, zerolog.TraceLevel, ""},
+		{level.Debug, zerolog.DebugLevel, ""},
+		{level.Info, zerolog.InfoLevel, ""},
+		{level.Warn, zerolog.WarnLevel, ""},
+		{level.Error, zerolog.ErrorLevel, ""},
+		{level.Fatal, zerolog.FatalLevel, ""},
+		{666, 0, "unknown log level: 666"},
+	}
+
+	for _, c := range cases {
+		t.Run(fmt.Sprint(c.input), func(t *testing.T) {
+			actual, actualErr := instance.ToZerolog(c.input)
+			if c.expectedErr == "" {
+				assert.ToBeNoError(t, actualErr)
+				assert.ToBeEqual(t, c.expected, actual)
+			} else {
+				assert.ToBeMatching(t, c.expectedErr, actualErr)
+				assert.ToBeEqual(t, zerolog.Level(0), actual)
+			}
+		})
+	}
+}
+
+func TestNewLevelMapperFacade(t *testing.T) {
+	givenMapper := &defaultLevelMapper{}
+
+	actual := NewLevelMapperFacade(func() LevelMapper {
+		return givenMapper
+	})
+
+	assert.ToBeSame(t, givenMapper, actual.(levelMapperFacade).Unwrap())
+
+	actualLevel, actualErr := actual.FromZerolog(zerolog.WarnLevel)
+	assert.ToBeNoError(t, actualErr)
+	assert.ToBeEqual(t, level.Warn, actualLevel)
+
+	actualZerologLevel, actualErr := actual.ToZerolog(level.Warn)
+	assert.ToBeNoError(t, actualErr)
+	assert.ToBeEqual(t, zerolog.WarnLevel, actualZerologLevel)
+}
@@ -0,0 +1,17 @@
+package zerolog
+
+import (
+	"github.com/rs/zerolog"
+
+	log "github.com/echocat/slf4g"
+)
+
+// New creates an instance of zerolog.Logger using the given target logger.
+// If the target logger is nil, the result of log.GetRootLogger() will be used.
+//
+// The level of the returned logger is set to zerolog.TraceLevel, because the
+// decision if an event should be logged or not is up to the target logger.
+func New(target log.CoreLogger, customizer ...func(*Writer)) zerolog.Logger {
+	w := NewWriter(target, customizer...)
+	return zerolog.New(w).Level(zerolog.TraceLevel)
+}
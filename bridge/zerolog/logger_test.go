@@ -0,0 +1,32 @@
+package zerolog
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/rs/zerolog"
+
+	log "github.com/echocat/slf4g"
+	"github.com/echocat/slf4g/internal/test/assert"
+	"github.com/echocat/slf4g/level"
+	"github.com/echocat/slf4g/testing/recording"
+)
+
+func TestNew(t *testing.T) {
+	aLogger := recording.NewCoreLogger()
+	anError := errors.New("expected")
+
+	actual := New(aLogger)
+	assert.ToBeEqual(t, zerolog.TraceLevel, actual.GetLevel())
+
+	actual.Warn().Str("foo", "bar").Err(anError).Msg("aMessage")
+
+	assert.ToBeEqual(t, 1, aLogger.Len())
+	actualEvent := aLogger.Get(0)
+	assert.ToBeEqual(t, level.Warn, actualEvent.GetLevel())
+	actualFoo, _ := actualEvent.Get("foo")
+	assert.ToBeEqual(t, "bar", actualFoo)
+	actualErr := log.GetErrorOf(actualEvent, aLogger.GetProvider())
+	assert.ToBeEqual(t, "expected", actualErr.Error())
+	assert.ToBeEqual(t, "aMessage", *log.GetMessageOf(actualEvent, aLogger.GetProvider()))
+}
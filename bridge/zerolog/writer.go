@@ -0,0 +1,240 @@
+package zerolog
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/rs/zerolog"
+
+	log "github.com/echocat/slf4g"
+	"github.com/echocat/slf4g/fields"
+	"github.com/echocat/slf4g/level"
+)
+
+// NewWriter creates a new instance of Writer which forwards everything to the
+// given target.
+func NewWriter(target log.CoreLogger, customizer ...func(*Writer)) *Writer {
+	result := &Writer{
+		Delegate: target,
+	}
+
+	for _, c := range customizer {
+		c(result)
+	}
+
+	return result
+}
+
+// Writer is an implementation of io.Writer and zerolog.LevelWriter which
+// parses the JSON written by zerolog and forwards it as structured events to
+// a log.CoreLogger of slf4g.
+//
+// The field names are resolved using zerolog.LevelFieldName,
+// zerolog.MessageFieldName, zerolog.ErrorFieldName and
+// zerolog.TimestampFieldName. The time is parsed using
+// zerolog.TimeFieldFormat.
+type Writer struct {
+	// Delegate is the log.CoreLogger of the slf4g framework where to forward
+	// all written events of this implementation to.
+	//
+	// If empty the result of log.GetRootLogger() will be used.
+	Delegate log.CoreLogger
+
+	// LevelMapper holds the mapper which is used to transform the levels
+	// between zerolog.Level and level.Level.
+	//
+	// If empty DefaultLevelMapper will be used.
+	LevelMapper LevelMapper
+
+	// DetectSkipFrames defines for how much frames an element log.Event should
+	// be skipped while reporting to Writer.Delegate.
+	//
+	// If empty DefaultDetectSkipFrames will be used.
+	DetectSkipFrames DetectSkipFrames
+}
+
+// Write implements io.Writer.Write()
+//
+// The level is taken from the field zerolog.LevelFieldName of the written
+// event.
+func (instance *Writer) Write(p []byte) (int, error) {
+	return instance.write(zerolog.NoLevel, p)
+}
+
+// WriteLevel implements zerolog.LevelWriter.WriteLevel()
+func (instance *Writer) WriteLevel(zl zerolog.Level, p []byte) (int, error) {
+	return instance.write(zl, p)
+}
+
+func (instance *Writer) write(zl zerolog.Level, p []byte) (int, error) {
+	delegate := instance.getDelegate()
+	helperOf(delegate)()
+
+	vs, err := instance.parse(p)
+	if err != nil {
+		// This is not a valid JSON document; forward it as a plain message.
+		vs = map[string]interface{}{
+			zerolog.MessageFieldName: string(bytes.TrimSpace(p)),
+		}
+	}
+
+	if lv, ok := vs[zerolog.LevelFieldName]; ok {
+		delete(vs, zerolog.LevelFieldName)
+		if zl == zerolog.NoLevel {
+			if slv, ok := lv.(string); ok {
+				if plv, err := zerolog.ParseLevel(slv); err == nil {
+					zl = plv
+				}
+			}
+		}
+	}
+
+	l, err := instance.mapFromZerologLevel(zl)
+	if err != nil {
+		return 0, err
+	}
+	if !delegate.IsLevelEnabled(l) {
+		return len(p), nil
+	}
+
+	e := log.NewEventWithFields(delegate, l, instance.fieldsOf(delegate, vs))
+
+	skipFrames := instance.getDetectSkipFrames()(1)
+	delegate.Log(e, skipFrames)
+	return len(p), nil
+}
+
+func (instance *Writer) parse(p []byte) (map[string]interface{}, error) {
+	decoder := json.NewDecoder(bytes.NewReader(p))
+	decoder.UseNumber()
+
+	var result map[string]interface{}
+	if err := decoder.Decode(&result); err != nil {
+		return nil, err
+	}
+	if result == nil {
+		return nil, errors.New("not a JSON object")
+	}
+	return result, nil
+}
+
+func (instance *Writer) fieldsOf(logger log.CoreLogger, vs map[string]interface{}) fields.Fields {
+	fdsSpec := logger.GetProvider().GetFieldKeysSpec()
+
+	result := make(map[string]interface{}, len(vs))
+	for k, v := range vs {
+		v = normalizeValue(v)
+		switch k {
+		case zerolog.MessageFieldName:
+			k = fdsSpec.GetMessage()
+		case zerolog.ErrorFieldName:
+			k = fdsSpec.GetError()
+			if sv, ok := v.(string); ok {
+				v = errors.New(sv)
+			}
+		case zerolog.TimestampFieldName:
+			if tv, ok := parseTime(v); ok {
+				k, v = fdsSpec.GetTimestamp(), tv
+			}
+		}
+		result[k] = v
+	}
+
+	return fields.WithAll(result)
+}
+
+func normalizeValue(v interface{}) interface{} {
+	switch tv := v.(type) {
+	case json.Number:
+		if iv, err := tv.Int64(); err == nil {
+			return iv
+		}
+		if fv, err := tv.Float64(); err == nil {
+			return fv
+		}
+		return tv.String()
+	case map[string]interface{}:
+		for k, sv := range tv {
+			tv[k] = normalizeValue(sv)
+		}
+		return tv
+	case []interface{}:
+		for i, sv := range tv {
+			tv[i] = normalizeValue(sv)
+		}
+		return tv
+	default:
+		return v
+	}
+}
+
+func parseTime(v interface{}) (time.Time, bool) {
+	switch tv := v.(type) {
+	case string:
+		format := zerolog.TimeFieldFormat
+		if format == "" || format == zerolog.TimeFormatUnix || format == zerolog.TimeFormatUnixMs ||
+			format == zerolog.TimeFormatUnixMicro || format == zerolog.TimeFormatUnixNano {
+			format = time.RFC3339Nano
+		}
+		result, err := time.Parse(format, tv)
+		return result, err == nil
+	case int64:
+		switch zerolog.TimeFieldFormat {
+		case zerolog.TimeFormatUnix:
+			return time.Unix(tv, 0), true
+		case zerolog.TimeFormatUnixMs:
+			return time.UnixMilli(tv), true
+		case zerolog.TimeFormatUnixMicro:
+			return time.UnixMicro(tv), true
+		case zerolog.TimeFormatUnixNano:
+			return time.Unix(0, tv), true
+		}
+	case float64:
+		if zerolog.TimeFieldFormat == zerolog.TimeFormatUnix {
+			sec := int64(tv)
+			return time.Unix(sec, int64((tv-float64(sec))*float64(time.Second))), true
+		}
+	}
+	return time.Time{}, false
+}
+
+func (instance *Writer) mapFromZerologLevel(zl zerolog.Level) (level.Level, error) {
+	l, err := instance.getLevelMapper().FromZerolog(zl)
+	if err != nil {
+		return 0, fmt.Errorf("cannot map zerolog's level %d to slf4g's level: %w", zl, err)
+	}
+	return l, nil
+}
+
+func (instance *Writer) getDelegate() log.CoreLogger {
+	if v := instance.Delegate; v != nil {
+		return v
+	}
+	return log.GetRootLogger()
+}
+
+func (instance *Writer) getLevelMapper() LevelMapper {
+	if v := instance.LevelMapper; v != nil {
+		return v
+	}
+	return DefaultLevelMapper
+}
+
+func (instance *Writer) getDetectSkipFrames() DetectSkipFrames {
+	if v := instance.DetectSkipFrames; v != nil {
+		return v
+	}
+	return DefaultDetectSkipFrames
+}
+
+func helperOf(instance log.CoreLogger) func() {
+	if wh, ok := instance.(interface {
+		Helper() func()
+	}); ok {
+		return wh.Helper()
+	}
+	return func() {}
+}
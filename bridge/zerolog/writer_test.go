@@ -0,0 +1,190 @@
+package zerolog
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/rs/zerolog"
+
+	log "github.com/echocat/slf4g"
+	"github.com/echocat/slf4g/fields"
+	"github.com/echocat/slf4g/internal/test/assert"
+	"github.com/echocat/slf4g/level"
+	"github.com/echocat/slf4g/testing/recording"
+)
+
+func TestNewWriter(t *testing.T) {
+	aLogger := recording.NewCoreLogger()
+	aLevelMapper := NewLevelMapper()
+
+	actual := NewWriter(aLogger, func(v *Writer) {
+		v.LevelMapper = aLevelMapper
+	})
+
+	assert.ToBeNotNil(t, actual)
+	assert.ToBeSame(t, aLogger, actual.Delegate)
+	assert.ToBeSame(t, aLevelMapper, actual.LevelMapper)
+}
+
+func TestWriter_Write(t *testing.T) {
+	aTime, err := time.Parse(time.RFC3339, "2025-10-01T15:30:15Z")
+	assert.ToBeNoError(t, err)
+
+	cases := []struct {
+		name           string
+		given          string
+		expectedLevel  level.Level
+		expectedFields map[string]interface{}
+		expectedError  string
+	}{{
+		name:          "regular",
+		given:         `{"level":"warn","message":"aMessage","time":"2025-10-01T15:30:15Z","foo":"bar","num":12,"float":1.5,"obj":{"a":1}}` + "\n",
+		expectedLevel: level.Warn,
+		expectedFields: map[string]interface{}{
+			"message":   "aMessage",
+			"timestamp": aTime,
+			"foo":       "bar",
+			"num":       int64(12),
+			"float":     1.5,
+			"obj":       map[string]interface{}{"a": int64(1)},
+		},
+	}, {
+		name:          "withError",
+		given:         `{"level":"error","error":"expected","message":"aMessage"}`,
+		expectedLevel: level.Error,
+		expectedFields: map[string]interface{}{
+			"message": "aMessage",
+			"error":   errors.New("expected"),
+		},
+	}, {
+		name:          "withoutLevel",
+		given:         `{"message":"aMessage"}`,
+		expectedLevel: level.Info,
+		expectedFields: map[string]interface{}{
+			"message": "aMessage",
+		},
+	}, {
+		name:          "withUnparsableTime",
+		given:         `{"level":"info","time":"foo"}`,
+		expectedLevel: level.Info,
+		expectedFields: map[string]interface{}{
+			"time": "foo",
+		},
+	}, {
+		name:          "disabled",
+		given:         `{"level":"debug","message":"aMessage"}`,
+		expectedLevel: 0,
+	}, {
+		name:          "noJson",
+		given:         "foo bar\n",
+		expectedLevel: level.Info,
+		expectedFields: map[string]interface{}{
+			"message": "foo bar",
+		},
+	}}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			baseLogger := recording.NewCoreLogger()
+			baseLogger.SetLevel(level.Info)
+			helperWasCalled := false
+
+			instance := &Writer{
+				Delegate: &delegateCoreLoggerWithHelper{baseLogger, func() {
+					helperWasCalled = true
+				}},
+				DetectSkipFrames: func(skip uint16) uint16 {
+					return skip + 1
+				},
+			}
+
+			actualN, actualErr := instance.Write([]byte(c.given))
+			assert.ToBeNoError(t, actualErr)
+			assert.ToBeEqual(t, len(c.given), actualN)
+			assert.ToBeEqual(t, true, helperWasCalled)
+
+			if c.expectedLevel == 0 {
+				assert.ToBeEqual(t, 0, baseLogger.Len())
+				return
+			}
+
+			assert.ToBeEqual(t, 1, baseLogger.Len())
+			actual := baseLogger.Get(0)
+			assert.ToBeEqual(t, c.expectedLevel, actual.GetLevel())
+
+			actualAsMap, err := fields.AsMap(actual)
+			assert.ToBeNoError(t, err)
+			delete(actualAsMap, "logger")
+			if _, expectsTimestamp := c.expectedFields["timestamp"]; !expectsTimestamp {
+				delete(actualAsMap, "timestamp")
+			}
+
+			assert.ToBeEqual(t, c.expectedFields, actualAsMap)
+		})
+	}
+}
+
+func TestWriter_WriteLevel(t *testing.T) {
+	baseLogger := recording.NewCoreLogger()
+	instance := &Writer{Delegate: baseLogger}
+
+	given := `{"level":"info","message":"aMessage"}`
+	actualN, actualErr := instance.WriteLevel(zerolog.ErrorLevel, []byte(given))
+	assert.ToBeNoError(t, actualErr)
+	assert.ToBeEqual(t, len(given), actualN)
+
+	assert.ToBeEqual(t, 1, baseLogger.Len())
+	assert.ToBeEqual(t, level.Error, baseLogger.Get(0).GetLevel())
+}
+
+func TestWriter_WriteLevel_failing(t *testing.T) {
+	baseLogger := recording.NewCoreLogger()
+	instance := &Writer{Delegate: baseLogger}
+
+	actualN, actualErr := instance.WriteLevel(zerolog.Disabled, []byte(`{}`))
+	assert.ToBeMatching(t, "unknown zerolog level: 7", actualErr)
+	assert.ToBeEqual(t, 0, actualN)
+	assert.ToBeEqual(t, 0, baseLogger.Len())
+}
+
+func Test_parseTime(t *testing.T) {
+	defer func(v string) {
+		zerolog.TimeFieldFormat = v
+	}(zerolog.TimeFieldFormat)
+
+	aTime := time.Unix(1759332615, 0)
+
+	cases := []struct {
+		format   string
+		given    interface{}
+		expected time.Time
+		ok       bool
+	}{
+		{time.RFC3339, "2025-10-01T15:30:15Z", aTime.UTC(), true},
+		{time.RFC3339, "foo", time.Time{}, false},
+		{zerolog.TimeFormatUnix, int64(1759332615), aTime, true},
+		{zerolog.TimeFormatUnix, 1759332615.5, aTime.Add(500 * time.Millisecond), true},
+		{zerolog.TimeFormatUnixMs, int64(1759332615000), aTime, true},
+		{zerolog.TimeFormatUnixMicro, int64(1759332615000000), aTime, true},
+		{zerolog.TimeFormatUnixNano, int64(1759332615000000000), aTime, true},
+		{time.RFC3339, int64(1759332615), time.Time{}, false},
+		{time.RFC3339, true, time.Time{}, false},
+	}
+
+	for _, c := range cases {
+		zerolog.TimeFieldFormat = c.format
+		actual, actualOk := parseTime(c.given)
+		assert.ToBeEqual(t, c.ok, actualOk)
+		assert.ToBeEqual(t, true, c.expected.Equal(actual))
+	}
+}
+
+type delegateCoreLoggerWithHelper struct {
+	log.CoreLogger
+	helper func()
+}
+
+func (instance delegateCoreLoggerWithHelper) Helper() func() {
+	return instance.helper
+}
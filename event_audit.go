@@ -0,0 +1,39 @@
+package log
+
+// AuditFieldKey is the key of the field which marks an Event as audit event.
+// Only the value set by MarkAsAudit() or NewAuditLogger() marks an Event as
+// audit event; a plain field with this key (like "audit"=true set by the user)
+// does not. See MarkAsAudit() for more details.
+const AuditFieldKey = "audit"
+
+// auditMarker is the value of AuditFieldKey which marks an Event as audit
+// event. As it is a bool it is rendered by all formatters as true.
+type auditMarker bool
+
+// MarkAsAudit marks the given Event as audit event. Audit events are required
+// to be delivered in any case. Implementations which are intercepting,
+// sampling or dropping events for any other reason than their level.Level
+// must not do this for events where IsAudit() returns true.
+func MarkAsAudit(e Event) Event {
+	if e == nil {
+		return nil
+	}
+	return e.With(AuditFieldKey, auditMarker(true))
+}
+
+// IsAudit returns true if the given Event was marked as audit event. See
+// MarkAsAudit() for more details.
+func IsAudit(e Event) bool {
+	if e == nil {
+		return false
+	}
+	v, _ := e.Get(AuditFieldKey)
+	_, ok := v.(auditMarker)
+	return ok
+}
+
+// NewAuditLogger creates a Logger based on the given target which marks
+// every logged Event as audit event. See MarkAsAudit() for more details.
+func NewAuditLogger(target Logger) Logger {
+	return target.With(AuditFieldKey, auditMarker(true))
+}
@@ -0,0 +1,66 @@
+package log
+
+import (
+	"testing"
+
+	"github.com/echocat/slf4g/fields"
+	"github.com/echocat/slf4g/level"
+
+	"github.com/echocat/slf4g/internal/test/assert"
+)
+
+func Test_MarkAsAudit(t *testing.T) {
+	givenProvider := newMockProvider("test").withRootLogger()
+	givenEvent := givenProvider.newEvent(level.Info)
+
+	actual := MarkAsAudit(givenEvent)
+
+	actualValue, _ := actual.Get(AuditFieldKey)
+	assert.ToBeEqual(t, auditMarker(true), actualValue)
+	assert.ToBeEqual(t, false, IsAudit(givenEvent))
+	assert.ToBeEqual(t, true, IsAudit(actual))
+}
+
+func Test_MarkAsAudit_withNilEvent(t *testing.T) {
+	actual := MarkAsAudit(nil)
+
+	assert.ToBeNil(t, actual)
+}
+
+func Test_IsAudit(t *testing.T) {
+	givenProvider := newMockProvider("test").withRootLogger()
+
+	cases := []struct {
+		name     string
+		given    Event
+		expected bool
+	}{
+		{"nil", nil, false},
+		{"absent", givenProvider.newEvent(level.Info), false},
+		{"marked", MarkAsAudit(givenProvider.newEvent(level.Info)), true},
+		{"plainTrue", givenProvider.newEvent(level.Info).With(AuditFieldKey, true), false},
+		{"plainFalse", givenProvider.newEvent(level.Info).With(AuditFieldKey, false), false},
+		{"otherType", givenProvider.newEvent(level.Info).With(AuditFieldKey, "true"), false},
+		{"lazy", givenProvider.newEvent(level.Info).With(AuditFieldKey, fields.LazyFunc(func() interface{} {
+			return true
+		})), false},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			assert.ToBeEqual(t, c.expected, IsAudit(c.given))
+		})
+	}
+}
+
+func Test_NewAuditLogger(t *testing.T) {
+	givenCoreLogger := newMockCoreLogger("foo")
+	givenCoreLogger.level = level.Info
+	givenCoreLogger.initLoggedEvents()
+
+	instance := NewAuditLogger(NewLogger(givenCoreLogger))
+	instance.Info("hello")
+
+	assert.ToBeEqual(t, 1, len(*givenCoreLogger.loggedEvents))
+	assert.ToBeEqual(t, true, IsAudit(givenCoreLogger.loggedEvent(0)))
+}
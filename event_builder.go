@@ -0,0 +1,199 @@
+package log
+
+import (
+	"time"
+
+	"github.com/echocat/slf4g/fields"
+	"github.com/echocat/slf4g/level"
+)
+
+// EventBuilder allows to build an Event field by field in a fluent way before
+// it is logged exactly once using Msg(), Msgf() or Send(). Example:
+//
+//	log.NewEventBuilder(logger, level.Info).
+//		Str("user", "foo").
+//		Int("attempt", 2).
+//		Err(err).
+//		Msg("Login failed.")
+//
+// The At*() methods are not part of the Logger interface but of the optional
+// EventBuilderFactory; so they can only be called on a Logger after a type
+// assertion. Use NewEventBuilder() (or the global At*() functions for the
+// root Logger) instead.
+//
+// If the requested level.Level is not enabled NewEventBuilder() (and also the
+// At*() methods of EventBuilderFactory) return nil. All methods of
+// EventBuilder can safely be called on nil and will simply do nothing. This
+// means that nothing will be allocated if the level is disabled.
+//
+// An EventBuilder should not be used anymore after it was sent.
+type EventBuilder struct {
+	logger CoreLogger
+	parent fields.Fields
+	level  level.Level
+	values map[string]interface{}
+}
+
+// EventBuilderFactory is an optional interface which could be implemented by
+// a Logger to create EventBuilder instances. All Logger created by
+// NewLogger() or NewLoggerFacade() are implementing it. Use NewEventBuilder()
+// to create an EventBuilder for every Logger.
+type EventBuilderFactory interface {
+	// At returns an EventBuilder which logs its result on the given
+	// level.Level with this Logger. If the level.Level is not enabled nil
+	// will be returned, which can still safely be used but does nothing.
+	At(level.Level) *EventBuilder
+
+	// AtTrace is like At but with level.Trace.
+	AtTrace() *EventBuilder
+
+	// AtDebug is like At but with level.Debug.
+	AtDebug() *EventBuilder
+
+	// AtInfo is like At but with level.Info.
+	AtInfo() *EventBuilder
+
+	// AtWarn is like At but with level.Warn.
+	AtWarn() *EventBuilder
+
+	// AtError is like At but with level.Error.
+	AtError() *EventBuilder
+
+	// AtFatal is like At but with level.Fatal.
+	AtFatal() *EventBuilder
+}
+
+// NewEventBuilder creates a new EventBuilder which logs its result on the
+// given level.Level with the given CoreLogger. If it implements
+// EventBuilderFactory its At() method will be used. If the level.Level is not
+// enabled nil will be returned, which can still safely be used but does
+// nothing.
+func NewEventBuilder(logger CoreLogger, l level.Level) *EventBuilder {
+	if f, ok := logger.(EventBuilderFactory); ok {
+		return f.At(l)
+	}
+	return newEventBuilder(logger, nil, l)
+}
+
+func newEventBuilder(logger CoreLogger, parent fields.Fields, l level.Level) *EventBuilder {
+	if !logger.IsLevelEnabled(l) {
+		return nil
+	}
+	return &EventBuilder{
+		logger: logger,
+		parent: parent,
+		level:  l,
+		values: make(map[string]interface{}, 8),
+	}
+}
+
+// Enabled returns true if this EventBuilder will log something.
+func (instance *EventBuilder) Enabled() bool {
+	return instance != nil
+}
+
+// Str adds the given string value with the given key.
+func (instance *EventBuilder) Str(key string, value string) *EventBuilder {
+	return instance.Any(key, value)
+}
+
+// Int adds the given int value with the given key.
+func (instance *EventBuilder) Int(key string, value int) *EventBuilder {
+	return instance.Any(key, value)
+}
+
+// Int64 adds the given int64 value with the given key.
+func (instance *EventBuilder) Int64(key string, value int64) *EventBuilder {
+	return instance.Any(key, value)
+}
+
+// Uint64 adds the given uint64 value with the given key.
+func (instance *EventBuilder) Uint64(key string, value uint64) *EventBuilder {
+	return instance.Any(key, value)
+}
+
+// Float64 adds the given float64 value with the given key.
+func (instance *EventBuilder) Float64(key string, value float64) *EventBuilder {
+	return instance.Any(key, value)
+}
+
+// Bool adds the given bool value with the given key.
+func (instance *EventBuilder) Bool(key string, value bool) *EventBuilder {
+	return instance.Any(key, value)
+}
+
+// Dur adds the given time.Duration value with the given key.
+func (instance *EventBuilder) Dur(key string, value time.Duration) *EventBuilder {
+	return instance.Any(key, value)
+}
+
+// Time adds the given time.Time value with the given key.
+func (instance *EventBuilder) Time(key string, value time.Time) *EventBuilder {
+	return instance.Any(key, value)
+}
+
+// Strf adds the given format with its arguments with the given key. It is
+// defined that the format itself will not be executed before the consumption
+// of the value.
+func (instance *EventBuilder) Strf(key string, format string, args ...interface{}) *EventBuilder {
+	return instance.Any(key, fields.LazyFormat(format, args...))
+}
+
+// Err adds the given error in the same way like Logger.WithError() does. If
+// the error is nil nothing will be added.
+func (instance *EventBuilder) Err(err error) *EventBuilder {
+	if instance == nil || err == nil {
+		return instance
+	}
+	return instance.Any(instance.logger.GetProvider().GetFieldKeysSpec().GetError(), err)
+}
+
+// Any adds the given value with the given key.
+func (instance *EventBuilder) Any(key string, value interface{}) *EventBuilder {
+	if instance == nil {
+		return nil
+	}
+	instance.values[key] = value
+	return instance
+}
+
+// Msg logs the built Event with the given message.
+func (instance *EventBuilder) Msg(msg string) {
+	if instance == nil {
+		return
+	}
+	helperOf(instance.logger)()
+	instance.values[instance.logger.GetProvider().GetFieldKeysSpec().GetMessage()] = msg
+	instance.send(2)
+}
+
+// Msgf logs the built Event with the given format as message. It is defined
+// that the format itself will not be executed before the consumption of the
+// value.
+func (instance *EventBuilder) Msgf(format string, args ...interface{}) {
+	if instance == nil {
+		return
+	}
+	helperOf(instance.logger)()
+	instance.values[instance.logger.GetProvider().GetFieldKeysSpec().GetMessage()] = fields.LazyFormat(format, args...)
+	instance.send(2)
+}
+
+// Send logs the built Event without any message.
+func (instance *EventBuilder) Send() {
+	if instance == nil {
+		return
+	}
+	helperOf(instance.logger)()
+	instance.send(2)
+}
+
+func (instance *EventBuilder) send(skipFrames uint16) {
+	var f fields.Fields = fields.WithAll(instance.values)
+	if parent := instance.parent; parent != nil && parent.Len() > 0 {
+		f = fields.NewLineage(f, parent)
+	}
+
+	e := NewEventWithFields(instance.logger, instance.level, f)
+	instance.logger.Log(e, skipFrames)
+}
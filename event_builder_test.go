@@ -0,0 +1,223 @@
+package log
+
+import (
+	"errors"
+	"runtime"
+	"testing"
+	"time"
+
+	"github.com/echocat/slf4g/fields"
+	"github.com/echocat/slf4g/level"
+
+	"github.com/echocat/slf4g/internal/test/assert"
+)
+
+func Test_EventBuilder_Msg(t *testing.T) {
+	givenLogger := newMockLogger("foo")
+	givenLogger.initLoggedEvents()
+	givenLogger.setLevel(level.Info)
+	givenError := errors.New("expected")
+	givenTime := time.Date(2026, 1, 2, 3, 4, 5, 0, time.UTC)
+	spec := givenLogger.getFieldKeysSpec()
+
+	NewEventBuilder(givenLogger.With("a", 1), level.Warn).
+		Str("str", "foo").
+		Int("int", 1).
+		Int64("int64", int64(2)).
+		Uint64("uint64", uint64(3)).
+		Float64("float64", 4.5).
+		Bool("bool", true).
+		Dur("dur", time.Second).
+		Time("time", givenTime).
+		Strf("strf", "%d-%d", 6, 7).
+		Any("any", []int{8}).
+		Err(givenError).
+		Err(nil).
+		Msg("hello")
+
+	assert.ToBeEqual(t, 1, len(givenLogger.loggedEvents()))
+	actual := givenLogger.loggedEvent(0)
+	assert.ToBeEqual(t, level.Warn, actual.GetLevel())
+	assert.ToBeEqualUsing(t,
+		givenLogger.NewEvent(level.Warn, map[string]interface{}{
+			spec.GetMessage(): "hello",
+			spec.GetError():   givenError,
+			"a":               1,
+			"str":             "foo",
+			"int":             1,
+			"int64":           int64(2),
+			"uint64":          uint64(3),
+			"float64":         4.5,
+			"bool":            true,
+			"dur":             time.Second,
+			"time":            givenTime,
+			"strf":            fields.LazyFormat("%d-%d", 6, 7),
+			"any":             []int{8},
+		}),
+		actual,
+		AreEventsEqual,
+	)
+}
+
+func Test_EventBuilder_Msgf(t *testing.T) {
+	givenLogger := newMockLogger("foo")
+	givenLogger.initLoggedEvents()
+	givenLogger.setLevel(level.Info)
+	spec := givenLogger.getFieldKeysSpec()
+
+	givenLogger.AtInfo().Str("a", "b").Msgf("hello %d", 1)
+
+	assert.ToBeEqual(t, 1, len(givenLogger.loggedEvents()))
+	assert.ToBeEqualUsing(t,
+		givenLogger.NewEvent(level.Info, map[string]interface{}{
+			spec.GetMessage(): fields.LazyFormat("hello %d", 1),
+			"a":               "b",
+		}),
+		givenLogger.loggedEvent(0),
+		AreEventsEqual,
+	)
+}
+
+func Test_EventBuilder_Send(t *testing.T) {
+	givenLogger := newMockLogger("foo")
+	givenLogger.initLoggedEvents()
+	givenLogger.setLevel(level.Info)
+
+	givenLogger.AtError().Int("a", 1).Send()
+
+	assert.ToBeEqual(t, 1, len(givenLogger.loggedEvents()))
+	assert.ToBeEqualUsing(t,
+		givenLogger.NewEvent(level.Error, map[string]interface{}{
+			"a": 1,
+		}),
+		givenLogger.loggedEvent(0),
+		AreEventsEqual,
+	)
+}
+
+func Test_EventBuilder_levels(t *testing.T) {
+	givenLogger := newMockLogger("foo")
+	cases := []struct {
+		atFunc func() *EventBuilder
+		level  level.Level
+	}{
+		{givenLogger.AtTrace, level.Trace},
+		{givenLogger.AtDebug, level.Debug},
+		{givenLogger.AtInfo, level.Info},
+		{givenLogger.AtWarn, level.Warn},
+		{givenLogger.AtError, level.Error},
+		{givenLogger.AtFatal, level.Fatal},
+		{func() *EventBuilder { return givenLogger.At(level.Info) }, level.Info},
+	}
+
+	for _, c := range cases {
+		t.Run(levelToName(c.level), func(t *testing.T) {
+			givenLogger.initLoggedEvents()
+
+			givenLogger.setLevel(c.level)
+			enabled := c.atFunc()
+			assert.ToBeEqual(t, true, enabled.Enabled())
+			enabled.Msg("foo")
+
+			givenLogger.setLevel(c.level + 1)
+			disabled := c.atFunc()
+			assert.ToBeNil(t, disabled)
+			assert.ToBeEqual(t, false, disabled.Enabled())
+			disabled.Str("a", "b").Msg("should not appear because level disabled")
+
+			assert.ToBeEqual(t, 1, len(givenLogger.loggedEvents()))
+			assert.ToBeEqual(t, c.level, givenLogger.loggedEvent(0).GetLevel())
+		})
+	}
+}
+
+func Test_EventBuilder_nilIsSafe(t *testing.T) {
+	var instance *EventBuilder
+
+	assert.ToBeEqual(t, false, instance.Enabled())
+	assert.ToBeNil(t, instance.
+		Str("str", "foo").
+		Int("int", 1).
+		Int64("int64", 2).
+		Uint64("uint64", 3).
+		Float64("float64", 4).
+		Bool("bool", true).
+		Dur("dur", time.Second).
+		Time("time", time.Time{}).
+		Strf("strf", "%d", 5).
+		Err(errors.New("expected")).
+		Any("any", 6))
+
+	instance.Msg("foo")
+	instance.Msgf("foo %d", 1)
+	instance.Send()
+}
+
+func Test_EventBuilder_allocatesNothingIfDisabled(t *testing.T) {
+	givenLogger := newMockLogger("foo")
+	givenLogger.initLoggedEvents()
+	givenLogger.setLevel(level.Fatal)
+	givenError := errors.New("expected")
+
+	actual := testing.AllocsPerRun(100, func() {
+		givenLogger.AtInfo().
+			Str("str", "foo").
+			Int("int", 1).
+			Err(givenError).
+			Msg("should not appear because level disabled")
+	})
+
+	assert.ToBeEqual(t, float64(0), actual)
+	assert.ToBeEqual(t, 0, len(givenLogger.loggedEvents()))
+}
+
+func Test_EventBuilder_skipFrames(t *testing.T) {
+	givenCoreLogger := &callerRecordingCoreLogger{newMockCoreLogger("foo"), nil}
+	givenCoreLogger.level = level.Info
+	instance := NewLogger(givenCoreLogger)
+
+	NewEventBuilder(instance, level.Info).Msg("foo")
+	NewEventBuilder(instance, level.Info).Msgf("foo")
+	NewEventBuilder(instance, level.Info).Send()
+	NewEventBuilder(givenCoreLogger, level.Info).Msg("foo")
+
+	expected := runtime.FuncForPC(currentPc()).Name()
+	assert.ToBeEqual(t, []string{expected, expected, expected, expected}, givenCoreLogger.callers)
+}
+
+func Test_NewEventBuilder_withCoreLogger(t *testing.T) {
+	givenCoreLogger := newMockCoreLogger("foo")
+	givenCoreLogger.initLoggedEvents()
+	givenCoreLogger.level = level.Info
+	spec := givenCoreLogger.GetProvider().GetFieldKeysSpec()
+
+	NewEventBuilder(givenCoreLogger, level.Warn).Str("a", "b").Msg("hello")
+	assert.ToBeNil(t, NewEventBuilder(givenCoreLogger, level.Debug))
+
+	assert.ToBeEqual(t, 1, len(*givenCoreLogger.loggedEvents))
+	assert.ToBeEqualUsing(t,
+		givenCoreLogger.NewEvent(level.Warn, map[string]interface{}{
+			spec.GetMessage(): "hello",
+			"a":               "b",
+		}),
+		(*givenCoreLogger.loggedEvents)[0],
+		AreEventsEqual,
+	)
+}
+
+func currentPc() uintptr {
+	pc, _, _, _ := runtime.Caller(1)
+	return pc
+}
+
+type callerRecordingCoreLogger struct {
+	*mockCoreLogger
+	callers []string
+}
+
+// Log records the caller in the same way like native does: skipFrames of 0
+// means the direct caller of Log.
+func (instance *callerRecordingCoreLogger) Log(_ Event, skipFrames uint16) {
+	pc, _, _, _ := runtime.Caller(int(skipFrames) + 1)
+	instance.callers = append(instance.callers, runtime.FuncForPC(pc).Name())
+}
@@ -0,0 +1,16 @@
+package log
+
+import (
+	"io"
+)
+
+// NewDiscardingFallbackProvider creates a new instance of the fallback
+// Provider which writes everything to io.Discard. This is only available in
+// tests (for example for benchmarks).
+func NewDiscardingFallbackProvider() Provider {
+	result := &fallbackProvider{
+		out: io.Discard,
+	}
+	result.cache = NewLoggerCache(result.rootFactory, result.factory)
+	return result
+}
@@ -1,6 +1,9 @@
 package fields
 
-import "fmt"
+import (
+	"fmt"
+	"sync"
+)
 
 // Lazy is a value which CAN be initialized on usage.
 //
@@ -27,13 +30,21 @@ func (instance lazyFunc) Get() interface{} {
 
 // LazyFormat returns a value which will be executed the fmt.Sprintf action at
 // the moment when it will be consumed or in other words: Lazy.Get() is called.
+//
+// The result is cached after the first call of Lazy.Get(), so fmt.Sprintf will
+// be executed at most once - regardless how many formatters or consumers are
+// rendering it. If it is never consumed (for example because the event was
+// dropped by an interceptor) fmt.Sprintf will never be executed at all.
 func LazyFormat(format string, args ...interface{}) Lazy {
-	return &lazyFormat{format, args}
+	return &lazyFormat{format: format, args: args}
 }
 
 type lazyFormat struct {
 	format string
 	args   []interface{}
+
+	once   sync.Once
+	result string
 }
 
 func (instance *lazyFormat) Get() interface{} {
@@ -41,12 +52,15 @@ func (instance *lazyFormat) Get() interface{} {
 }
 
 func (instance *lazyFormat) String() string {
-	targetArgs := make([]interface{}, len(instance.args))
-	for i, arg := range instance.args {
-		if l, ok := arg.(Lazy); ok {
-			arg = l.Get()
+	instance.once.Do(func() {
+		targetArgs := make([]interface{}, len(instance.args))
+		for i, arg := range instance.args {
+			if l, ok := arg.(Lazy); ok {
+				arg = l.Get()
+			}
+			targetArgs[i] = arg
 		}
-		targetArgs[i] = arg
-	}
-	return fmt.Sprintf(instance.format, targetArgs...)
+		instance.result = fmt.Sprintf(instance.format, targetArgs...)
+	})
+	return instance.result
 }
@@ -53,6 +53,19 @@ func Test_LazyFormat_formats(t *testing.T) {
 	assert.ToBeEqual(t, "foobar", actual)
 }
 
+func Test_LazyFormat_formatsOnlyOnce(t *testing.T) {
+	actualCallAmount := uint64(0)
+
+	instance := LazyFormat("foo%s", LazyFunc(func() interface{} {
+		atomic.AddUint64(&actualCallAmount, 1)
+		return "bar"
+	}))
+
+	assert.ToBeEqual(t, "foobar", instance.Get())
+	assert.ToBeEqual(t, "foobar", instance.(fmt.Stringer).String())
+	assert.ToBeEqual(t, uint64(1), atomic.LoadUint64(&actualCallAmount))
+}
+
 var someVariable = &someStruct{}
 
 type someStruct struct {
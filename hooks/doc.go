@@ -4,6 +4,12 @@
 //     [log] package to use slf4g as its logging backend.
 //  2. [github.com/echocat/slf4g/hooks/sdkslog] which configures the SDK's modern
 //     [log/slog] package to use slf4g as its logging backend.
+//  3. [github.com/echocat/slf4g/hooks/logrus] which configures the standard
+//     logger of github.com/sirupsen/logrus to use slf4g as its logging backend.
+//     It lives in its own Go module.
+//  4. [github.com/echocat/slf4g/hooks/grpclog] which configures the logging of
+//     google.golang.org/grpc to use slf4g as its logging backend. It lives in
+//     its own Go module.
 //
 // Simply import those packages as follows:
 //
@@ -13,5 +19,11 @@
 //
 //		// For hook into SDK's log/slog package
 //		_ "github.com/echocat/slf4g/hooks/sdkslog"
+//
+//		// For hook into github.com/sirupsen/logrus
+//		_ "github.com/echocat/slf4g/hooks/logrus"
+//
+//		// For hook into google.golang.org/grpc/grpclog
+//		_ "github.com/echocat/slf4g/hooks/grpclog"
 //	)
 package hooks
@@ -0,0 +1,15 @@
+module github.com/echocat/slf4g/hooks/grpclog
+
+go 1.23.0
+
+replace (
+	github.com/echocat/slf4g => ../../
+	github.com/echocat/slf4g/bridge/grpclog => ../../bridge/grpclog
+)
+
+require github.com/echocat/slf4g/bridge/grpclog v0.0.0
+
+require (
+	github.com/echocat/slf4g v0.0.0 // indirect
+	google.golang.org/grpc v1.72.0 // indirect
+)
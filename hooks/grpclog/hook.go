@@ -0,0 +1,19 @@
+// Package hook_grpclog is an automatic hook for usage together with the
+// logging of google.golang.org/grpc ([google.golang.org/grpc/grpclog.LoggerV2]).
+//
+// Importing this package anonymously will configure the whole application to
+// use the slf4g framework on any log output of gRPC.
+//
+//	import (
+//	   _ "github.com/echocat/slf4g/hooks/grpclog"
+//	)
+//
+// This package lives in its own Go module to keep the core of slf4g free of
+// any dependencies.
+package hook_grpclog
+
+import bridge "github.com/echocat/slf4g/bridge/grpclog"
+
+func init() {
+	bridge.Configure()
+}
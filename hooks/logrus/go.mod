@@ -0,0 +1,16 @@
+module github.com/echocat/slf4g/hooks/logrus
+
+go 1.23.0
+
+replace (
+	github.com/echocat/slf4g => ../../
+	github.com/echocat/slf4g/bridge/logrus => ../../bridge/logrus
+)
+
+require github.com/echocat/slf4g/bridge/logrus v0.0.0
+
+require (
+	github.com/echocat/slf4g v0.0.0 // indirect
+	github.com/sirupsen/logrus v1.9.3 // indirect
+	golang.org/x/sys v0.0.0-20220715151400-c0bba94af5f8 // indirect
+)
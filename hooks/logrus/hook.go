@@ -0,0 +1,19 @@
+// Package hook_logrus is an automatic hook for usage together with
+// github.com/sirupsen/logrus ([github.com/sirupsen/logrus.StandardLogger]).
+//
+// Importing this package anonymously will configure the whole application to
+// use the slf4g framework on any usage of the standard logger of logrus.
+//
+//	import (
+//	   _ "github.com/echocat/slf4g/hooks/logrus"
+//	)
+//
+// This package lives in its own Go module to keep the core of slf4g free of
+// any dependencies.
+package hook_logrus
+
+import bridge "github.com/echocat/slf4g/bridge/logrus"
+
+func init() {
+	bridge.Configure()
+}
@@ -0,0 +1,18 @@
+//go:build go1.21
+
+package hook_sdklog
+
+import (
+	"log/slog"
+	"testing"
+
+	std "github.com/echocat/slf4g/sdk/bridge/slog"
+
+	"github.com/echocat/slf4g/internal/test/assert"
+)
+
+func Test_providerOfDefaultIsRejected(t *testing.T) {
+	assert.Execution(t, func() {
+		std.NewProvider(slog.Default())
+	}).WillPanicWith("^the target of a slog provider must not forward into slf4g itself")
+}
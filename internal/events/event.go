@@ -0,0 +1,85 @@
+// Package events provides a simple implementation of log.Event which is
+// shared by several implementations of log.CoreLogger.
+package events
+
+import (
+	log "github.com/echocat/slf4g"
+	"github.com/echocat/slf4g/fields"
+	"github.com/echocat/slf4g/level"
+)
+
+// Event is a simple implementation of log.Event. Use New() to create it.
+type Event struct {
+	provider log.Provider
+	fields   fields.Fields
+	level    level.Level
+}
+
+// New creates a new instance of Event. The given provider is used to resolve
+// the key of errors (see WithError()).
+func New(provider log.Provider, f fields.Fields, l level.Level) *Event {
+	return &Event{
+		provider: provider,
+		fields:   f,
+		level:    l,
+	}
+}
+
+// ForEach implements log.Event#ForEach()
+func (instance *Event) ForEach(consumer func(key string, value interface{}) error) error {
+	return instance.fields.ForEach(consumer)
+}
+
+// Get implements log.Event#Get()
+func (instance *Event) Get(key string) (interface{}, bool) {
+	return instance.fields.Get(key)
+}
+
+// Len implements log.Event#Len()
+func (instance *Event) Len() int {
+	return instance.fields.Len()
+}
+
+// GetLevel implements log.Event#GetLevel()
+func (instance *Event) GetLevel() level.Level {
+	return instance.level
+}
+
+// With implements log.Event#With()
+func (instance *Event) With(key string, value interface{}) log.Event {
+	return instance.with(func(s fields.Fields) fields.Fields {
+		return s.With(key, value)
+	})
+}
+
+// Withf implements log.Event#Withf()
+func (instance *Event) Withf(key string, format string, args ...interface{}) log.Event {
+	return instance.with(func(s fields.Fields) fields.Fields {
+		return s.Withf(key, format, args...)
+	})
+}
+
+// WithError implements log.Event#WithError()
+func (instance *Event) WithError(err error) log.Event {
+	return instance.with(func(s fields.Fields) fields.Fields {
+		return s.With(instance.provider.GetFieldKeysSpec().GetError(), err)
+	})
+}
+
+// WithAll implements log.Event#WithAll()
+func (instance *Event) WithAll(of map[string]interface{}) log.Event {
+	return instance.with(func(s fields.Fields) fields.Fields {
+		return s.WithAll(of)
+	})
+}
+
+// Without implements log.Event#Without()
+func (instance *Event) Without(keys ...string) log.Event {
+	return instance.with(func(s fields.Fields) fields.Fields {
+		return s.Without(keys...)
+	})
+}
+
+func (instance *Event) with(mod func(fields.Fields) fields.Fields) log.Event {
+	return New(instance.provider, mod(instance.fields), instance.level)
+}
@@ -0,0 +1,89 @@
+package events
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/echocat/slf4g/fields"
+	"github.com/echocat/slf4g/level"
+	"github.com/echocat/slf4g/testing/recording"
+
+	"github.com/echocat/slf4g/internal/test/assert"
+)
+
+func Test_Event_ForEach(t *testing.T) {
+	instance := New(nil, fields.With("a", 1).With("b", 2), level.Info)
+
+	var actual []string
+	actualErr := instance.ForEach(func(key string, _ interface{}) error {
+		actual = append(actual, key)
+		return nil
+	})
+
+	assert.ToBeNoError(t, actualErr)
+	assert.ToBeEqual(t, []string{"b", "a"}, actual)
+}
+
+func Test_Event_Get(t *testing.T) {
+	instance := New(nil, fields.With("a", 1), level.Info)
+
+	actual1, actualExists1 := instance.Get("a")
+	assert.ToBeEqual(t, true, actualExists1)
+	assert.ToBeEqual(t, 1, actual1)
+	actual2, actualExists2 := instance.Get("b")
+	assert.ToBeEqual(t, false, actualExists2)
+	assert.ToBeEqual(t, nil, actual2)
+}
+
+func Test_Event_Len(t *testing.T) {
+	instance := New(nil, fields.With("a", 1).With("b", 2), level.Info)
+
+	assert.ToBeEqual(t, 2, instance.Len())
+}
+
+func Test_Event_GetLevel(t *testing.T) {
+	instance := New(nil, fields.Empty(), level.Error)
+
+	assert.ToBeEqual(t, level.Error, instance.GetLevel())
+}
+
+func Test_Event_modifiers(t *testing.T) {
+	givenError := errors.New("expected")
+	instance := New(recording.NewProvider(), fields.With("a", 1), level.Warn)
+
+	cases := []struct {
+		name     string
+		actual   func() interface{}
+		expected fields.Fields
+	}{{
+		name:     "With",
+		actual:   func() interface{} { return instance.With("b", 2) },
+		expected: fields.With("a", 1).With("b", 2),
+	}, {
+		name:     "Withf",
+		actual:   func() interface{} { return instance.Withf("b", "%d", 2) },
+		expected: fields.With("a", 1).Withf("b", "%d", 2),
+	}, {
+		name:     "WithError",
+		actual:   func() interface{} { return instance.WithError(givenError) },
+		expected: fields.With("a", 1).With("error", givenError),
+	}, {
+		name:     "WithAll",
+		actual:   func() interface{} { return instance.WithAll(map[string]interface{}{"b": 2}) },
+		expected: fields.With("a", 1).WithAll(map[string]interface{}{"b": 2}),
+	}, {
+		name:     "Without",
+		actual:   func() interface{} { return instance.Without("a") },
+		expected: fields.Empty(),
+	}}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			actual := c.actual().(*Event)
+
+			assert.ToBeEqualUsing(t, c.expected, actual.fields, fields.AreEqual)
+			assert.ToBeEqual(t, level.Warn, actual.level)
+			assert.ToBeSame(t, instance.provider, actual.provider)
+		})
+	}
+}
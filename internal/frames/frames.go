@@ -0,0 +1,59 @@
+// Package frames provides the detection of frames which should be skipped
+// while creating log messages; used by the several bridges.
+package frames
+
+import (
+	"runtime"
+	"strings"
+)
+
+// NewDetector creates a function which detects how many frames should be
+// skipped while creating the log message. All frames which belong to one of
+// the given packages will be skipped. The skip argument of the returned
+// function is relative to the caller of it.
+func NewDetector(ignoredPackages ...string) func(skip uint16) uint16 {
+	ignored := make(map[string]struct{}, len(ignoredPackages))
+	for _, pkg := range ignoredPackages {
+		ignored[pkg] = struct{}{}
+	}
+
+	return func(skip uint16) uint16 {
+		pcs := make([]uintptr, 64)
+		n := runtime.Callers(int(2+skip), pcs)
+		if n == 0 {
+			return 0
+		}
+		frames := runtime.CallersFrames(pcs[:n])
+
+		skipped := skip
+		for {
+			f, more := frames.Next()
+			if _, ok := ignored[PackageOf(f.Function)]; !ok {
+				return skipped
+			}
+			skipped++
+			if !more {
+				return skipped
+			}
+		}
+	}
+}
+
+// PackageOf returns the package of the given fully qualified function name.
+func PackageOf(funcName string) string {
+	if funcName == "" {
+		return ""
+	}
+	lastSlash := strings.LastIndex(funcName, "/")
+	start := 0
+	if lastSlash >= 0 {
+		start = lastSlash + 1
+	}
+	rest := funcName[start:]
+
+	if i := strings.Index(rest, "."); i >= 0 {
+		return funcName[:start+i]
+	}
+
+	return funcName
+}
@@ -0,0 +1,48 @@
+package frames
+
+import (
+	"testing"
+
+	"github.com/echocat/slf4g/internal/test/assert"
+)
+
+func Test_NewDetector(t *testing.T) {
+	instance := NewDetector("github.com/echocat/slf4g/internal/frames")
+
+	var actual uint16
+	func() {
+		actual = instance(0)
+	}()
+
+	// The closure above and this test function itself are part of the
+	// ignored package; only testing.tRunner is not.
+	assert.ToBeEqual(t, uint16(2), actual)
+}
+
+func Test_NewDetector_withoutIgnoredPackages(t *testing.T) {
+	instance := NewDetector()
+
+	assert.ToBeEqual(t, uint16(0), instance(0))
+	assert.ToBeEqual(t, uint16(1), instance(1))
+}
+
+func Test_PackageOf(t *testing.T) {
+	cases := []struct {
+		given    string
+		expected string
+	}{
+		{"", ""},
+		{"main.main", "main"},
+		{"runtime.goexit", "runtime"},
+		{"log/slog.(*Logger).log", "log/slog"},
+		{"github.com/echocat/slf4g/bridge/zap.(*core).Write", "github.com/echocat/slf4g/bridge/zap"},
+		{"github.com/echocat/slf4g/bridge/zap_test.Test_foo.func1", "github.com/echocat/slf4g/bridge/zap_test"},
+		{"go.uber.org/zap", "go.uber.org/zap"},
+	}
+
+	for _, c := range cases {
+		t.Run(c.given, func(t *testing.T) {
+			assert.ToBeEqual(t, c.expected, PackageOf(c.given))
+		})
+	}
+}
@@ -0,0 +1,154 @@
+package level
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+	"sync"
+)
+
+var (
+	definitions      = map[Level]Definition{}
+	definitionNames  = map[string]Level{}
+	definitionsMutex sync.RWMutex
+)
+
+// Definition declares a custom Level which should be known by all parts of
+// the framework; for example Provider, the names used to format and parse
+// levels or the colors used to print them. See Register() for more details.
+type Definition struct {
+	// Level is the ordinal of the custom Level. It is not allowed to use the
+	// ordinal of a standard Level (like Info).
+	Level Level
+
+	// Name is the canonical name of the custom Level; like "NOTE". It is
+	// used to format the Level. While parsing it is not case-sensitive.
+	// Implementations which are printing levels with a fixed width (like the
+	// text formatter of native) might trim longer names; so names with up to 5
+	// characters are recommended.
+	Name string
+
+	// Aliases are further names which are accepted while parsing the Level.
+	Aliases []string
+
+	// Color is the ANSI escape code which is used by implementations which are
+	// colorizing the Level (like native). If empty these implementations are
+	// using their default color.
+	Color string
+}
+
+// Register declares the given custom Level once and makes it known to all
+// parts of the framework. It returns the registered Level, to be used like:
+//
+//	var Notice = level.Register(level.Definition{Level: 3500, Name: "NOTE"})
+//
+// Afterwards, it will be returned by the default Provider, formatted and
+// parsed by its name and can be used with all methods accepting a Level; like
+// log.Logger#At() or log.Logger#IsLevelEnabled().
+//
+// It is not possible to redefine standard levels, to register a Level twice or
+// to use a name or alias of another custom Level.
+func Register(d Definition) Level {
+	if d.Level == 0 {
+		panic("the level of a definition must not be 0")
+	}
+	if d.Name == "" {
+		panic(fmt.Sprintf("the name of a definition of level %d must not be empty", d.Level))
+	}
+	for _, standard := range standardLevels {
+		if standard == d.Level {
+			panic(fmt.Sprintf("the standard level %d cannot be redefined", d.Level))
+		}
+	}
+
+	definitionsMutex.Lock()
+	defer definitionsMutex.Unlock()
+
+	if existing, ok := definitions[d.Level]; ok {
+		panic(fmt.Sprintf("level %d is already defined as %s", d.Level, existing.Name))
+	}
+	names := append([]string{d.Name}, d.Aliases...)
+	for _, name := range names {
+		if existing, ok := definitionNames[strings.ToUpper(name)]; ok {
+			panic(fmt.Sprintf("name %s is already used by level %d", name, existing))
+		}
+	}
+
+	d.Aliases = append([]string(nil), d.Aliases...)
+	definitions[d.Level] = d
+	for _, name := range names {
+		definitionNames[strings.ToUpper(name)] = d.Level
+	}
+
+	return d.Level
+}
+
+// Unregister is doing the exact opposite of Register(). It returns the
+// Definition which was registered for the given Level (if any).
+func Unregister(l Level) (Definition, bool) {
+	definitionsMutex.Lock()
+	defer definitionsMutex.Unlock()
+
+	existing, ok := definitions[l]
+	if !ok {
+		return Definition{}, false
+	}
+
+	delete(definitions, l)
+	for name, candidate := range definitionNames {
+		if candidate == l {
+			delete(definitionNames, name)
+		}
+	}
+
+	return existing, true
+}
+
+// GetDefinition returns the Definition of the given custom Level which was
+// registered using Register().
+func GetDefinition(l Level) (Definition, bool) {
+	definitionsMutex.RLock()
+	defer definitionsMutex.RUnlock()
+
+	result, ok := definitions[l]
+	return result, ok
+}
+
+// GetDefinitionByName returns the Definition of the custom Level which was
+// registered using Register() with the given name or alias. The name is not
+// case-sensitive.
+func GetDefinitionByName(name string) (Definition, bool) {
+	definitionsMutex.RLock()
+	defer definitionsMutex.RUnlock()
+
+	l, ok := definitionNames[strings.ToUpper(name)]
+	if !ok {
+		return Definition{}, false
+	}
+	return definitions[l], true
+}
+
+// GetAllDefinitions returns the Definition of all custom levels which were
+// registered using Register() ordered by their severity.
+func GetAllDefinitions() []Definition {
+	definitionsMutex.RLock()
+	defer definitionsMutex.RUnlock()
+
+	result := make([]Definition, 0, len(definitions))
+	for _, d := range definitions {
+		result = append(result, d)
+	}
+	sort.Slice(result, func(i, j int) bool {
+		return result[i].Level.CompareTo(result[j].Level) < 0
+	})
+	return result
+}
+
+func getAllDefinedLevels() Levels {
+	ds := GetAllDefinitions()
+	result := make(Levels, len(ds))
+	for i, d := range ds {
+		result[i] = d.Level
+	}
+	return result
+}
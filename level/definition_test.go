@@ -0,0 +1,85 @@
+package level
+
+import (
+	"testing"
+
+	"github.com/echocat/slf4g/internal/test/assert"
+)
+
+func Test_Register(t *testing.T) {
+	given := Definition{Level: 3500, Name: "NOTICE", Aliases: []string{"note"}, Color: `[32;1m`}
+
+	actual := Register(given)
+	defer Unregister(3500)
+
+	assert.ToBeEqual(t, Level(3500), actual)
+
+	actualDefinition, actualOk := GetDefinition(3500)
+	assert.ToBeEqual(t, true, actualOk)
+	assert.ToBeEqual(t, given, actualDefinition)
+
+	actualDefinition, actualOk = GetDefinitionByName("notice")
+	assert.ToBeEqual(t, true, actualOk)
+	assert.ToBeEqual(t, given, actualDefinition)
+
+	actualDefinition, actualOk = GetDefinitionByName("NOTE")
+	assert.ToBeEqual(t, true, actualOk)
+	assert.ToBeEqual(t, given, actualDefinition)
+}
+
+func Test_Register_fails(t *testing.T) {
+	Register(Definition{Level: 3500, Name: "NOTICE", Aliases: []string{"NOTE"}})
+	defer Unregister(3500)
+
+	cases := []struct {
+		name     string
+		given    Definition
+		expected string
+	}{
+		{"withoutLevel", Definition{Name: "FOO"}, "^the level of a definition must not be 0$"},
+		{"withoutName", Definition{Level: 3600}, "^the name of a definition of level 3600 must not be empty$"},
+		{"standardLevel", Definition{Level: Info, Name: "FOO"}, "^the standard level 3000 cannot be redefined$"},
+		{"sameLevel", Definition{Level: 3500, Name: "FOO"}, "^level 3500 is already defined as NOTICE$"},
+		{"sameName", Definition{Level: 3600, Name: "notice"}, "^name notice is already used by level 3500$"},
+		{"sameAlias", Definition{Level: 3600, Name: "FOO", Aliases: []string{"note"}}, "^name note is already used by level 3500$"},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			assert.Execution(t, func() {
+				Register(c.given)
+			}).WillPanicWith(c.expected)
+		})
+	}
+}
+
+func Test_Unregister(t *testing.T) {
+	given := Definition{Level: 3500, Name: "NOTICE", Aliases: []string{"NOTE"}}
+	Register(given)
+
+	actual, actualOk := Unregister(3500)
+	assert.ToBeEqual(t, true, actualOk)
+	assert.ToBeEqual(t, given, actual)
+
+	_, actualOk = GetDefinition(3500)
+	assert.ToBeEqual(t, false, actualOk)
+	_, actualOk = GetDefinitionByName("NOTE")
+	assert.ToBeEqual(t, false, actualOk)
+
+	_, actualOk = Unregister(3500)
+	assert.ToBeEqual(t, false, actualOk)
+}
+
+func Test_GetAllDefinitions(t *testing.T) {
+	Register(Definition{Level: 7000, Name: "AUDIT"})
+	defer Unregister(7000)
+	Register(Definition{Level: 3500, Name: "NOTICE"})
+	defer Unregister(3500)
+
+	actual := GetAllDefinitions()
+
+	assert.ToBeEqual(t, []Definition{
+		{Level: 3500, Name: "NOTICE"},
+		{Level: 7000, Name: "AUDIT"},
+	}, actual)
+}
@@ -9,6 +9,14 @@
 // always Info = 3000. Another Level which uses the ordinal 3000 can be
 // just assumed as an alias to Info.  Customization only means added new
 // instances of Level. Standard levels always remains available.
+//
+// Custom levels should be declared once using Register(). This makes them
+// known to the default Provider and to the implementations (like their names,
+// colors and mappings to other frameworks):
+//
+//	var Notice = level.Register(level.Definition{Level: 3500, Name: "NOTE"})
+//
+//	log.At(Notice).Msg("Something noticeable happened.")
 package level
 
 import "errors"
@@ -1,7 +1,13 @@
 package level
 
+import (
+	"sort"
+)
+
 var defaultProviderV = &defaultProvider{"default"}
 
+var standardLevels = Levels{Trace, Debug, Info, Warn, Error, Fatal}
+
 type defaultProvider struct {
 	name string
 }
@@ -10,6 +16,13 @@ func (instance *defaultProvider) GetName() string {
 	return instance.name
 }
 
+// GetLevels returns all standard levels together with all custom levels
+// registered using Register().
 func (instance *defaultProvider) GetLevels() Levels {
-	return Levels{Trace, Debug, Info, Warn, Error, Fatal}
+	result := append(Levels{}, standardLevels...)
+	if defined := getAllDefinedLevels(); len(defined) > 0 {
+		result = append(result, defined...)
+		sort.Sort(result)
+	}
+	return result
 }
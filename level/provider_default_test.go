@@ -17,3 +17,14 @@ func Test_defaultProvider_GetLevels(t *testing.T) {
 
 	assert.ToBeEqual(t, Levels{Trace, Debug, Info, Warn, Error, Fatal}, actual)
 }
+
+func Test_defaultProvider_GetLevels_withDefinitions(t *testing.T) {
+	Register(Definition{Level: 3500, Name: "NOTICE"})
+	defer Unregister(3500)
+	Register(Definition{Level: 7000, Name: "AUDIT"})
+	defer Unregister(7000)
+
+	actual := defaultProviderV.GetLevels()
+
+	assert.ToBeEqual(t, Levels{Trace, Debug, Info, 3500, Warn, Error, Fatal, 7000}, actual)
+}
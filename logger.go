@@ -7,6 +7,18 @@ import (
 
 // Logger defines an instance which executes log event actions.
 //
+// # Event builder
+//
+// The fluent EventBuilder is not part of this interface, to not break
+// existing implementations. So something like logger.AtInfo() does not
+// compile for a Logger; use NewEventBuilder() instead, which works for every
+// Logger:
+//
+//	log.NewEventBuilder(logger, level.Info).Str("user", "foo").Msg("hello")
+//
+// The global functions At(), AtInfo(), ... are doing the same for the root
+// Logger.
+//
 // # Implementation hints
 //
 // If you're considering to implement slf4g you're usually not required to
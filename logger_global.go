@@ -192,6 +192,42 @@ func WithAll(of map[string]interface{}) Logger {
 	return GetRootLogger().WithAll(of)
 }
 
+// At returns an EventBuilder which logs its result on the given level.Level
+// at the current root Logger. See NewEventBuilder() for more details.
+func At(l level.Level) *EventBuilder {
+	return NewEventBuilder(GetRootLogger(), l)
+}
+
+// AtTrace is like At but with level.Trace.
+func AtTrace() *EventBuilder {
+	return NewEventBuilder(GetRootLogger(), level.Trace)
+}
+
+// AtDebug is like At but with level.Debug.
+func AtDebug() *EventBuilder {
+	return NewEventBuilder(GetRootLogger(), level.Debug)
+}
+
+// AtInfo is like At but with level.Info.
+func AtInfo() *EventBuilder {
+	return NewEventBuilder(GetRootLogger(), level.Info)
+}
+
+// AtWarn is like At but with level.Warn.
+func AtWarn() *EventBuilder {
+	return NewEventBuilder(GetRootLogger(), level.Warn)
+}
+
+// AtError is like At but with level.Error.
+func AtError() *EventBuilder {
+	return NewEventBuilder(GetRootLogger(), level.Error)
+}
+
+// AtFatal is like At but with level.Fatal.
+func AtFatal() *EventBuilder {
+	return NewEventBuilder(GetRootLogger(), level.Fatal)
+}
+
 func log(l level.Level, args ...interface{}) (doLog, helper func()) {
 	p := GetProvider()
 	logger := p.GetRootLogger()
@@ -45,11 +45,11 @@ func (instance *loggerImpl) GetProvider() Provider {
 }
 
 func (instance *loggerImpl) log(level level.Level, args ...interface{}) (doLog, helper func()) {
-	return instance.doLog(level, 2, args...)
+	return instance.doLog(level, 1, args...)
 }
 
 func (instance *loggerImpl) logf(level level.Level, format string, args ...interface{}) (doLog, helper func()) {
-	return instance.doLogf(level, 2, format, args...)
+	return instance.doLogf(level, 1, format, args...)
 }
 
 func (instance *loggerImpl) DoLog(level level.Level, skipFrames uint16, args ...interface{}) {
@@ -93,8 +93,11 @@ func (instance *loggerImpl) doLogf(level level.Level, skipFrames uint16, format
 		return func() {}, helper
 	}
 	provider := instance.GetProvider()
+	// The message is always stored as a fields.LazyFormat, regardless of how
+	// the actual Event implements Withf(), so fmt.Sprintf is only executed if
+	// the event is really rendered.
 	e := instance.NewEventWithFields(level, instance.fields).
-		Withf(provider.GetFieldKeysSpec().GetMessage(), format, args...)
+		With(provider.GetFieldKeysSpec().GetMessage(), fields.LazyFormat(format, args...))
 
 	return func() {
 		helper()
@@ -227,6 +230,34 @@ func (instance *loggerImpl) Without(keys ...string) Logger {
 	}
 }
 
+func (instance *loggerImpl) At(l level.Level) *EventBuilder {
+	return newEventBuilder(instance.Unwrap(), instance.fields, l)
+}
+
+func (instance *loggerImpl) AtTrace() *EventBuilder {
+	return newEventBuilder(instance.Unwrap(), instance.fields, level.Trace)
+}
+
+func (instance *loggerImpl) AtDebug() *EventBuilder {
+	return newEventBuilder(instance.Unwrap(), instance.fields, level.Debug)
+}
+
+func (instance *loggerImpl) AtInfo() *EventBuilder {
+	return newEventBuilder(instance.Unwrap(), instance.fields, level.Info)
+}
+
+func (instance *loggerImpl) AtWarn() *EventBuilder {
+	return newEventBuilder(instance.Unwrap(), instance.fields, level.Warn)
+}
+
+func (instance *loggerImpl) AtError() *EventBuilder {
+	return newEventBuilder(instance.Unwrap(), instance.fields, level.Error)
+}
+
+func (instance *loggerImpl) AtFatal() *EventBuilder {
+	return newEventBuilder(instance.Unwrap(), instance.fields, level.Fatal)
+}
+
 func (instance *loggerImpl) Helper() func() {
 	return helperOf(instance.Unwrap())
 }
@@ -3,6 +3,7 @@ package log
 import (
 	"errors"
 	"fmt"
+	"runtime"
 	"testing"
 
 	"github.com/echocat/slf4g/fields"
@@ -163,6 +164,36 @@ func Test_loggerImpl_logf(t *testing.T) {
 	}
 }
 
+func Test_loggerImpl_logf_isDeferred(t *testing.T) {
+	givenLogger := newMockLogger("foo")
+	givenLogger.initLoggedEvents()
+	givenLogger.setLevel(1)
+	givenArg := &countingStringer{value: "bar"}
+
+	givenLogger.Infof("foo%v", givenArg)
+
+	assert.ToBeEqual(t, 1, len(givenLogger.loggedEvents()))
+	assert.ToBeEqual(t, 0, givenArg.calls)
+
+	actualEvent := givenLogger.loggedEvent(0)
+	actual1 := GetMessageOf(actualEvent, givenLogger.GetProvider())
+	actual2 := GetMessageOf(actualEvent, givenLogger.GetProvider())
+
+	assert.ToBeEqual(t, "foobar", *actual1)
+	assert.ToBeEqual(t, "foobar", *actual2)
+	assert.ToBeEqual(t, 1, givenArg.calls)
+}
+
+type countingStringer struct {
+	value string
+	calls int
+}
+
+func (instance *countingStringer) String() string {
+	instance.calls++
+	return instance.value
+}
+
 func Test_loggerImpl_IsEnabled(t *testing.T) {
 	givenLogger := newMockLogger("foo")
 	cases := []struct {
@@ -404,3 +435,21 @@ func (instance *mockCoreLoggerWithNewEventWithFields) NewEventWithFields(l level
 		fields:   asFields,
 	}
 }
+
+func Test_loggerImpl_location(t *testing.T) {
+	givenCoreLogger := &callerRecordingCoreLogger{newMockCoreLogger("foo"), nil}
+	givenCoreLogger.level = level.Info
+	instance := NewLogger(givenCoreLogger)
+	givenCoreLogger.provider.rootProvider = func() Logger { return instance }
+	defer setProvider(givenCoreLogger.provider)()
+
+	instance.Info("foo")
+	instance.Infof("foo")
+	instance.(LoggerFacade).DoLog(level.Info, 0, "foo")
+	instance.(LoggerFacade).DoLogf(level.Info, 0, "foo")
+	Info("foo")
+	Infof("foo")
+
+	expected := runtime.FuncForPC(currentPc()).Name()
+	assert.ToBeEqual(t, []string{expected, expected, expected, expected, expected, expected}, givenCoreLogger.callers)
+}
@@ -0,0 +1,224 @@
+package audit
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"math"
+	"os"
+	"sync"
+
+	log "github.com/echocat/slf4g"
+
+	"github.com/echocat/slf4g/native/consumer"
+	"github.com/echocat/slf4g/native/formatter"
+)
+
+// DefaultFileMode is used by NewConsumer() to create new audit files.
+var DefaultFileMode os.FileMode = 0600
+
+// QuarantineSuffix is appended to the filename of an audit file to receive
+// the name of the file which contains its incomplete records. See
+// NewConsumer() for more details.
+const QuarantineSuffix = ".incomplete"
+
+// stderr receives the report of incomplete records if Consumer.OnError is not
+// set.
+var stderr io.Writer = os.Stderr
+
+// Consumer is an implementation of consumer.Consumer which writes every audit
+// event (see log.IsAudit()) into a dedicated file. The file is synced after
+// each written record and each record carries the hash of the previous one.
+// See Verify() to check the chain of such a file.
+//
+// Interceptors are never called for audit events. All other events are passed
+// to Delegate.
+type Consumer struct {
+	// Formatter is used to format the audit events. It has to produce JSON. If
+	// nil a formatter.Json will be used.
+	Formatter formatter.Formatter
+
+	// Delegate receives all events which are not audit events. If nil they
+	// are ignored.
+	Delegate consumer.Consumer
+
+	// OnError will be called if an audit event could not be written. If nil
+	// it will panic; audit events must never be dropped silently.
+	//
+	// It is also called (with a nil event) by NewConsumer() if the last record
+	// of an existing file was incomplete (see ErrIncompleteRecord). If nil
+	// this will be reported to stderr instead.
+	OnError func(*Consumer, log.Event, error)
+
+	out      syncWriter
+	previous string
+	mutex    sync.Mutex
+}
+
+type syncWriter interface {
+	io.WriteCloser
+	Sync() error
+}
+
+// NewConsumer creates a new instance of Consumer which writes into the file
+// with the given filename. If the file already exists its chain will be
+// verified and continued; if the chain is broken an error wrapping
+// ErrChainBroken will be returned.
+//
+// An incomplete last record (see ErrIncompleteRecord) does not break the
+// chain. It is moved into a file with the suffix QuarantineSuffix, removed
+// from the file itself and reported to Consumer.OnError (or stderr if not
+// set).
+func NewConsumer(filename string, customizer ...func(*Consumer)) (*Consumer, error) {
+	previous, size, err := lastHashOf(filename)
+	var incomplete error
+	if errors.Is(err, ErrIncompleteRecord) {
+		incomplete, err = quarantineTail(filename, size, err)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	f, err := os.OpenFile(filename, os.O_CREATE|os.O_APPEND|os.O_WRONLY, DefaultFileMode)
+	if err != nil {
+		return nil, err
+	}
+
+	result := &Consumer{
+		out:      f,
+		previous: previous,
+	}
+	for _, c := range customizer {
+		c(result)
+	}
+	if incomplete != nil {
+		if v := result.OnError; v != nil {
+			v(result, nil, incomplete)
+		} else {
+			_, _ = fmt.Fprintf(stderr, "audit file %s: %v\n", filename, incomplete)
+		}
+	}
+	return result, nil
+}
+
+func lastHashOf(filename string) (string, int64, error) {
+	f, err := os.Open(filename)
+	if errors.Is(err, os.ErrNotExist) {
+		return "", 0, nil
+	}
+	if err != nil {
+		return "", 0, err
+	}
+	defer func() { _ = f.Close() }()
+
+	return verify(f)
+}
+
+// quarantineTail moves everything of the given file after offset into its
+// quarantine file (see QuarantineSuffix). It returns the given cause enriched
+// with the name of the quarantine file.
+func quarantineTail(filename string, offset int64, cause error) (incomplete error, err error) {
+	f, err := os.OpenFile(filename, os.O_RDWR, 0)
+	if err != nil {
+		return nil, err
+	}
+	defer func() { _ = f.Close() }()
+
+	tail, err := io.ReadAll(io.NewSectionReader(f, offset, math.MaxInt64-offset))
+	if err != nil {
+		return nil, err
+	}
+
+	quarantine := filename + QuarantineSuffix
+	q, err := os.OpenFile(quarantine, os.O_CREATE|os.O_APPEND|os.O_WRONLY, DefaultFileMode)
+	if err != nil {
+		return nil, err
+	}
+	defer func() { _ = q.Close() }()
+	if _, err := q.Write(append(tail, '\n')); err != nil {
+		return nil, err
+	}
+	if err := q.Sync(); err != nil {
+		return nil, err
+	}
+
+	if err := f.Truncate(offset); err != nil {
+		return nil, err
+	}
+	if err := f.Sync(); err != nil {
+		return nil, err
+	}
+	return fmt.Errorf("%w; moved it to %s", cause, quarantine), nil
+}
+
+// Consume implements consumer.Consumer#Consume()
+func (instance *Consumer) Consume(event log.Event, source log.CoreLogger) {
+	if event == nil {
+		return
+	}
+	if !log.IsAudit(event) {
+		if v := instance.Delegate; v != nil {
+			v.Consume(event, source)
+		}
+		return
+	}
+
+	if err := instance.write(event, source); err != nil {
+		if v := instance.OnError; v != nil {
+			v(instance, event, err)
+		} else {
+			panic(fmt.Sprintf("cannot write audit event %v: %v", event, err))
+		}
+	}
+}
+
+func (instance *Consumer) write(event log.Event, source log.CoreLogger) error {
+	formatted, err := instance.getFormatter().Format(event, source.GetProvider(), nil)
+	if err != nil {
+		return err
+	}
+	var buf bytes.Buffer
+	if err := json.Compact(&buf, formatted); err != nil {
+		return fmt.Errorf("formatter does not produce valid JSON: %w", err)
+	}
+	content := buf.Bytes()
+
+	instance.mutex.Lock()
+	defer instance.mutex.Unlock()
+
+	if instance.out == nil {
+		return errors.New("consumer is closed")
+	}
+
+	hash := computeHash(instance.previous, content)
+	if _, err := instance.out.Write(encodeRecord(instance.previous, hash, content)); err != nil {
+		return err
+	}
+	if err := instance.out.Sync(); err != nil {
+		return err
+	}
+	instance.previous = hash
+	return nil
+}
+
+// Close closes the underlying file.
+func (instance *Consumer) Close() error {
+	instance.mutex.Lock()
+	defer instance.mutex.Unlock()
+
+	if instance.out == nil {
+		return nil
+	}
+	err := instance.out.Close()
+	instance.out = nil
+	return err
+}
+
+func (instance *Consumer) getFormatter() formatter.Formatter {
+	if v := instance.Formatter; v != nil {
+		return v
+	}
+	return formatter.NewJson()
+}
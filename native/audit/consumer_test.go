@@ -0,0 +1,203 @@
+package audit
+
+import (
+	"errors"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	log "github.com/echocat/slf4g"
+	"github.com/echocat/slf4g/fields"
+
+	"github.com/echocat/slf4g/native"
+	"github.com/echocat/slf4g/native/consumer"
+	"github.com/echocat/slf4g/native/formatter"
+	"github.com/echocat/slf4g/native/location"
+
+	"github.com/echocat/slf4g/internal/test/assert"
+)
+
+func Test_Consumer_Consume(t *testing.T) {
+	givenFile := filepath.Join(t.TempDir(), "audit.log")
+	givenDelegate := consumer.NewRecorder()
+	instance, err := NewConsumer(givenFile, func(v *Consumer) {
+		v.Delegate = givenDelegate
+		v.Formatter = formatter.NewJson(func(v *formatter.Json) {
+			v.KeySorter = fields.DefaultKeySorter
+		})
+	})
+	assert.ToBeNoError(t, err)
+	logger := newProvider(instance).GetLogger("foo")
+
+	log.NewAuditLogger(logger).Info("first")
+	logger.Info("not audited")
+	log.NewAuditLogger(logger).With("user", "bar").Warn("second")
+	assert.ToBeNoError(t, instance.Close())
+
+	assert.ToBeEqual(t, 1, givenDelegate.Len())
+	assert.ToBeNoError(t, Verify(givenFile))
+
+	actual := readLines(t, givenFile)
+	assert.ToBeEqual(t, 2, len(actual))
+	assert.ToBeMatching(t, `^\{"previous":"","hash":"[0-9a-f]{64}","event":\{"level":"INFO","audit":true,"logger":"foo","message":"first"}}$`, actual[0])
+	assert.ToBeMatching(t, `^\{"previous":"[0-9a-f]{64}","hash":"[0-9a-f]{64}","event":\{"level":"WARN","audit":true,"logger":"foo","message":"second","user":"bar"}}$`, actual[1])
+}
+
+func Test_NewConsumer_continuesChain(t *testing.T) {
+	givenFile := filepath.Join(t.TempDir(), "audit.log")
+
+	for i := 0; i < 2; i++ {
+		instance, err := NewConsumer(givenFile)
+		assert.ToBeNoError(t, err)
+		log.NewAuditLogger(newProvider(instance).GetRootLogger()).Info("hello")
+		assert.ToBeNoError(t, instance.Close())
+	}
+
+	assert.ToBeEqual(t, 2, len(readLines(t, givenFile)))
+	assert.ToBeNoError(t, Verify(givenFile))
+}
+
+func Test_NewConsumer_withBrokenChain(t *testing.T) {
+	givenFile := filepath.Join(t.TempDir(), "audit.log")
+	assert.ToBeNoError(t, os.WriteFile(givenFile, []byte(`{"previous":"","hash":"abc","event":{}}`+"\n"), 0600))
+
+	actual, actualErr := NewConsumer(givenFile)
+
+	assert.ToBeNil(t, actual)
+	assert.ToBeEqual(t, true, errors.Is(actualErr, ErrChainBroken))
+}
+
+func Test_NewConsumer_withIncompleteLastRecord(t *testing.T) {
+	givenFile := filepath.Join(t.TempDir(), "audit.log")
+	instance, err := NewConsumer(givenFile)
+	assert.ToBeNoError(t, err)
+	log.NewAuditLogger(newProvider(instance).GetRootLogger()).Info("first")
+	assert.ToBeNoError(t, instance.Close())
+	givenTorn := `{"previous":"abc","hash":"de`
+	appendToFile(t, givenFile, givenTorn)
+
+	var actualErr error
+	instance, err = NewConsumer(givenFile, func(v *Consumer) {
+		v.OnError = func(_ *Consumer, event log.Event, err error) {
+			assert.ToBeNil(t, event)
+			actualErr = err
+		}
+	})
+	assert.ToBeNoError(t, err)
+	log.NewAuditLogger(newProvider(instance).GetRootLogger()).Info("second")
+	assert.ToBeNoError(t, instance.Close())
+
+	assert.ToBeEqual(t, true, errors.Is(actualErr, ErrIncompleteRecord))
+	assert.ToBeEqual(t, true, errors.Is(actualErr, ErrChainBroken))
+	assert.ToBeEqual(t, 2, len(readLines(t, givenFile)))
+	assert.ToBeNoError(t, Verify(givenFile))
+	assert.ToBeEqual(t, []string{givenTorn}, readLines(t, givenFile+QuarantineSuffix))
+}
+
+func Test_NewConsumer_withIncompleteOnlyRecord(t *testing.T) {
+	givenFile := filepath.Join(t.TempDir(), "audit.log")
+	appendToFile(t, givenFile, `{"prev`)
+	actualStderr := captureStderr(t)
+
+	instance, err := NewConsumer(givenFile)
+	assert.ToBeNoError(t, err)
+	assert.ToBeEqual(t, "audit file "+givenFile+": audit chain broken: line 1: incomplete record; moved it to "+givenFile+QuarantineSuffix+"\n", actualStderr.String())
+	log.NewAuditLogger(newProvider(instance).GetRootLogger()).Info("hello")
+	assert.ToBeNoError(t, instance.Close())
+
+	assert.ToBeEqual(t, 1, len(readLines(t, givenFile)))
+	assert.ToBeNoError(t, Verify(givenFile))
+	assert.ToBeEqual(t, []string{`{"prev`}, readLines(t, givenFile+QuarantineSuffix))
+}
+
+func Test_Consumer_Consume_failing(t *testing.T) {
+	givenErr := errors.New("expected")
+	var actualErr error
+	instance := &Consumer{
+		out: &failingWriter{givenErr},
+		OnError: func(_ *Consumer, _ log.Event, err error) {
+			actualErr = err
+		},
+	}
+
+	log.NewAuditLogger(newProvider(instance).GetRootLogger()).Info("hello")
+
+	assert.ToBeSame(t, givenErr, actualErr)
+}
+
+func Test_Consumer_Consume_failingWithoutOnError(t *testing.T) {
+	instance := &Consumer{
+		out: &failingWriter{errors.New("expected")},
+	}
+	logger := log.NewAuditLogger(newProvider(instance).GetRootLogger())
+
+	assert.Execution(t, func() {
+		logger.Info("hello")
+	}).WillPanicWith("^cannot write audit event .+: expected$")
+}
+
+func Test_Consumer_Consume_closed(t *testing.T) {
+	givenFile := filepath.Join(t.TempDir(), "audit.log")
+	instance, err := NewConsumer(givenFile)
+	assert.ToBeNoError(t, err)
+	assert.ToBeNoError(t, instance.Close())
+	assert.ToBeNoError(t, instance.Close())
+	logger := log.NewAuditLogger(newProvider(instance).GetRootLogger())
+
+	assert.Execution(t, func() {
+		logger.Info("hello")
+	}).WillPanicWith("^cannot write audit event .+: consumer is closed$")
+}
+
+func newProvider(c consumer.Consumer) *native.Provider {
+	return &native.Provider{
+		LocationDiscovery: location.NoopDiscovery(),
+		CoreLoggerCustomizer: func(_ *native.Provider, cl *native.CoreLogger) log.CoreLogger {
+			cl.Consumer = consumer.Func(func(event log.Event, source log.CoreLogger) {
+				c.Consume(event.Without("timestamp"), source)
+			})
+			return cl
+		},
+	}
+}
+
+func captureStderr(t *testing.T) *strings.Builder {
+	result := &strings.Builder{}
+	before := stderr
+	stderr = result
+	t.Cleanup(func() { stderr = before })
+	return result
+}
+
+func appendToFile(t *testing.T, filename string, content string) {
+	t.Helper()
+	f, err := os.OpenFile(filename, os.O_CREATE|os.O_APPEND|os.O_WRONLY, 0600)
+	assert.ToBeNoError(t, err)
+	_, err = f.WriteString(content)
+	assert.ToBeNoError(t, err)
+	assert.ToBeNoError(t, f.Close())
+}
+
+func readLines(t *testing.T, filename string) []string {
+	t.Helper()
+	content, err := os.ReadFile(filename)
+	assert.ToBeNoError(t, err)
+	return strings.Split(strings.TrimSuffix(string(content), "\n"), "\n")
+}
+
+type failingWriter struct {
+	err error
+}
+
+func (instance *failingWriter) Write([]byte) (int, error) {
+	return 0, instance.err
+}
+
+func (instance *failingWriter) Sync() error {
+	return instance.err
+}
+
+func (instance *failingWriter) Close() error {
+	return nil
+}
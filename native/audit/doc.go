@@ -0,0 +1,26 @@
+// Package audit provides a consumer.Consumer which writes audit events (see
+// log.IsAudit()) with guaranteed delivery into a dedicated file.
+//
+// Each event is written as one record per line and the file is synced after
+// each write. Every record carries the hash of the previous record, which
+// forms a chain that makes tampering detectable. Use Verify() to check the
+// chain of a file. An incomplete last record (for example because the process
+// crashed while writing it) is moved aside when the file is opened again; see
+// NewConsumer().
+//
+// Usage:
+//
+//	c, err := audit.NewConsumer("audit.log", func(v *audit.Consumer) {
+//	    // All other events are still written to the default consumer.
+//	    v.Delegate = consumer.Default
+//	})
+//	if err != nil {
+//	    panic(err)
+//	}
+//	defer c.Close()
+//	native.DefaultProvider.Consumer = c
+//
+//	log.NewAuditLogger(log.GetLogger("users")).
+//	    With("user", "foo").
+//	    Info("User was deleted.")
+package audit
@@ -0,0 +1,33 @@
+package audit
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+)
+
+type record struct {
+	Previous string          `json:"previous"`
+	Hash     string          `json:"hash"`
+	Event    json.RawMessage `json:"event"`
+}
+
+func computeHash(previous string, event []byte) string {
+	h := sha256.New()
+	_, _ = h.Write([]byte(previous))
+	_, _ = h.Write([]byte{'\n'})
+	_, _ = h.Write(event)
+	return hex.EncodeToString(h.Sum(nil))
+}
+
+func encodeRecord(previous, hash string, event []byte) []byte {
+	result := make([]byte, 0, len(event)+len(previous)+len(hash)+40)
+	result = append(result, `{"previous":"`...)
+	result = append(result, previous...)
+	result = append(result, `","hash":"`...)
+	result = append(result, hash...)
+	result = append(result, `","event":`...)
+	result = append(result, event...)
+	result = append(result, '}', '\n')
+	return result
+}
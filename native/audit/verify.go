@@ -0,0 +1,72 @@
+package audit
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+)
+
+// ErrChainBroken indicates that the chain of an audit file is broken; which
+// means that the file was tampered with or is corrupt.
+var ErrChainBroken = errors.New("audit chain broken")
+
+// ErrIncompleteRecord indicates that the last record of an audit file is
+// incomplete; which usually happens if the process was terminated while this
+// record was written. It is always wrapped together with ErrChainBroken.
+var ErrIncompleteRecord = errors.New("incomplete record")
+
+// Verify checks the chain of the audit file with the given filename. It
+// returns an error wrapping ErrChainBroken (including the affected line) if
+// the chain is broken.
+func Verify(filename string) error {
+	f, err := os.Open(filename)
+	if err != nil {
+		return err
+	}
+	defer func() { _ = f.Close() }()
+
+	return VerifyReader(f)
+}
+
+// VerifyReader is like Verify() but reads the records from the given reader.
+func VerifyReader(r io.Reader) error {
+	_, _, err := verify(r)
+	return err
+}
+
+// verify returns the hash of the last record and the amount of bytes which
+// are covered by the verified records. If the last record is incomplete, the
+// result is valid up to the record before, together with an error wrapping
+// ErrIncompleteRecord.
+func verify(r io.Reader) (last string, size int64, err error) {
+	br := bufio.NewReader(r)
+	for n := 1; ; n++ {
+		line, rErr := br.ReadBytes('\n')
+		if rErr == io.EOF && len(line) == 0 {
+			return last, size, nil
+		}
+		if rErr != nil && rErr != io.EOF {
+			return "", 0, rErr
+		}
+		if rErr == io.EOF {
+			return last, size, fmt.Errorf("%w: line %d: %w", ErrChainBroken, n, ErrIncompleteRecord)
+		}
+
+		var rec record
+		if err := json.Unmarshal(bytes.TrimSuffix(line, []byte{'\n'}), &rec); err != nil {
+			return "", 0, fmt.Errorf("%w: line %d: cannot parse record: %v", ErrChainBroken, n, err)
+		}
+		if rec.Previous != last {
+			return "", 0, fmt.Errorf("%w: line %d: previous hash %q does not match %q", ErrChainBroken, n, rec.Previous, last)
+		}
+		if expected := computeHash(rec.Previous, rec.Event); rec.Hash != expected {
+			return "", 0, fmt.Errorf("%w: line %d: hash %q does not match content", ErrChainBroken, n, rec.Hash)
+		}
+		last = rec.Hash
+		size += int64(len(line))
+	}
+}
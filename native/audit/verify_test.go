@@ -0,0 +1,49 @@
+package audit
+
+import (
+	"errors"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/echocat/slf4g/internal/test/assert"
+)
+
+func Test_VerifyReader(t *testing.T) {
+	first := computeHash("", []byte(`{"msg":"a"}`))
+	second := computeHash(first, []byte(`{"msg":"b"}`))
+	valid := string(encodeRecord("", first, []byte(`{"msg":"a"}`))) +
+		string(encodeRecord(first, second, []byte(`{"msg":"b"}`)))
+
+	cases := []struct {
+		name     string
+		given    string
+		expected string
+	}{
+		{"empty", "", ""},
+		{"valid", valid, ""},
+		{"modifiedEvent", strings.Replace(valid, `"msg":"b"`, `"msg":"c"`, 1), `^audit chain broken: line 2: hash "[0-9a-f]+" does not match content$`},
+		{"removedRecord", string(encodeRecord(first, second, []byte(`{"msg":"b"}`))), `^audit chain broken: line 1: previous hash "[0-9a-f]+" does not match ""$`},
+		{"incomplete", strings.TrimSuffix(valid, "\n"), `^audit chain broken: line 2: incomplete record$`},
+		{"garbage", "foo\n", `^audit chain broken: line 1: cannot parse record: .+$`},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			actual := VerifyReader(strings.NewReader(c.given))
+
+			if c.expected == "" {
+				assert.ToBeNoError(t, actual)
+			} else {
+				assert.ToBeMatching(t, c.expected, actual)
+				assert.ToBeEqual(t, true, errors.Is(actual, ErrChainBroken))
+			}
+		})
+	}
+}
+
+func Test_Verify_withMissingFile(t *testing.T) {
+	actual := Verify(filepath.Join(t.TempDir(), "missing.log"))
+
+	assert.ToBeNotNil(t, actual)
+}
@@ -0,0 +1,19 @@
+package native
+
+import (
+	"io"
+	"testing"
+
+	"github.com/echocat/slf4g/native/consumer"
+	"github.com/echocat/slf4g/testing/discard"
+)
+
+func BenchmarkNative(b *testing.B) {
+	discard.Benchmark(b, &Provider{
+		Consumer: consumer.NewWriter(io.Discard),
+	})
+}
+
+func BenchmarkDiscard(b *testing.B) {
+	discard.Benchmark(b, discard.NewProvider())
+}
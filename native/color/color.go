@@ -0,0 +1,231 @@
+package color
+
+import (
+	"fmt"
+	"strconv"
+)
+
+// Color is a foreground color which can be expressed as one of the 16 basic
+// ANSI colors, one of the 256 colors of the ANSI palette or as a 24-bit RGB
+// color. It will be automatically downgraded to the supported Depth while
+// printed. The zero value represents no color at all.
+type Color struct {
+	kind  colorKind
+	value uint32
+	bold  bool
+
+	// as256 and asBasic are the downgraded representations of value. They
+	// are calculated once while creating the Color to prevent searching the
+	// palettes on every Code() call.
+	as256   uint8
+	asBasic uint8
+}
+
+type colorKind uint8
+
+const (
+	colorKindNone colorKind = iota
+	colorKindBasic
+	colorKind256
+	colorKindRgb
+)
+
+// The 16 basic ANSI colors.
+var (
+	Black         = Basic(0)
+	Red           = Basic(1)
+	Green         = Basic(2)
+	Yellow        = Basic(3)
+	Blue          = Basic(4)
+	Magenta       = Basic(5)
+	Cyan          = Basic(6)
+	White         = Basic(7)
+	BrightBlack   = Basic(8)
+	BrightRed     = Basic(9)
+	BrightGreen   = Basic(10)
+	BrightYellow  = Basic(11)
+	BrightBlue    = Basic(12)
+	BrightMagenta = Basic(13)
+	BrightCyan    = Basic(14)
+	BrightWhite   = Basic(15)
+)
+
+// Basic creates a Color of the 16 basic ANSI colors. 0-7 are the normal ones,
+// 8-15 the bright variants. Bigger values are wrapped.
+func Basic(index uint8) Color {
+	return Color{kind: colorKindBasic, value: uint32(index % 16)}
+}
+
+// Ansi256 creates a Color of the 256 colors of the ANSI palette. While
+// printed with Depth16 it will be downgraded to the nearest basic color.
+func Ansi256(index uint8) Color {
+	return Color{kind: colorKind256, value: uint32(index), asBasic: nearestBasic(rgbOf256(index))}
+}
+
+// Rgb creates a 24-bit RGB Color. While printed with Depth256 or Depth16 it
+// will be downgraded to the nearest color of these palettes.
+func Rgb(r, g, b uint8) Color {
+	return rgb(uint32(r)<<16 | uint32(g)<<8 | uint32(b))
+}
+
+func rgb(v uint32) Color {
+	return Color{kind: colorKindRgb, value: v, as256: nearest256(v), asBasic: nearestBasic(v)}
+}
+
+// Hex creates a 24-bit RGB Color (see Rgb) of the given hex representation
+// like #ff8800.
+func Hex(plain string) (Color, error) {
+	if len(plain) != 7 || plain[0] != '#' {
+		return Color{}, fmt.Errorf("illegal hex color: %s", plain)
+	}
+	v, err := strconv.ParseUint(plain[1:], 16, 32)
+	if err != nil {
+		return Color{}, fmt.Errorf("illegal hex color: %s", plain)
+	}
+	return rgb(uint32(v)), nil
+}
+
+// MustHex is same as Hex but will panic in case of errors.
+func MustHex(plain string) Color {
+	result, err := Hex(plain)
+	if err != nil {
+		panic(err)
+	}
+	return result
+}
+
+// Bold returns a copy of this Color which will be additionally printed bold.
+func (instance Color) Bold() Color {
+	instance.bold = true
+	return instance
+}
+
+// IsZero returns true if this represents no color at all.
+func (instance Color) IsZero() bool {
+	return instance.kind == colorKindNone && !instance.bold
+}
+
+// Code returns the parameters of the ANSI escape code (like 31;1) of this
+// Color for the given Depth. If the Color cannot be represented with the
+// given Depth it will be downgraded to the nearest color.
+func (instance Color) Code(depth Depth) string {
+	var result string
+	switch instance.kind {
+	case colorKindBasic:
+		result = basicCode(uint8(instance.value))
+	case colorKind256:
+		index := uint8(instance.value)
+		if depth >= Depth256 || index < 16 {
+			result = code256(index)
+		} else {
+			result = basicCode(instance.asBasic)
+		}
+	case colorKindRgb:
+		v := instance.value
+		switch depth {
+		case DepthTrueColor:
+			result = "38;2;" + strconv.Itoa(int(v>>16&0xff)) + ";" + strconv.Itoa(int(v>>8&0xff)) + ";" + strconv.Itoa(int(v&0xff))
+		case Depth256:
+			result = code256(instance.as256)
+		default:
+			result = basicCode(instance.asBasic)
+		}
+	}
+	if instance.bold {
+		if result != "" {
+			result += ";"
+		}
+		result += "1"
+	}
+	return result
+}
+
+// Colorize wraps the given string with the ANSI escape codes of this Color
+// for the given Depth. If this Color IsZero() the string is returned as it
+// is.
+func (instance Color) Colorize(depth Depth, what string) string {
+	code := instance.Code(depth)
+	if code == "" {
+		return what
+	}
+	return "\x1b[" + code + "m" + what + "\x1b[0m"
+}
+
+// String prints out a meaningful representation of this instance.
+func (instance Color) String() string {
+	var result string
+	switch instance.kind {
+	case colorKindBasic:
+		result = "basic:" + strconv.Itoa(int(instance.value))
+	case colorKind256:
+		result = "256:" + strconv.Itoa(int(instance.value))
+	case colorKindRgb:
+		result = fmt.Sprintf("#%06x", instance.value)
+	default:
+		result = "none"
+	}
+	if instance.bold {
+		result += "+bold"
+	}
+	return result
+}
+
+func basicCode(index uint8) string {
+	if index < 8 {
+		return strconv.Itoa(30 + int(index))
+	}
+	return strconv.Itoa(90 + int(index) - 8)
+}
+
+func code256(index uint8) string {
+	return "38;5;" + strconv.Itoa(int(index))
+}
+
+// basicPalette contains the RGB values of the 16 basic colors like xterm uses
+// them by default.
+var basicPalette = [16]uint32{
+	0x000000, 0xcd0000, 0x00cd00, 0xcdcd00, 0x0000ee, 0xcd00cd, 0x00cdcd, 0xe5e5e5,
+	0x7f7f7f, 0xff0000, 0x00ff00, 0xffff00, 0x5c5cff, 0xff00ff, 0x00ffff, 0xffffff,
+}
+
+var cubeLevels = [6]uint32{0, 95, 135, 175, 215, 255}
+
+func rgbOf256(index uint8) uint32 {
+	switch {
+	case index < 16:
+		return basicPalette[index]
+	case index < 232:
+		i := uint32(index) - 16
+		return cubeLevels[i/36]<<16 | cubeLevels[i/6%6]<<8 | cubeLevels[i%6]
+	default:
+		v := 8 + (uint32(index)-232)*10
+		return v<<16 | v<<8 | v
+	}
+}
+
+func nearest256(rgb uint32) uint8 {
+	best, bestDistance := uint8(16), -1
+	for i := 16; i < 256; i++ {
+		if d := distance(rgb, rgbOf256(uint8(i))); bestDistance < 0 || d < bestDistance {
+			best, bestDistance = uint8(i), d
+		}
+	}
+	return best
+}
+
+func nearestBasic(rgb uint32) uint8 {
+	best, bestDistance := uint8(0), -1
+	for i, candidate := range basicPalette {
+		if d := distance(rgb, candidate); bestDistance < 0 || d < bestDistance {
+			best, bestDistance = uint8(i), d
+		}
+	}
+	return best
+}
+
+func distance(a, b uint32) int {
+	dr := int(a>>16&0xff) - int(b>>16&0xff)
+	dg := int(a>>8&0xff) - int(b>>8&0xff)
+	db := int(a&0xff) - int(b&0xff)
+	return dr*dr + dg*dg + db*db
+}
@@ -0,0 +1,98 @@
+package color
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/echocat/slf4g/internal/test/assert"
+)
+
+func ExampleColor_Colorize() {
+	c := MustHex("#ff8700").Bold()
+
+	fmt.Printf("%q\n", c.Colorize(DepthTrueColor, "hello"))
+	fmt.Printf("%q\n", c.Colorize(Depth256, "hello"))
+	fmt.Printf("%q\n", c.Colorize(Depth16, "hello"))
+
+	// Output:
+	// "\x1b[38;2;255;135;0;1mhello\x1b[0m"
+	// "\x1b[38;5;208;1mhello\x1b[0m"
+	// "\x1b[33;1mhello\x1b[0m"
+}
+
+func Test_Color_Code(t *testing.T) {
+	cases := []struct {
+		given         Color
+		givenDepth    Depth
+		expected      string
+		expectedNamed string
+	}{
+		{Color{}, DepthTrueColor, "", "none"},
+		{Color{}.Bold(), Depth16, "1", "none+bold"},
+		{Red, Depth16, "31", "basic:1"},
+		{Red.Bold(), DepthTrueColor, "31;1", "basic:1+bold"},
+		{BrightBlack, Depth256, "90", "basic:8"},
+		{Basic(17), Depth16, "31", "basic:1"},
+		{Ansi256(208), Depth256, "38;5;208", "256:208"},
+		{Ansi256(208), DepthTrueColor, "38;5;208", "256:208"},
+		{Ansi256(9), Depth16, "38;5;9", "256:9"},
+		{Ansi256(208), Depth16, "33", "256:208"},
+		{Ansi256(240), Depth16, "90", "256:240"},
+		{Rgb(255, 135, 0), DepthTrueColor, "38;2;255;135;0", "#ff8700"},
+		{Rgb(255, 135, 0), Depth256, "38;5;208", "#ff8700"},
+		{Rgb(255, 135, 0), Depth16, "33", "#ff8700"},
+		{Rgb(30, 30, 30), Depth256, "38;5;234", "#1e1e1e"},
+		{Rgb(30, 30, 30), Depth16, "30", "#1e1e1e"},
+	}
+
+	for _, c := range cases {
+		t.Run(fmt.Sprintf("%v-%v", c.given, c.givenDepth), func(t *testing.T) {
+			assert.ToBeEqual(t, c.expected, c.given.Code(c.givenDepth))
+			assert.ToBeEqual(t, c.expectedNamed, c.given.String())
+		})
+	}
+}
+
+func Test_Color_downgradesAreCalculatedOnCreation(t *testing.T) {
+	actualRgb := Rgb(255, 135, 0)
+	assert.ToBeEqual(t, uint8(208), actualRgb.as256)
+	assert.ToBeEqual(t, uint8(3), actualRgb.asBasic)
+
+	actualHex := MustHex("#1e1e1e")
+	assert.ToBeEqual(t, uint8(234), actualHex.as256)
+	assert.ToBeEqual(t, uint8(0), actualHex.asBasic)
+
+	actual256 := Ansi256(240)
+	assert.ToBeEqual(t, uint8(8), actual256.asBasic)
+}
+
+func Test_Color_Colorize(t *testing.T) {
+	assert.ToBeEqual(t, "hello", Color{}.Colorize(Depth16, "hello"))
+	assert.ToBeEqual(t, "\x1b[34mhello\x1b[0m", Blue.Colorize(Depth16, "hello"))
+}
+
+func Test_Color_IsZero(t *testing.T) {
+	assert.ToBeEqual(t, true, Color{}.IsZero())
+	assert.ToBeEqual(t, false, Color{}.Bold().IsZero())
+	assert.ToBeEqual(t, false, Black.IsZero())
+}
+
+func Test_Hex(t *testing.T) {
+	actual, actualErr := Hex("#0a0B0c")
+	assert.ToBeNoError(t, actualErr)
+	assert.ToBeEqual(t, Rgb(10, 11, 12), actual)
+
+	for _, given := range []string{"", "0a0b0c", "#0a0b0", "#0a0b0g"} {
+		t.Run(given, func(t *testing.T) {
+			_, actualErr := Hex(given)
+			assert.ToBeMatching(t, "^illegal hex color: ", actualErr.Error())
+		})
+	}
+}
+
+func Test_MustHex_panics(t *testing.T) {
+	defer func() {
+		assert.ToBeNotNil(t, recover())
+	}()
+	MustHex("foo")
+}
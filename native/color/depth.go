@@ -0,0 +1,130 @@
+package color
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"strings"
+)
+
+// ErrIllegalDepth will be returned in situations where illegal values or
+// representations if a Depth are provided.
+var ErrIllegalDepth = errors.New("illegal color-depth")
+
+// Depth expresses how many colors are supported in the current context.
+type Depth uint8
+
+const (
+	// Depth16 expresses that the 16 basic ANSI colors (including their bright
+	// variants) are supported. This is the most compatible one and the default.
+	Depth16 Depth = 0
+
+	// Depth256 expresses that the 256 colors of the ANSI palette are
+	// supported.
+	Depth256 Depth = 1
+
+	// DepthTrueColor expresses that the full 24-bit RGB colors are supported.
+	DepthTrueColor Depth = 2
+)
+
+// AllDepths returns all possible values of Depth.
+func AllDepths() Depths {
+	return Depths{Depth16, Depth256, DepthTrueColor}
+}
+
+// DetectDepth detects the Depth of the current environment based on the
+// environment variables FORCE_COLOR (2=256 colors, 3=true color), COLORTERM
+// and TERM. If nothing special could be detected Depth16 is returned.
+//
+// It does not detect if colors are supported at all; see
+// DetectSupportForWriter for this.
+func DetectDepth() Depth {
+	switch os.Getenv("FORCE_COLOR") {
+	case "2":
+		return Depth256
+	case "3":
+		return DepthTrueColor
+	}
+
+	switch strings.ToLower(os.Getenv("COLORTERM")) {
+	case "truecolor", "24bit":
+		return DepthTrueColor
+	}
+
+	if os.Getenv("WT_SESSION") != "" {
+		// Windows Terminal does always support true color.
+		return DepthTrueColor
+	}
+
+	term := strings.ToLower(os.Getenv("TERM"))
+	switch {
+	case strings.Contains(term, "truecolor"), strings.Contains(term, "24bit"), strings.HasSuffix(term, "-direct"):
+		return DepthTrueColor
+	case strings.Contains(term, "256color"):
+		return Depth256
+	}
+
+	return Depth16
+}
+
+// MarshalText implements encoding.TextMarshaler
+func (instance Depth) MarshalText() (text []byte, err error) {
+	switch instance {
+	case Depth16:
+		return []byte("16"), nil
+	case Depth256:
+		return []byte("256"), nil
+	case DepthTrueColor:
+		return []byte("truecolor"), nil
+	default:
+		return nil, fmt.Errorf("%w: %d", ErrIllegalDepth, instance)
+	}
+}
+
+// UnmarshalText implements encoding.TextMarshaler
+func (instance *Depth) UnmarshalText(text []byte) error {
+	switch strings.ToLower(string(text)) {
+	case "16", "basic", "ansi":
+		*instance = Depth16
+		return nil
+	case "256", "8bit":
+		*instance = Depth256
+		return nil
+	case "truecolor", "true", "24bit", "rgb":
+		*instance = DepthTrueColor
+		return nil
+	default:
+		return fmt.Errorf("%w: %v", ErrIllegalDepth, string(text))
+	}
+}
+
+// String prints out a meaningful representation of this instance.
+func (instance Depth) String() string {
+	if text, err := instance.MarshalText(); err != nil {
+		return fmt.Sprintf("illegal-color-depth-%d", instance)
+	} else {
+		return string(text)
+	}
+}
+
+// Set will set this instance to the given plain value or errors.
+func (instance *Depth) Set(plain string) error {
+	return instance.UnmarshalText([]byte(plain))
+}
+
+// Depths is a multiple version of Depth.
+type Depths []Depth
+
+// Strings returns a meaningful representation of all of it's values.
+func (instance Depths) Strings() []string {
+	result := make([]string, len(instance))
+	for i, v := range instance {
+		result[i] = v.String()
+	}
+	return result
+}
+
+// String returns a meaningful representation of this instance.
+func (instance Depths) String() string {
+	return strings.Join(instance.Strings(), ",")
+}
@@ -0,0 +1,108 @@
+package color
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/echocat/slf4g/internal/test/assert"
+)
+
+func Test_DetectDepth(t *testing.T) {
+	defer prepareEnv("FORCE_COLOR", "COLORTERM", "WT_SESSION", "TERM")()
+
+	cases := []struct {
+		name            string
+		givenForceColor string
+		givenColorTerm  string
+		givenWtSession  string
+		givenTerm       string
+		expected        Depth
+	}{
+		{"nothing", "", "", "", "", Depth16},
+		{"force 256", "2", "", "", "", Depth256},
+		{"force truecolor", "3", "", "", "xterm", DepthTrueColor},
+		{"force basic", "1", "", "", "xterm-256color", Depth256},
+		{"colorterm truecolor", "", "truecolor", "", "xterm", DepthTrueColor},
+		{"colorterm 24bit", "", "24bit", "", "", DepthTrueColor},
+		{"windows terminal", "", "", "abc", "", DepthTrueColor},
+		{"term direct", "", "", "", "xterm-direct", DepthTrueColor},
+		{"term 256color", "", "", "", "xterm-256color", Depth256},
+		{"term xterm", "", "", "", "xterm", Depth16},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			setEnv("FORCE_COLOR", c.givenForceColor)
+			setEnv("COLORTERM", c.givenColorTerm)
+			setEnv("WT_SESSION", c.givenWtSession)
+			setEnv("TERM", c.givenTerm)
+
+			actual := DetectDepth()
+
+			assert.ToBeEqual(t, c.expected, actual)
+		})
+	}
+}
+
+func Test_Depth_MarshalText(t *testing.T) {
+	cases := []struct {
+		expected string
+		instance Depth
+	}{
+		{"16", Depth16},
+		{"256", Depth256},
+		{"truecolor", DepthTrueColor},
+	}
+	for _, c := range cases {
+		t.Run(c.expected, func(t *testing.T) {
+			actual, actualErr := c.instance.MarshalText()
+			assert.ToBeNil(t, actualErr)
+			assert.ToBeEqual(t, c.expected, string(actual))
+		})
+	}
+}
+
+func Test_Depth_MarshalText_errors(t *testing.T) {
+	instance := Depth(66)
+
+	actual, actualErr := instance.MarshalText()
+	assert.ToBeEqual(t, fmt.Errorf("%w: 66", ErrIllegalDepth), actualErr)
+	assert.ToBeNil(t, actual)
+}
+
+func Test_Depth_UnmarshalText(t *testing.T) {
+	cases := []struct {
+		given    string
+		expected Depth
+	}{
+		{"16", Depth16},
+		{"basic", Depth16},
+		{"256", Depth256},
+		{"8bit", Depth256},
+		{"truecolor", DepthTrueColor},
+		{"24BIT", DepthTrueColor},
+	}
+	for _, c := range cases {
+		t.Run(c.given, func(t *testing.T) {
+			var actual Depth
+			actualErr := actual.Set(c.given)
+			assert.ToBeNil(t, actualErr)
+			assert.ToBeEqual(t, c.expected, actual)
+		})
+	}
+}
+
+func Test_Depth_UnmarshalText_errors(t *testing.T) {
+	var actual Depth
+	actualErr := actual.UnmarshalText([]byte("foo"))
+	assert.ToBeEqual(t, fmt.Errorf("%w: foo", ErrIllegalDepth), actualErr)
+}
+
+func Test_Depth_String(t *testing.T) {
+	assert.ToBeEqual(t, "256", Depth256.String())
+	assert.ToBeEqual(t, "illegal-color-depth-66", Depth(66).String())
+}
+
+func Test_Depths_String(t *testing.T) {
+	assert.ToBeEqual(t, "16,256,truecolor", AllDepths().String())
+}
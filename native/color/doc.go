@@ -2,5 +2,10 @@
 // how the application should behave.
 //
 // Please see DetectSupportForWriter(...) for details how a detection usually
-// works.
+// works and DetectDepth(...) for how many colors are supported.
+//
+// A Theme declares the colors (see Color) used for the several parts of a log
+// event. Each Color is downgraded automatically to the supported Depth while
+// printed; so themes can use 256 or true colors without breaking terminals
+// which only support the 16 basic colors.
 package color
@@ -5,6 +5,31 @@ import (
 	"strings"
 )
 
+// SupportDecisionOfEnvironment returns if the user explicitly decided by
+// environment variables whether colors should be used or not:
+//
+// 1. If NO_COLOR is set to a non-empty value colors should not be used (see
+// https://no-color.org).
+//
+// 2. If FORCE_COLOR is set colors should be used; except it is 0, false, no
+// or off.
+//
+// If decided is false nothing was explicitly configured.
+func SupportDecisionOfEnvironment() (supported, decided bool) {
+	if os.Getenv("NO_COLOR") != "" {
+		return false, true
+	}
+	if v, ok := os.LookupEnv("FORCE_COLOR"); ok {
+		switch strings.ToLower(v) {
+		case "0", "false", "no", "off":
+			return false, true
+		default:
+			return true, true
+		}
+	}
+	return false, false
+}
+
 // SupportAssumptionDetection is a function that detects for the current
 // environment if color support can be assumed. This can for example be done in
 // if this application runs in the context of a GitLabCi run, inside an IDE, ...
@@ -29,6 +29,46 @@ func Test_CanSupportBeAssumed(t *testing.T) {
 	assert.ToBeEqual(t, givenResult, actual2)
 }
 
+func Test_SupportDecisionOfEnvironment(t *testing.T) {
+	defer prepareEnv("NO_COLOR", "FORCE_COLOR")()
+
+	cases := []struct {
+		name             string
+		givenNoColor     *string
+		givenForceColor  *string
+		expectedSupport  bool
+		expectedDecision bool
+	}{
+		{"nothing", nil, nil, false, false},
+		{"no color", pString("1"), nil, false, true},
+		{"empty no color", pString(""), nil, false, false},
+		{"no color wins", pString("1"), pString("1"), false, true},
+		{"force color", nil, pString("1"), true, true},
+		{"empty force color", nil, pString(""), true, true},
+		{"force color 3", nil, pString("3"), true, true},
+		{"force color 0", nil, pString("0"), false, true},
+		{"force color false", nil, pString("false"), false, true},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			_ = os.Unsetenv("NO_COLOR")
+			_ = os.Unsetenv("FORCE_COLOR")
+			if c.givenNoColor != nil {
+				_ = os.Setenv("NO_COLOR", *c.givenNoColor)
+			}
+			if c.givenForceColor != nil {
+				_ = os.Setenv("FORCE_COLOR", *c.givenForceColor)
+			}
+
+			actualSupport, actualDecision := SupportDecisionOfEnvironment()
+
+			assert.ToBeEqual(t, c.expectedSupport, actualSupport)
+			assert.ToBeEqual(t, c.expectedDecision, actualDecision)
+		})
+	}
+}
+
 func Test_SupportAssumptionDetectionIntellij(t *testing.T) {
 	defer prepareEnv("IDEA_INITIAL_DIRECTORY", "TERMINAL_EMULATOR")()
 
@@ -135,3 +175,7 @@ func prepareEnv(keys ...string) func() {
 		}
 	}
 }
+
+func pString(v string) *string {
+	return &v
+}
@@ -41,8 +41,15 @@ func AllSupports() Supports {
 // io.Writer is still returned. Errors are only returned in cases where something
 // bad happens while detecting or preparing for color.
 //
-// See SupportAssumptionDetections for assumed detections.
+// An explicit decision of the user by environment variables (see
+// SupportDecisionOfEnvironment) always wins. See SupportAssumptionDetections
+// for assumed detections.
 func DetectSupportForWriter(w io.Writer) (prepared io.Writer, supported Supported, err error) {
+	byEnvironment, decidedByEnvironment := SupportDecisionOfEnvironment()
+	if decidedByEnvironment && !byEnvironment {
+		return w, SupportedNone, nil
+	}
+
 	actual, err := prepareForColors(w)
 	if actual && err == nil {
 		return w, SupportedNative, nil
@@ -52,6 +59,10 @@ func DetectSupportForWriter(w io.Writer) (prepared io.Writer, supported Supporte
 		return w, SupportedAssumed, nil
 	}
 
+	if decidedByEnvironment {
+		return w, SupportedAssumed, nil
+	}
+
 	for _, d := range SupportAssumptionDetections {
 		if v, err := d(); err != nil {
 			return w, SupportedNone, err
@@ -9,6 +9,7 @@ import (
 )
 
 func Test_DetectSupportForWriter_assuming(t *testing.T) {
+	defer prepareEnv("NO_COLOR", "FORCE_COLOR")()
 	old := SupportAssumptionDetections
 	defer func() {
 		SupportAssumptionDetections = old
@@ -32,6 +33,29 @@ func Test_DetectSupportForWriter_assuming(t *testing.T) {
 	assert.ToBeEqual(t, SupportedNone, actualSupported2)
 }
 
+func Test_DetectSupportForWriter_byEnvironment(t *testing.T) {
+	defer prepareEnv("NO_COLOR", "FORCE_COLOR")()
+	old := SupportAssumptionDetections
+	defer func() {
+		SupportAssumptionDetections = old
+	}()
+	SupportAssumptionDetections = []SupportAssumptionDetection{func() (bool, error) {
+		return true, nil
+	}}
+
+	setEnv("NO_COLOR", "1")
+	_, actualSupported1, actualErr1 := DetectSupportForWriter(os.Stdout)
+	assert.ToBeNil(t, actualErr1)
+	assert.ToBeEqual(t, SupportedNone, actualSupported1)
+
+	SupportAssumptionDetections = nil
+	setEnv("NO_COLOR", "")
+	setEnv("FORCE_COLOR", "1")
+	_, actualSupported2, actualErr2 := DetectSupportForWriter(os.Stdout)
+	assert.ToBeNil(t, actualErr2)
+	assert.ToBeEqual(t, SupportedAssumed, actualSupported2)
+}
+
 func Test_Supported_IsSupported(t *testing.T) {
 	assert.ToBeEqual(t, false, SupportedNone.IsSupported())
 	assert.ToBeEqual(t, true, SupportedNative.IsSupported())
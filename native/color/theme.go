@@ -0,0 +1,125 @@
+package color
+
+import (
+	"github.com/echocat/slf4g/level"
+)
+
+// DefaultTheme is the default instance of Theme which should cover the most
+// of the cases.
+var DefaultTheme = ThemeDefault
+
+// Theme declares the colors which are used to print the several parts of a
+// log event. Each Color will be automatically downgraded to the Depth of the
+// output while printed.
+type Theme struct {
+	// Name of the Theme.
+	Name string
+
+	// Levels contains the colors of the levels.
+	Levels map[level.Level]Color
+
+	// Timestamp is the color of the timestamp.
+	Timestamp Color
+
+	// Logger is the color of the logger name.
+	Logger Color
+
+	// Key is the color of the keys of all fields.
+	Key Color
+
+	// Value is the color of the values of all fields, which does not have a
+	// specific color defined in Fields.
+	Value Color
+
+	// Fields contains the colors of the values of specific fields by their
+	// key.
+	Fields map[string]Color
+
+	// Error is the color of errors.
+	Error Color
+
+	// Stack is the color of stack traces of errors.
+	Stack Color
+}
+
+// LevelColor returns the Color of the given level.Level. If there is no
+// Color configured for it, BrightWhite is returned.
+func (instance *Theme) LevelColor(l level.Level) Color {
+	if v, ok := instance.Levels[l]; ok {
+		return v
+	}
+	return BrightWhite.Bold()
+}
+
+// FieldColor returns the Color of the value of the field with the given key.
+// If there is no Color configured for it, Value is returned.
+func (instance *Theme) FieldColor(key string) Color {
+	if v, ok := instance.Fields[key]; ok {
+		return v
+	}
+	return instance.Value
+}
+
+// ThemeDefault uses only the 16 basic colors and works well on dark and most
+// light terminals.
+var ThemeDefault = &Theme{
+	Name: "default",
+	Levels: map[level.Level]Color{
+		level.Trace: Black.Bold(),
+		level.Debug: Cyan.Bold(),
+		level.Info:  Blue.Bold(),
+		level.Warn:  Yellow.Bold(),
+		level.Error: Red.Bold(),
+		level.Fatal: Magenta.Bold(),
+	},
+	Timestamp: BrightBlack,
+	Logger:    Cyan,
+	Key:       Blue,
+	Error:     Red.Bold(),
+	Stack:     BrightBlack,
+}
+
+// ThemeDark is tuned for terminals with a dark background and makes use of
+// true colors, if supported.
+var ThemeDark = &Theme{
+	Name: "dark",
+	Levels: map[level.Level]Color{
+		level.Trace: MustHex("#6c7086"),
+		level.Debug: MustHex("#89dceb"),
+		level.Info:  MustHex("#89b4fa").Bold(),
+		level.Warn:  MustHex("#f9e2af").Bold(),
+		level.Error: MustHex("#f38ba8").Bold(),
+		level.Fatal: MustHex("#cba6f7").Bold(),
+	},
+	Timestamp: MustHex("#7f849c"),
+	Logger:    MustHex("#94e2d5"),
+	Key:       MustHex("#74c7ec"),
+	Value:     MustHex("#cdd6f4"),
+	Error:     MustHex("#f38ba8").Bold(),
+	Stack:     MustHex("#7f849c"),
+}
+
+// ThemeLight is tuned for terminals with a light background. It avoids
+// bright colors which are hard to read on white.
+var ThemeLight = &Theme{
+	Name: "light",
+	Levels: map[level.Level]Color{
+		level.Trace: MustHex("#8c8fa1"),
+		level.Debug: MustHex("#04a5e5"),
+		level.Info:  MustHex("#1e66f5").Bold(),
+		level.Warn:  MustHex("#df8e1d").Bold(),
+		level.Error: MustHex("#d20f39").Bold(),
+		level.Fatal: MustHex("#8839ef").Bold(),
+	},
+	Timestamp: MustHex("#6c6f85"),
+	Logger:    MustHex("#179299"),
+	Key:       MustHex("#209fb5"),
+	Value:     MustHex("#4c4f69"),
+	Error:     MustHex("#d20f39").Bold(),
+	Stack:     MustHex("#6c6f85"),
+}
+
+// AllThemes returns all built-in themes.
+func AllThemes() []*Theme {
+	return []*Theme{ThemeDefault, ThemeDark, ThemeLight}
+}
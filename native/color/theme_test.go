@@ -0,0 +1,42 @@
+package color
+
+import (
+	"testing"
+
+	"github.com/echocat/slf4g/level"
+
+	"github.com/echocat/slf4g/internal/test/assert"
+)
+
+func Test_Theme_LevelColor(t *testing.T) {
+	instance := &Theme{Levels: map[level.Level]Color{level.Info: Green}}
+
+	assert.ToBeEqual(t, Green, instance.LevelColor(level.Info))
+	assert.ToBeEqual(t, BrightWhite.Bold(), instance.LevelColor(level.Warn))
+}
+
+func Test_Theme_FieldColor(t *testing.T) {
+	instance := &Theme{Value: Green, Fields: map[string]Color{"foo": Red}}
+
+	assert.ToBeEqual(t, Red, instance.FieldColor("foo"))
+	assert.ToBeEqual(t, Green, instance.FieldColor("bar"))
+}
+
+func Test_AllThemes(t *testing.T) {
+	for _, theme := range AllThemes() {
+		t.Run(theme.Name, func(t *testing.T) {
+			for _, l := range level.GetProvider().GetLevels() {
+				assert.ToBeEqual(t, false, theme.LevelColor(l).IsZero())
+			}
+			assert.ToBeEqual(t, false, theme.Timestamp.IsZero())
+			assert.ToBeEqual(t, false, theme.Logger.IsZero())
+			assert.ToBeEqual(t, false, theme.Key.IsZero())
+			assert.ToBeEqual(t, false, theme.Error.IsZero())
+		})
+	}
+}
+
+func Test_ThemeDefault_matchesDefaultLevelColors(t *testing.T) {
+	assert.ToBeEqual(t, "34;1", ThemeDefault.LevelColor(level.Info).Code(DepthTrueColor))
+	assert.ToBeEqual(t, "31;1", ThemeDefault.LevelColor(level.Error).Code(Depth16))
+}
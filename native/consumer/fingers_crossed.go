@@ -0,0 +1,246 @@
+package consumer
+
+import (
+	"sync"
+
+	log "github.com/echocat/slf4g"
+	"github.com/echocat/slf4g/fields"
+	"github.com/echocat/slf4g/level"
+)
+
+var (
+	// DefaultFingersCrossedSize is the default amount of events which are
+	// kept by FingersCrossed. See FingersCrossed.Size for more details.
+	DefaultFingersCrossedSize = 100
+
+	// DefaultFingersCrossedPassLevel is the default minimum level of events
+	// which are passed directly by FingersCrossed. See
+	// FingersCrossed.PassLevel for more details.
+	DefaultFingersCrossedPassLevel = level.Info
+
+	// DefaultFingersCrossedTriggerLevel is the default minimum level of events
+	// which flush the buffer of FingersCrossed. See
+	// FingersCrossed.TriggerLevel for more details.
+	DefaultFingersCrossedTriggerLevel = level.Error
+
+	// DefaultFingersCrossedBackfillKey is the default key of the field which
+	// marks events flushed by FingersCrossed. See FingersCrossed.BackfillKey
+	// for more details.
+	DefaultFingersCrossedBackfillKey = "backfill"
+)
+
+// FingersCrossed is an implementation of a Consumer which only passes events
+// of at least PassLevel to the Delegate. The last Size events below this
+// level are kept in a ring buffer. As soon as an event of at least
+// TriggerLevel is consumed, the buffered events are passed (marked with the
+// BackfillKey field) in front of it to the Delegate; so the context which led
+// to an error is available without logging everything all the time.
+//
+// Audit events (see log.MarkAsAudit()) are always passed directly, because
+// they must not be dropped.
+//
+// Values of fields.Lazy (including messages of Debugf() and similar) of
+// buffered events are resolved as soon as the events are buffered; so they
+// represent the state at the time they were logged. All other values (like
+// slices or pointers) are kept by reference; changes to them will be visible
+// in the flushed events.
+//
+// The events which should be buffered have to reach this Consumer; so the
+// level of the loggers (see native.Provider.Level) has to be set to the lowest
+// level which should be buffered (for example level.Debug).
+type FingersCrossed struct {
+	// Delegate is the Consumer to which all passed events are delivered. If
+	// nothing was provided Default will be used.
+	Delegate Consumer
+
+	// Size is the maximum amount of events kept in the buffer. If exceeded
+	// the oldest ones are dropped. If not set DefaultFingersCrossedSize will
+	// be used.
+	Size int
+
+	// PassLevel is the minimum level of events which are passed directly to
+	// the Delegate. If not set DefaultFingersCrossedPassLevel will be used.
+	PassLevel level.Level
+
+	// TriggerLevel is the minimum level of events which flush the buffer. If
+	// not set DefaultFingersCrossedTriggerLevel will be used.
+	TriggerLevel level.Level
+
+	// BackfillKey is the key of the field which is set to true on each
+	// flushed event. If not set DefaultFingersCrossedBackfillKey will be used.
+	BackfillKey string
+
+	buffer []fingersCrossedEntry
+	head   int
+	length int
+
+	mutex sync.Mutex
+}
+
+type fingersCrossedEntry struct {
+	event  log.Event
+	source log.CoreLogger
+}
+
+// NewFingersCrossed creates a new instance of FingersCrossed which delivers
+// to the given delegate, can be customized using customizer and is ready to
+// use.
+func NewFingersCrossed(delegate Consumer, customizer ...func(*FingersCrossed)) *FingersCrossed {
+	result := &FingersCrossed{
+		Delegate: delegate,
+	}
+	for _, c := range customizer {
+		c(result)
+	}
+	return result
+}
+
+// Consume implements Consumer.Consume()
+func (instance *FingersCrossed) Consume(event log.Event, source log.CoreLogger) {
+	if event == nil {
+		return
+	}
+
+	instance.mutex.Lock()
+	defer instance.mutex.Unlock()
+
+	l := event.GetLevel()
+	if l.CompareTo(instance.getTriggerLevel()) >= 0 {
+		instance.flush()
+	}
+	// Audit events must never be dropped; so they are not buffered.
+	if l.CompareTo(instance.getPassLevel()) >= 0 || log.IsAudit(event) {
+		instance.getDelegate().Consume(event, source)
+		return
+	}
+	instance.push(event, source)
+}
+
+// Flush passes all currently buffered events to the Delegate, like it happens
+// if an event of at least TriggerLevel is consumed.
+func (instance *FingersCrossed) Flush() {
+	instance.mutex.Lock()
+	defer instance.mutex.Unlock()
+
+	instance.flush()
+}
+
+// Reset drops all currently buffered events.
+func (instance *FingersCrossed) Reset() {
+	instance.mutex.Lock()
+	defer instance.mutex.Unlock()
+
+	instance.buffer, instance.head, instance.length = nil, 0, 0
+}
+
+// Len returns the amount of currently buffered events.
+func (instance *FingersCrossed) Len() int {
+	instance.mutex.Lock()
+	defer instance.mutex.Unlock()
+
+	return instance.length
+}
+
+// Unwrap returns the Delegate.
+func (instance *FingersCrossed) Unwrap() Consumer {
+	return instance.getDelegate()
+}
+
+func (instance *FingersCrossed) push(event log.Event, source log.CoreLogger) {
+	size := instance.getSize()
+	if len(instance.buffer) != size {
+		instance.resize(size)
+	}
+	entry := fingersCrossedEntry{resolveLazyValues(event), source}
+	if instance.length < size {
+		instance.buffer[(instance.head+instance.length)%size] = entry
+		instance.length++
+		return
+	}
+	// The buffer is full; overwrite the oldest one.
+	instance.buffer[instance.head] = entry
+	instance.head = (instance.head + 1) % size
+}
+
+// resolveLazyValues returns the given event with all of its fields.Lazy values
+// replaced by their current values.
+func resolveLazyValues(event log.Event) log.Event {
+	var resolved map[string]interface{}
+	_ = event.ForEach(func(key string, value interface{}) error {
+		if v, ok := value.(fields.Lazy); ok {
+			if resolved == nil {
+				resolved = map[string]interface{}{}
+			}
+			resolved[key] = v.Get()
+		}
+		return nil
+	})
+	if resolved == nil {
+		return event
+	}
+	return event.WithAll(resolved)
+}
+
+func (instance *FingersCrossed) resize(size int) {
+	buffer := make([]fingersCrossedEntry, size)
+	skip := 0
+	if instance.length > size {
+		skip = instance.length - size
+	}
+	n := 0
+	for i := skip; i < instance.length; i++ {
+		buffer[n] = instance.buffer[(instance.head+i)%len(instance.buffer)]
+		n++
+	}
+	instance.buffer, instance.head, instance.length = buffer, 0, n
+}
+
+func (instance *FingersCrossed) flush() {
+	if instance.length == 0 {
+		return
+	}
+	delegate := instance.getDelegate()
+	key := instance.getBackfillKey()
+	for i := 0; i < instance.length; i++ {
+		index := (instance.head + i) % len(instance.buffer)
+		entry := instance.buffer[index]
+		instance.buffer[index] = fingersCrossedEntry{}
+		delegate.Consume(entry.event.With(key, true), entry.source)
+	}
+	instance.head, instance.length = 0, 0
+}
+
+func (instance *FingersCrossed) getDelegate() Consumer {
+	if v := instance.Delegate; v != nil {
+		return v
+	}
+	return Default
+}
+
+func (instance *FingersCrossed) getSize() int {
+	if v := instance.Size; v > 0 {
+		return v
+	}
+	return DefaultFingersCrossedSize
+}
+
+func (instance *FingersCrossed) getPassLevel() level.Level {
+	if v := instance.PassLevel; v != 0 {
+		return v
+	}
+	return DefaultFingersCrossedPassLevel
+}
+
+func (instance *FingersCrossed) getTriggerLevel() level.Level {
+	if v := instance.TriggerLevel; v != 0 {
+		return v
+	}
+	return DefaultFingersCrossedTriggerLevel
+}
+
+func (instance *FingersCrossed) getBackfillKey() string {
+	if v := instance.BackfillKey; v != "" {
+		return v
+	}
+	return DefaultFingersCrossedBackfillKey
+}
@@ -0,0 +1,32 @@
+package consumer_test
+
+import (
+	"testing"
+
+	log "github.com/echocat/slf4g"
+	"github.com/echocat/slf4g/level"
+
+	"github.com/echocat/slf4g/native"
+	"github.com/echocat/slf4g/native/consumer"
+
+	"github.com/echocat/slf4g/internal/test/assert"
+)
+
+func Test_FingersCrossed_Consume_resolvesLazyValuesWhenBuffered(t *testing.T) {
+	givenDelegate := consumer.NewRecorder()
+	provider := &native.Provider{
+		Level:    level.Debug,
+		Consumer: consumer.NewFingersCrossed(givenDelegate),
+	}
+	logger := provider.GetLogger("foo")
+
+	state := []string{"before"}
+	logger.Debugf("state=%v", state)
+	state[0] = "MUTATED"
+	logger.Error("boom")
+
+	actual := givenDelegate.GetAll()
+	assert.ToBeEqual(t, 2, len(actual))
+	assert.ToBeEqual(t, "state=[before]", *log.GetMessageOf(actual[0], provider))
+	assert.ToBeEqual(t, "boom", *log.GetMessageOf(actual[1], provider))
+}
@@ -0,0 +1,153 @@
+package consumer
+
+import (
+	"fmt"
+	"testing"
+
+	log "github.com/echocat/slf4g"
+	"github.com/echocat/slf4g/level"
+	"github.com/echocat/slf4g/testing/recording"
+
+	"github.com/echocat/slf4g/internal/test/assert"
+)
+
+func Test_NewFingersCrossed(t *testing.T) {
+	givenDelegate := NewRecorder()
+
+	instance := NewFingersCrossed(givenDelegate)
+
+	assert.ToBeSame(t, givenDelegate, instance.Delegate)
+	assert.ToBeEqual(t, 0, instance.Size)
+}
+
+func Test_NewFingersCrossed_withCustomization(t *testing.T) {
+	instance := NewFingersCrossed(nil, func(v *FingersCrossed) {
+		v.Size = 66
+	})
+
+	assert.ToBeEqual(t, 66, instance.Size)
+	assert.ToBeSame(t, Default, instance.Unwrap())
+}
+
+func Test_FingersCrossed_Consume(t *testing.T) {
+	cases := []struct {
+		name     string
+		given    []level.Level
+		expected []string
+	}{{
+		name:     "passesOnly",
+		given:    []level.Level{level.Debug, level.Info, level.Trace, level.Warn},
+		expected: []string{"1:3000", "3:4000"},
+	}, {
+		name:     "backfills",
+		given:    []level.Level{level.Debug, level.Info, level.Trace, level.Error},
+		expected: []string{"1:3000", "0:2000:backfill", "2:1000:backfill", "3:5000"},
+	}, {
+		name:     "dropsOldest",
+		given:    []level.Level{level.Debug, level.Trace, level.Debug, level.Debug, level.Fatal},
+		expected: []string{"1:1000:backfill", "2:2000:backfill", "3:2000:backfill", "4:6000"},
+	}, {
+		name:     "backfillsOnlyOnce",
+		given:    []level.Level{level.Debug, level.Error, level.Debug, level.Error},
+		expected: []string{"0:2000:backfill", "1:5000", "2:2000:backfill", "3:5000"},
+	}}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			givenLogger := recording.NewLogger()
+			givenDelegate := NewRecorder()
+			instance := NewFingersCrossed(givenDelegate, func(v *FingersCrossed) {
+				v.Size = 3
+			})
+
+			for i, l := range c.given {
+				instance.Consume(givenLogger.NewEvent(l, map[string]interface{}{"index": i}), givenLogger)
+			}
+
+			assert.ToBeEqual(t, c.expected, describeFingersCrossedEvents(givenDelegate.GetAll()))
+		})
+	}
+}
+
+func Test_FingersCrossed_Consume_withCustomLevelsAndKey(t *testing.T) {
+	givenLogger := recording.NewLogger()
+	givenDelegate := NewRecorder()
+	instance := NewFingersCrossed(givenDelegate, func(v *FingersCrossed) {
+		v.PassLevel = level.Error
+		v.TriggerLevel = level.Warn
+		v.BackfillKey = "fingersCrossed"
+	})
+
+	instance.Consume(givenLogger.NewEvent(level.Info, nil), givenLogger)
+	instance.Consume(givenLogger.NewEvent(level.Warn, nil), givenLogger)
+
+	actual := givenDelegate.GetAll()
+	assert.ToBeEqual(t, 1, len(actual))
+	assert.ToBeEqual(t, level.Info, actual[0].GetLevel())
+	v, _ := actual[0].Get("fingersCrossed")
+	assert.ToBeEqual(t, true, v)
+	assert.ToBeEqual(t, 1, instance.Len())
+}
+
+func Test_FingersCrossed_Consume_passesAuditEvents(t *testing.T) {
+	givenLogger := recording.NewLogger()
+	givenDelegate := NewRecorder()
+	instance := NewFingersCrossed(givenDelegate, func(v *FingersCrossed) {
+		v.Size = 1
+		v.PassLevel = level.Warn
+	})
+
+	instance.Consume(log.MarkAsAudit(givenLogger.NewEvent(level.Info, map[string]interface{}{"index": 0})), givenLogger)
+	instance.Consume(givenLogger.NewEvent(level.Info, map[string]interface{}{"index": 1}), givenLogger)
+	instance.Consume(givenLogger.NewEvent(level.Debug, map[string]interface{}{"index": 2}), givenLogger)
+
+	assert.ToBeEqual(t, []string{"0:3000"}, describeFingersCrossedEvents(givenDelegate.GetAll()))
+	assert.ToBeEqual(t, 1, instance.Len())
+}
+
+func Test_FingersCrossed_Consume_withNil(t *testing.T) {
+	givenDelegate := NewRecorder()
+	instance := NewFingersCrossed(givenDelegate)
+
+	instance.Consume(nil, recording.NewLogger())
+
+	assert.ToBeEqual(t, 0, givenDelegate.Len())
+	assert.ToBeEqual(t, 0, instance.Len())
+}
+
+func Test_FingersCrossed_Flush(t *testing.T) {
+	givenLogger := recording.NewLogger()
+	givenDelegate := NewRecorder()
+	instance := NewFingersCrossed(givenDelegate)
+	instance.Consume(givenLogger.NewEvent(level.Debug, map[string]interface{}{"index": 0}), givenLogger)
+
+	instance.Flush()
+
+	assert.ToBeEqual(t, []string{"0:2000:backfill"}, describeFingersCrossedEvents(givenDelegate.GetAll()))
+	assert.ToBeEqual(t, 0, instance.Len())
+}
+
+func Test_FingersCrossed_Reset(t *testing.T) {
+	givenLogger := recording.NewLogger()
+	givenDelegate := NewRecorder()
+	instance := NewFingersCrossed(givenDelegate)
+	instance.Consume(givenLogger.NewEvent(level.Debug, nil), givenLogger)
+
+	instance.Reset()
+	instance.Flush()
+
+	assert.ToBeEqual(t, 0, givenDelegate.Len())
+	assert.ToBeEqual(t, 0, instance.Len())
+}
+
+func describeFingersCrossedEvents(events []log.Event) []string {
+	result := make([]string, len(events))
+	for i, event := range events {
+		index, _ := event.Get("index")
+		result[i] = fmt.Sprintf("%v:%d", index, event.GetLevel())
+		if v, ok := event.Get("backfill"); ok && v == true {
+			result[i] += ":backfill"
+		}
+	}
+	return result
+}
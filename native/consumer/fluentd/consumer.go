@@ -0,0 +1,510 @@
+package fluentd
+
+import (
+	"bufio"
+	"errors"
+	"fmt"
+	"net"
+	"strings"
+	"sync"
+	"time"
+
+	log "github.com/echocat/slf4g"
+	"github.com/echocat/slf4g/native/formatter"
+	"github.com/echocat/slf4g/native/interceptor"
+)
+
+var (
+	// DefaultTagPrefix is the default prefix of all tags. See
+	// Consumer.TagPrefix for more details.
+	DefaultTagPrefix = "slf4g"
+
+	// DefaultBatchSize is the default maximum amount of entries sent within
+	// one message. See Consumer.BatchSize for more details.
+	DefaultBatchSize = 100
+
+	// DefaultFlushInterval is the default interval in which pending entries
+	// are sent. See Consumer.FlushInterval for more details.
+	DefaultFlushInterval = time.Second
+
+	// DefaultBufferSize is the default maximum amount of entries which are
+	// buffered. See Consumer.BufferSize for more details.
+	DefaultBufferSize = 10000
+
+	// DefaultTimeout is the default timeout for connecting, writing and
+	// waiting for acks. See Consumer.Timeout for more details.
+	DefaultTimeout = 5 * time.Second
+
+	// DefaultMinBackoff is the default time to wait before the first
+	// reconnect. See Consumer.MinBackoff for more details.
+	DefaultMinBackoff = 100 * time.Millisecond
+
+	// DefaultMaxBackoff is the default maximum time to wait between
+	// reconnects. See Consumer.MaxBackoff for more details.
+	DefaultMaxBackoff = 30 * time.Second
+)
+
+var (
+	// ErrBufferOverflow is reported (see Consumer.OnError) if entries were
+	// dropped because the buffer is full.
+	ErrBufferOverflow = errors.New("buffer overflow")
+
+	// ErrIllegalResponse is reported (see Consumer.OnError) if the server
+	// responded with something unexpected.
+	ErrIllegalResponse = errors.New("illegal response")
+
+	// ErrClosed is reported (see Consumer.OnError) if events are consumed
+	// after the Consumer was closed.
+	ErrClosed = errors.New("consumer is closed")
+)
+
+// Consumer is an implementation of consumer.Consumer which sends all consumed
+// events to Fluentd or Fluent Bit using the Forward Protocol v1.
+//
+// Events are encoded as MessagePack [time, record] entries, buffered and sent
+// in the background as PackedForward messages; one message per tag (derived
+// from the logger name, see TagPrefix) which contains up to BatchSize
+// entries. If the connection is lost it will be reestablished with an
+// exponential backoff (see MinBackoff and MaxBackoff); entries are kept in the
+// buffer in the meantime.
+//
+// NewConsumer() is used to create a new instance. Close() has to be called to
+// flush pending entries and to release the connection.
+type Consumer struct {
+	// Formatter is used to format the record of the consumed events. It has to
+	// produce a MessagePack map. If nothing was provided
+	// formatter.NewMessagePack() will be used.
+	Formatter formatter.Formatter
+
+	// Interceptor can be used to intercept the consumption of an event shortly
+	// before the actual consumption or directly afterward. If nothing was
+	// provided interceptor.Default will be used.
+	Interceptor interceptor.Interceptor
+
+	// TagPrefix is the prefix of the tag of each event. The tag is this prefix
+	// followed by the name of the logger, where / are replaced by . (for
+	// example slf4g.github.com.acme.foo). Events of the root logger are
+	// tagged only by the prefix. If not set DefaultTagPrefix will be used.
+	TagPrefix string
+
+	// Tagger can be used to derive the tag from the logger name completely
+	// custom. If set TagPrefix is ignored.
+	Tagger func(loggerName string) string
+
+	// BatchSize is the maximum amount of entries sent within one message. If
+	// this amount of entries is pending they will be sent immediately. If not
+	// set DefaultBatchSize will be used.
+	BatchSize int
+
+	// FlushInterval is the interval in which pending entries are sent. If not
+	// set DefaultFlushInterval will be used.
+	FlushInterval time.Duration
+
+	// BufferSize is the maximum amount of entries which are buffered (for
+	// example while the connection is lost). If exceeded the oldest entries
+	// are dropped and ErrBufferOverflow is reported. If not set
+	// DefaultBufferSize will be used.
+	BufferSize int
+
+	// RequireAck will (if set to true) send a chunk id with each message and
+	// waits for the server to acknowledge it. Messages which are not
+	// acknowledged are sent again with the same chunk id (at least once
+	// semantics); this enables the server to detect duplicates.
+	RequireAck bool
+
+	// Timeout is the timeout for connecting, writing and waiting for acks. If
+	// not set DefaultTimeout will be used.
+	Timeout time.Duration
+
+	// MinBackoff is the time to wait before the first reconnect; it is
+	// doubled with every failed attempt. If not set DefaultMinBackoff will be
+	// used.
+	MinBackoff time.Duration
+
+	// MaxBackoff is the maximum time to wait between reconnects. If not set
+	// DefaultMaxBackoff will be used.
+	MaxBackoff time.Duration
+
+	// OnError will be called if events could not be sent or were dropped. It
+	// will be called from the background goroutine. If nothing was provided
+	// these errors will be silently swallowed.
+	OnError func(*Consumer, error)
+
+	network string
+	address string
+
+	dial    func(network, address string, timeout time.Duration) (net.Conn, error)
+	conn    net.Conn
+	reader  *bufio.Reader
+	backoff time.Duration
+	retryAt time.Time
+
+	queue    chan entry
+	closing  chan struct{}
+	closed   chan struct{}
+	closeErr error
+	once     sync.Once
+	mutex    sync.RWMutex
+}
+
+// NewConsumer creates a new instance of Consumer which sends the events to the
+// given network ("tcp" or "unix") and address and is ready to use. The
+// connection is established lazily in the background.
+func NewConsumer(network, address string, customizer ...func(*Consumer)) *Consumer {
+	result := &Consumer{
+		network: network,
+		address: address,
+		dial:    net.DialTimeout,
+		closing: make(chan struct{}),
+		closed:  make(chan struct{}),
+	}
+	for _, c := range customizer {
+		c(result)
+	}
+	result.queue = make(chan entry, result.getBufferSize())
+	go result.run()
+	return result
+}
+
+// Consume implements consumer.Consumer#Consume()
+func (instance *Consumer) Consume(event log.Event, source log.CoreLogger) {
+	if event == nil {
+		return
+	}
+
+	if event = instance.getInterceptor().OnBeforeLog(event, source.GetProvider()); event == nil {
+		return
+	}
+
+	if !source.IsLevelEnabled(event.GetLevel()) {
+		return
+	}
+
+	if err := instance.enqueue(event, source.GetProvider()); err != nil {
+		instance.onError(err)
+	}
+
+	_ = instance.getInterceptor().OnAfterLog(event, source.GetProvider())
+}
+
+func (instance *Consumer) enqueue(event log.Event, using log.Provider) error {
+	record, err := instance.getFormatter().Format(event, using, nil)
+	if err != nil {
+		return fmt.Errorf("cannot format event %v: %w", event, err)
+	}
+
+	t := time.Now()
+	if v := log.GetTimestampOf(event, using); v != nil {
+		t = *v
+	}
+	var loggerName string
+	if v := log.GetLoggerOf(event, using); v != nil {
+		loggerName = *v
+	}
+
+	e := entry{
+		tag:  instance.tagOf(loggerName),
+		data: encodeEntry(t, record),
+	}
+
+	instance.mutex.RLock()
+	defer instance.mutex.RUnlock()
+	select {
+	case <-instance.closing:
+		return ErrClosed
+	default:
+	}
+	select {
+	case instance.queue <- e:
+		return nil
+	default:
+		return fmt.Errorf("%w: dropped event %v", ErrBufferOverflow, event)
+	}
+}
+
+func (instance *Consumer) tagOf(loggerName string) string {
+	if v := instance.Tagger; v != nil {
+		return v(loggerName)
+	}
+	prefix := instance.getTagPrefix()
+	if loggerName == "" || loggerName == "ROOT" {
+		return prefix
+	}
+	name := strings.Trim(strings.ReplaceAll(loggerName, "/", "."), ".")
+	if prefix == "" {
+		return name
+	}
+	return prefix + "." + name
+}
+
+// Close flushes all pending entries (as long as the server is reachable
+// within Timeout) and closes the connection. It returns an error if not all
+// entries could be flushed.
+func (instance *Consumer) Close() error {
+	instance.once.Do(func() {
+		instance.mutex.Lock()
+		close(instance.closing)
+		instance.mutex.Unlock()
+	})
+	<-instance.closed
+	return instance.closeErr
+}
+
+func (instance *Consumer) run() {
+	defer close(instance.closed)
+
+	ticker := time.NewTicker(instance.getFlushInterval())
+	defer ticker.Stop()
+
+	var pending []entry
+	for {
+		select {
+		case e := <-instance.queue:
+			pending = instance.append(pending, e)
+			if len(pending) >= instance.getBatchSize() {
+				pending = instance.flush(pending)
+			}
+		case <-ticker.C:
+			pending = instance.flush(pending)
+		case <-instance.closing:
+			for more := true; more; {
+				select {
+				case e := <-instance.queue:
+					pending = instance.append(pending, e)
+				default:
+					more = false
+				}
+			}
+			instance.closeErr = instance.flushFinally(pending)
+			instance.disconnect()
+			return
+		}
+	}
+}
+
+func (instance *Consumer) append(pending []entry, e entry) []entry {
+	if len(pending) >= instance.getBufferSize() {
+		instance.onError(fmt.Errorf("%w: dropped oldest entry of tag %s", ErrBufferOverflow, pending[0].tag))
+		pending = pending[1:]
+	}
+	return append(pending, e)
+}
+
+func (instance *Consumer) flushFinally(pending []entry) error {
+	deadline := time.Now().Add(instance.getTimeout())
+	for len(pending) > 0 && time.Now().Before(deadline) {
+		if wait := time.Until(instance.retryAt); wait > 0 {
+			if time.Now().Add(wait).After(deadline) {
+				break
+			}
+			time.Sleep(wait)
+		}
+		pending = instance.flush(pending)
+	}
+	if len(pending) > 0 {
+		return fmt.Errorf("cannot flush %d entries while closing", len(pending))
+	}
+	return nil
+}
+
+// flush sends the given entries and returns all entries which could not be
+// sent.
+func (instance *Consumer) flush(pending []entry) []entry {
+	if instance.conn == nil && time.Now().Before(instance.retryAt) {
+		// Still waiting for the next reconnect...
+		return pending
+	}
+	for len(pending) > 0 {
+		n := len(pending)
+		if batchSize := instance.getBatchSize(); n > batchSize {
+			n = batchSize
+		}
+		rest, err := instance.send(pending[:n])
+		if err != nil {
+			instance.onError(err)
+			return append(rest, pending[n:]...)
+		}
+		pending = pending[n:]
+	}
+	return nil
+}
+
+// send sends the given entries; one message per tag and chunk. It returns the
+// entries which were not sent in case of an error.
+func (instance *Consumer) send(entries []entry) ([]entry, error) {
+	if err := instance.connectIfRequired(); err != nil {
+		return entries, err
+	}
+
+	type messageKey struct {
+		tag   string
+		chunk string
+	}
+	var keys []messageKey
+	byKey := map[messageKey][]entry{}
+	for _, e := range entries {
+		key := messageKey{e.tag, e.chunk}
+		if _, ok := byKey[key]; !ok {
+			keys = append(keys, key)
+		}
+		byKey[key] = append(byKey[key], e)
+	}
+
+	for i, key := range keys {
+		if err := instance.sendMessage(key.tag, byKey[key]); err != nil {
+			instance.disconnect()
+			instance.scheduleRetry()
+			var rest []entry
+			for _, k := range keys[i:] {
+				rest = append(rest, byKey[k]...)
+			}
+			return rest, fmt.Errorf("cannot send %d entries to %s://%s: %w", len(rest), instance.network, instance.address, err)
+		}
+	}
+	instance.backoff = 0
+	return nil, nil
+}
+
+// sendMessage sends the given entries as one message. If an ack is required
+// and the entries were not sent before, a new chunk id is assigned to all of
+// them; otherwise the chunk id of the previous attempt is reused, which
+// enables the server to detect resends of messages it already received.
+func (instance *Consumer) sendMessage(tag string, entries []entry) error {
+	var chunk string
+	if instance.RequireAck {
+		if chunk = entries[0].chunk; chunk == "" {
+			var err error
+			if chunk, err = newChunkId(); err != nil {
+				return err
+			}
+			for i := range entries {
+				entries[i].chunk = chunk
+			}
+		}
+	}
+	message, err := encodePackedForward(tag, entries, chunk)
+	if err != nil {
+		return err
+	}
+
+	if err := instance.conn.SetDeadline(time.Now().Add(instance.getTimeout())); err != nil {
+		return err
+	}
+	if _, err := instance.conn.Write(message); err != nil {
+		return err
+	}
+	if chunk == "" {
+		return nil
+	}
+	ack, err := readAck(instance.reader)
+	if err != nil {
+		return err
+	}
+	if ack != chunk {
+		return fmt.Errorf("%w: expected ack %q but got %q", ErrIllegalResponse, chunk, ack)
+	}
+	return nil
+}
+
+func (instance *Consumer) connectIfRequired() error {
+	if instance.conn != nil {
+		return nil
+	}
+	conn, err := instance.dial(instance.network, instance.address, instance.getTimeout())
+	if err != nil {
+		instance.scheduleRetry()
+		return fmt.Errorf("cannot connect to %s://%s: %w", instance.network, instance.address, err)
+	}
+	instance.conn = conn
+	instance.reader = bufio.NewReader(conn)
+	return nil
+}
+
+func (instance *Consumer) disconnect() {
+	if conn := instance.conn; conn != nil {
+		_ = conn.Close()
+	}
+	instance.conn = nil
+	instance.reader = nil
+}
+
+func (instance *Consumer) scheduleRetry() {
+	if instance.backoff <= 0 {
+		instance.backoff = instance.getMinBackoff()
+	} else if instance.backoff *= 2; instance.backoff > instance.getMaxBackoff() {
+		instance.backoff = instance.getMaxBackoff()
+	}
+	instance.retryAt = time.Now().Add(instance.backoff)
+}
+
+func (instance *Consumer) onError(err error) {
+	if v := instance.OnError; v != nil {
+		v(instance, err)
+	}
+}
+
+func (instance *Consumer) getFormatter() formatter.Formatter {
+	if v := instance.Formatter; v != nil {
+		return v
+	}
+	return formatter.NewMessagePack()
+}
+
+func (instance *Consumer) getInterceptor() interceptor.Interceptor {
+	if v := instance.Interceptor; v != nil {
+		return v
+	}
+	if v := interceptor.Default; v != nil {
+		return v
+	}
+	return interceptor.Noop()
+}
+
+func (instance *Consumer) getTagPrefix() string {
+	if v := instance.TagPrefix; v != "" {
+		return v
+	}
+	return DefaultTagPrefix
+}
+
+func (instance *Consumer) getBatchSize() int {
+	if v := instance.BatchSize; v > 0 {
+		return v
+	}
+	return DefaultBatchSize
+}
+
+func (instance *Consumer) getFlushInterval() time.Duration {
+	if v := instance.FlushInterval; v > 0 {
+		return v
+	}
+	return DefaultFlushInterval
+}
+
+func (instance *Consumer) getBufferSize() int {
+	if v := instance.BufferSize; v > 0 {
+		return v
+	}
+	return DefaultBufferSize
+}
+
+func (instance *Consumer) getTimeout() time.Duration {
+	if v := instance.Timeout; v > 0 {
+		return v
+	}
+	return DefaultTimeout
+}
+
+func (instance *Consumer) getMinBackoff() time.Duration {
+	if v := instance.MinBackoff; v > 0 {
+		return v
+	}
+	return DefaultMinBackoff
+}
+
+func (instance *Consumer) getMaxBackoff() time.Duration {
+	if v := instance.MaxBackoff; v > 0 {
+		return v
+	}
+	return DefaultMaxBackoff
+}
@@ -0,0 +1,488 @@
+package fluentd
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"sync"
+	"testing"
+	"time"
+
+	log "github.com/echocat/slf4g"
+	"github.com/echocat/slf4g/internal/test/assert"
+	"github.com/echocat/slf4g/native"
+	"github.com/echocat/slf4g/native/consumer"
+	"github.com/echocat/slf4g/native/interceptor"
+	"github.com/echocat/slf4g/native/location"
+)
+
+var givenTimestamp = time.Date(2021, 1, 2, 13, 14, 15, 123000000, time.UTC)
+
+func Test_Consumer_Consume(t *testing.T) {
+	server := newTestServer(t, false)
+	instance := NewConsumer("tcp", server.address(), func(v *Consumer) {
+		v.Interceptor = interceptor.Noop()
+	})
+	provider := newProvider(instance)
+
+	provider.GetLogger("github.com/acme/foo").Info("first")
+	provider.GetLogger("github.com/acme/bar").Warn("second")
+	provider.GetLogger("github.com/acme/foo").With("user", "baz").Info("third")
+	assert.ToBeNoError(t, instance.Close())
+
+	actual := server.receivedMessages()
+	assert.ToBeEqual(t, 2, len(actual))
+	assert.ToBeEqual(t, []interface{}{
+		"slf4g.github.com.acme.foo",
+		[]interface{}{
+			[]interface{}{eventTime{givenTimestamp}, map[string]interface{}{"level": "INFO", "logger": "github.com/acme/foo", "message": "first", "timestamp": givenTimestamp}},
+			[]interface{}{eventTime{givenTimestamp}, map[string]interface{}{"level": "INFO", "logger": "github.com/acme/foo", "message": "third", "timestamp": givenTimestamp, "user": "baz"}},
+		},
+		map[string]interface{}{"size": uint64(2)},
+	}, actual[0])
+	assert.ToBeEqual(t, []interface{}{
+		"slf4g.github.com.acme.bar",
+		[]interface{}{
+			[]interface{}{eventTime{givenTimestamp}, map[string]interface{}{"level": "WARN", "logger": "github.com/acme/bar", "message": "second", "timestamp": givenTimestamp}},
+		},
+		map[string]interface{}{"size": uint64(1)},
+	}, actual[1])
+}
+
+func Test_Consumer_Consume_batches(t *testing.T) {
+	server := newTestServer(t, false)
+	instance := NewConsumer("tcp", server.address(), func(v *Consumer) {
+		v.Interceptor = interceptor.Noop()
+		v.BatchSize = 2
+		v.FlushInterval = time.Hour
+	})
+	logger := newProvider(instance).GetLogger("foo")
+
+	for i := 0; i < 5; i++ {
+		logger.Info(i)
+	}
+	server.awaitMessages(2)
+	assert.ToBeEqual(t, 2, len(server.receivedMessages()))
+
+	assert.ToBeNoError(t, instance.Close())
+	actual := server.receivedMessages()
+	assert.ToBeEqual(t, 3, len(actual))
+	assert.ToBeEqual(t, map[string]interface{}{"size": uint64(1)}, actual[2].([]interface{})[2])
+}
+
+func Test_Consumer_Consume_withAck(t *testing.T) {
+	server := newTestServer(t, true)
+	instance := NewConsumer("unix", server.address(), func(v *Consumer) {
+		v.Interceptor = interceptor.Noop()
+		v.RequireAck = true
+	})
+
+	newProvider(instance).GetRootLogger().Info("hello")
+	assert.ToBeNoError(t, instance.Close())
+
+	actual := server.receivedMessages()
+	assert.ToBeEqual(t, 1, len(actual))
+	assert.ToBeEqual(t, "slf4g", actual[0].([]interface{})[0])
+	actualOption := actual[0].([]interface{})[2].(map[string]interface{})
+	assert.ToBeEqual(t, uint64(1), actualOption["size"])
+	assert.ToBeMatching(t, "^[A-Za-z0-9+/]{22}==$", actualOption["chunk"])
+}
+
+func Test_Consumer_Consume_withAckTimeout(t *testing.T) {
+	server := newTestServer(t, true)
+	server.skipAcks = 1
+	var actualErrs errorRecorder
+	instance := NewConsumer("unix", server.address(), func(v *Consumer) {
+		v.Interceptor = interceptor.Noop()
+		v.RequireAck = true
+		v.FlushInterval = 5 * time.Millisecond
+		v.Timeout = 200 * time.Millisecond
+		v.MinBackoff = time.Millisecond
+		v.OnError = actualErrs.record
+	})
+
+	newProvider(instance).GetRootLogger().Info("hello")
+	server.awaitMessages(2)
+	assert.ToBeNoError(t, instance.Close())
+
+	actual := server.receivedMessages()
+	assert.ToBeEqual(t, 2, len(actual))
+	assert.ToBeEqual(t, actual[0], actual[1])
+	actualOption := actual[0].([]interface{})[2].(map[string]interface{})
+	assert.ToBeMatching(t, "^[A-Za-z0-9+/]{22}==$", actualOption["chunk"])
+	assert.ToBeEqual(t, 1, actualErrs.len())
+	assert.ToBeMatching(t, "timeout", actualErrs.get(0))
+}
+
+func Test_Consumer_Consume_withWrongAck(t *testing.T) {
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	assert.ToBeNoError(t, err)
+	defer func() { _ = listener.Close() }()
+	go func() {
+		for {
+			conn, err := listener.Accept()
+			if err != nil {
+				return
+			}
+			go func() {
+				defer func() { _ = conn.Close() }()
+				_, _ = decode(bufio.NewReader(conn))
+				_, _ = conn.Write([]byte{0x81, 0xa3, 'a', 'c', 'k', 0xa1, 'x'})
+			}()
+		}
+	}()
+
+	var actualErrs errorRecorder
+	instance := NewConsumer("tcp", listener.Addr().String(), func(v *Consumer) {
+		v.Interceptor = interceptor.Noop()
+		v.RequireAck = true
+		v.Timeout = 200 * time.Millisecond
+		v.MinBackoff = time.Hour
+		v.OnError = actualErrs.record
+	})
+
+	newProvider(instance).GetRootLogger().Info("hello")
+
+	assert.ToBeMatching(t, "cannot flush 1 entries while closing", instance.Close())
+	assert.ToBeEqual(t, true, errors.Is(actualErrs.get(0), ErrIllegalResponse))
+}
+
+func Test_Consumer_Consume_reconnects(t *testing.T) {
+	server := newTestServer(t, false)
+	var dials int
+	var actualErrs errorRecorder
+	instance := NewConsumer("tcp", server.address(), func(v *Consumer) {
+		v.Interceptor = interceptor.Noop()
+		v.FlushInterval = 5 * time.Millisecond
+		v.MinBackoff = time.Millisecond
+		v.OnError = actualErrs.record
+	})
+	instance.dial = func(network, address string, timeout time.Duration) (net.Conn, error) {
+		if dials++; dials <= 2 {
+			return nil, errors.New("expected")
+		}
+		return net.DialTimeout(network, address, timeout)
+	}
+
+	newProvider(instance).GetRootLogger().Info("hello")
+	server.awaitMessages(1)
+	assert.ToBeNoError(t, instance.Close())
+
+	assert.ToBeEqual(t, 1, len(server.receivedMessages()))
+	assert.ToBeEqual(t, 3, dials)
+	assert.ToBeEqual(t, 2, actualErrs.len())
+	assert.ToBeMatching(t, "cannot connect to tcp://.+: expected", actualErrs.get(0))
+}
+
+func Test_Consumer_Consume_overflow(t *testing.T) {
+	var actualErrs errorRecorder
+	instance := NewConsumer("tcp", "127.0.0.1:1", func(v *Consumer) {
+		v.Interceptor = interceptor.Noop()
+		v.BufferSize = 1
+		v.FlushInterval = time.Hour
+		v.Timeout = 10 * time.Millisecond
+		v.OnError = actualErrs.record
+	})
+	instance.dial = func(string, string, time.Duration) (net.Conn, error) {
+		return nil, errors.New("expected")
+	}
+	logger := newProvider(instance).GetRootLogger()
+
+	for i := 0; i < 10; i++ {
+		logger.Info(i)
+	}
+	assert.ToBeMatching(t, "cannot flush 1 entries while closing", instance.Close())
+
+	var overflows int
+	for i := 0; i < actualErrs.len(); i++ {
+		if errors.Is(actualErrs.get(i), ErrBufferOverflow) {
+			overflows++
+		}
+	}
+	assert.ToBeEqual(t, true, overflows > 0)
+
+	logger.Info("after close")
+	assert.ToBeEqual(t, true, errors.Is(actualErrs.get(actualErrs.len()-1), ErrClosed))
+}
+
+func Test_Consumer_tagOf(t *testing.T) {
+	cases := []struct {
+		givenPrefix string
+		given       string
+		expected    string
+	}{
+		{"", "github.com/acme/foo", "slf4g.github.com.acme.foo"},
+		{"", "ROOT", "slf4g"},
+		{"", "", "slf4g"},
+		{"app", "foo.bar", "app.foo.bar"},
+		{"app", "/foo/", "app.foo"},
+	}
+
+	for _, c := range cases {
+		t.Run(c.given, func(t *testing.T) {
+			instance := &Consumer{TagPrefix: c.givenPrefix}
+
+			actual := instance.tagOf(c.given)
+
+			assert.ToBeEqual(t, c.expected, actual)
+		})
+	}
+}
+
+func Test_Consumer_tagOf_withTagger(t *testing.T) {
+	instance := &Consumer{Tagger: func(loggerName string) string {
+		return "<" + loggerName + ">"
+	}}
+
+	actual := instance.tagOf("foo")
+
+	assert.ToBeEqual(t, "<foo>", actual)
+}
+
+func newProvider(c consumer.Consumer) *native.Provider {
+	return &native.Provider{
+		LocationDiscovery: location.NoopDiscovery(),
+		CoreLoggerCustomizer: func(_ *native.Provider, cl *native.CoreLogger) log.CoreLogger {
+			cl.Consumer = consumer.Func(func(event log.Event, source log.CoreLogger) {
+				c.Consume(event.With("timestamp", givenTimestamp), source)
+			})
+			return cl
+		},
+	}
+}
+
+type testServer struct {
+	t        *testing.T
+	listener net.Listener
+	ack      bool
+	skipAcks int
+	messages []interface{}
+	mutex    sync.Mutex
+	cond     *sync.Cond
+}
+
+func newTestServer(t *testing.T, ack bool) *testServer {
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	if ack {
+		listener, err = net.Listen("unix", t.TempDir()+"/fluentd.sock")
+	}
+	assert.ToBeNoError(t, err)
+	result := &testServer{t: t, listener: listener, ack: ack}
+	result.cond = sync.NewCond(&result.mutex)
+	t.Cleanup(func() { _ = listener.Close() })
+	go result.serve()
+	return result
+}
+
+func (instance *testServer) address() string {
+	return instance.listener.Addr().String()
+}
+
+func (instance *testServer) serve() {
+	for {
+		conn, err := instance.listener.Accept()
+		if err != nil {
+			return
+		}
+		go instance.handle(conn)
+	}
+}
+
+func (instance *testServer) handle(conn net.Conn) {
+	defer func() { _ = conn.Close() }()
+	reader := bufio.NewReader(conn)
+	for {
+		message, err := decode(reader)
+		if err == io.EOF {
+			return
+		}
+		if err != nil {
+			instance.t.Errorf("cannot decode message: %v", err)
+			return
+		}
+		decoded := message.([]interface{})
+		entries, err := decodeAll(decoded[1].([]byte))
+		if err != nil {
+			instance.t.Errorf("cannot decode entries: %v", err)
+			return
+		}
+		decoded[1] = entries
+
+		instance.mutex.Lock()
+		skipAck := instance.skipAcks > 0
+		if skipAck {
+			instance.skipAcks--
+		}
+		instance.mutex.Unlock()
+
+		if chunk, ok := decoded[2].(map[string]interface{})["chunk"].(string); ok && instance.ack && !skipAck {
+			ack := append([]byte{0x81, 0xa3, 'a', 'c', 'k', 0xa0 | byte(len(chunk))}, chunk...)
+			if _, err := conn.Write(ack); err != nil {
+				instance.t.Errorf("cannot write ack: %v", err)
+				return
+			}
+		}
+
+		instance.mutex.Lock()
+		instance.messages = append(instance.messages, decoded)
+		instance.cond.Broadcast()
+		instance.mutex.Unlock()
+	}
+}
+
+func (instance *testServer) awaitMessages(n int) {
+	instance.mutex.Lock()
+	defer instance.mutex.Unlock()
+	for len(instance.messages) < n {
+		instance.cond.Wait()
+	}
+}
+
+func (instance *testServer) receivedMessages() []interface{} {
+	// Give the server the chance to process everything which was sent...
+	time.Sleep(50 * time.Millisecond)
+	instance.mutex.Lock()
+	defer instance.mutex.Unlock()
+	return append([]interface{}{}, instance.messages...)
+}
+
+type errorRecorder struct {
+	errs  []error
+	mutex sync.Mutex
+}
+
+func (instance *errorRecorder) record(_ *Consumer, err error) {
+	instance.mutex.Lock()
+	defer instance.mutex.Unlock()
+	instance.errs = append(instance.errs, err)
+}
+
+func (instance *errorRecorder) len() int {
+	instance.mutex.Lock()
+	defer instance.mutex.Unlock()
+	return len(instance.errs)
+}
+
+func (instance *errorRecorder) get(i int) error {
+	instance.mutex.Lock()
+	defer instance.mutex.Unlock()
+	return instance.errs[i]
+}
+
+type eventTime struct {
+	time.Time
+}
+
+func decodeAll(b []byte) ([]interface{}, error) {
+	reader := bufio.NewReader(bytes.NewReader(b))
+	var result []interface{}
+	for {
+		v, err := decode(reader)
+		if err == io.EOF {
+			return result, nil
+		}
+		if err != nil {
+			return nil, err
+		}
+		result = append(result, v)
+	}
+}
+
+// decode is a minimal MessagePack decoder which supports everything the
+// consumer and the formatter.MessagePack produces in these tests.
+func decode(from *bufio.Reader) (interface{}, error) {
+	b, err := from.ReadByte()
+	if err != nil {
+		return nil, err
+	}
+	readN := func(n int) ([]byte, error) {
+		result := make([]byte, n)
+		_, err := io.ReadFull(from, result)
+		return result, err
+	}
+	readLength := func(n int) (int, error) {
+		bs, err := readN(n)
+		if err != nil {
+			return 0, err
+		}
+		var result int
+		for _, c := range bs {
+			result = result<<8 | int(c)
+		}
+		return result, nil
+	}
+	collection := func(n int, asMap bool) (interface{}, error) {
+		if asMap {
+			result := map[string]interface{}{}
+			for i := 0; i < n; i++ {
+				k, err := decode(from)
+				if err != nil {
+					return nil, err
+				}
+				v, err := decode(from)
+				if err != nil {
+					return nil, err
+				}
+				result[k.(string)] = v
+			}
+			return result, nil
+		}
+		result := []interface{}{}
+		for i := 0; i < n; i++ {
+			v, err := decode(from)
+			if err != nil {
+				return nil, err
+			}
+			result = append(result, v)
+		}
+		return result, nil
+	}
+
+	switch {
+	case b <= 0x7f:
+		return uint64(b), nil
+	case b&0xf0 == 0x80:
+		return collection(int(b&0x0f), true)
+	case b&0xf0 == 0x90:
+		return collection(int(b&0x0f), false)
+	case b&0xe0 == 0xa0:
+		bs, err := readN(int(b & 0x1f))
+		return string(bs), err
+	case b == 0xc0:
+		return nil, nil
+	case b == 0xc4, b == 0xc5, b == 0xc6, b == 0xd9:
+		n, err := readLength(map[byte]int{0xc4: 1, 0xc5: 2, 0xc6: 4, 0xd9: 1}[b])
+		if err != nil {
+			return nil, err
+		}
+		bs, err := readN(n)
+		if b == 0xd9 {
+			return string(bs), err
+		}
+		return bs, err
+	case b == 0xcc, b == 0xcd, b == 0xce:
+		n, err := readLength(map[byte]int{0xcc: 1, 0xcd: 2, 0xce: 4}[b])
+		return uint64(n), err
+	case b == 0xd6 || b == 0xd7:
+		bs, err := readN(map[byte]int{0xd6: 5, 0xd7: 9}[b])
+		if err != nil {
+			return nil, err
+		}
+		switch {
+		case bs[0] == 0x00 && len(bs) == 9:
+			return eventTime{time.Unix(int64(binary.BigEndian.Uint32(bs[1:5])), int64(binary.BigEndian.Uint32(bs[5:9]))).UTC()}, nil
+		case bs[0] == 0xff && len(bs) == 5:
+			return time.Unix(int64(binary.BigEndian.Uint32(bs[1:5])), 0).UTC(), nil
+		case bs[0] == 0xff:
+			v := binary.BigEndian.Uint64(bs[1:9])
+			return time.Unix(int64(v&(1<<34-1)), int64(v>>34)).UTC(), nil
+		}
+		return nil, fmt.Errorf("unsupported extension type %d", int8(bs[0]))
+	default:
+		return nil, fmt.Errorf("unsupported type 0x%02x", b)
+	}
+}
@@ -0,0 +1,5 @@
+// Package fluentd provides a consumer.Consumer which sends log events to
+// Fluentd or Fluent Bit using the Forward Protocol v1
+// (https://github.com/fluent/fluentd/wiki/Forward-Protocol-Specification-v1)
+// over TCP or unix sockets.
+package fluentd
@@ -0,0 +1,166 @@
+package fluentd
+
+import (
+	"bufio"
+	"crypto/rand"
+	"encoding/base64"
+	"encoding/binary"
+	"fmt"
+	"io"
+	"time"
+
+	"github.com/echocat/slf4g/native/formatter/encoding"
+)
+
+// entry is one already encoded [time, record] entry of the given tag. chunk
+// is the id of the message the entry was sent with the first time (if an ack
+// was required); it is reused if the entry needs to be sent again.
+type entry struct {
+	tag   string
+	data  []byte
+	chunk string
+}
+
+// encodeEntry encodes the given time and the already encoded record as
+// [time, record]. The time is encoded as EventTime (extension type 0).
+func encodeEntry(t time.Time, record []byte) []byte {
+	to := encoding.NewBufferedMessagePackEncoder()
+	_ = to.WriteArrayHeader(2)
+	var eventTime [10]byte
+	eventTime[0], eventTime[1] = 0xd7, 0x00 // fixext8, type 0
+	binary.BigEndian.PutUint32(eventTime[2:6], uint32(t.Unix()))
+	binary.BigEndian.PutUint32(eventTime[6:10], uint32(t.Nanosecond()))
+	_ = to.WriteRaw(eventTime[:])
+	_ = to.WriteRaw(record)
+	return to.Bytes()
+}
+
+// encodePackedForward encodes the given entries of the same tag as
+// PackedForward message [tag, entries, option]. If chunk is not empty it will
+// be part of the option, which requests an ack from the server.
+func encodePackedForward(tag string, entries []entry, chunk string) ([]byte, error) {
+	var size int
+	for _, e := range entries {
+		size += len(e.data)
+	}
+	packed := make([]byte, 0, size)
+	for _, e := range entries {
+		packed = append(packed, e.data...)
+	}
+
+	to := encoding.NewBufferedMessagePackEncoder()
+	optionSize := 1
+	if chunk != "" {
+		optionSize++
+	}
+	if err := to.WriteArrayHeader(3); err != nil {
+		return nil, err
+	}
+	if err := to.WriteValue(tag); err != nil {
+		return nil, err
+	}
+	if err := to.WriteValue(packed); err != nil {
+		return nil, err
+	}
+	if err := to.WriteMapHeader(optionSize); err != nil {
+		return nil, err
+	}
+	if err := to.WriteKeyValue("size", len(entries)); err != nil {
+		return nil, err
+	}
+	if chunk != "" {
+		if err := to.WriteKeyValue("chunk", chunk); err != nil {
+			return nil, err
+		}
+	}
+	return to.Bytes(), nil
+}
+
+func newChunkId() (string, error) {
+	var b [16]byte
+	if _, err := rand.Read(b[:]); err != nil {
+		return "", err
+	}
+	return base64.StdEncoding.EncodeToString(b[:]), nil
+}
+
+// readAck reads the response {"ack": <chunk>} of the server and returns the
+// value of ack.
+func readAck(from *bufio.Reader) (string, error) {
+	length, err := readMapHeader(from)
+	if err != nil {
+		return "", err
+	}
+	var ack string
+	for i := 0; i < length; i++ {
+		k, err := readString(from)
+		if err != nil {
+			return "", err
+		}
+		v, err := readString(from)
+		if err != nil {
+			return "", err
+		}
+		if k == "ack" {
+			ack = v
+		}
+	}
+	return ack, nil
+}
+
+func readMapHeader(from *bufio.Reader) (int, error) {
+	b, err := from.ReadByte()
+	if err != nil {
+		return 0, err
+	}
+	switch {
+	case b&0xf0 == 0x80:
+		return int(b & 0x0f), nil
+	case b == 0xde:
+		return readLength(from, 2)
+	case b == 0xdf:
+		return readLength(from, 4)
+	default:
+		return 0, fmt.Errorf("%w: expected map but got 0x%02x", ErrIllegalResponse, b)
+	}
+}
+
+func readString(from *bufio.Reader) (string, error) {
+	b, err := from.ReadByte()
+	if err != nil {
+		return "", err
+	}
+	var length int
+	switch {
+	case b&0xe0 == 0xa0:
+		length = int(b & 0x1f)
+	case b == 0xd9, b == 0xc4:
+		length, err = readLength(from, 1)
+	case b == 0xda, b == 0xc5:
+		length, err = readLength(from, 2)
+	case b == 0xdb, b == 0xc6:
+		length, err = readLength(from, 4)
+	default:
+		return "", fmt.Errorf("%w: expected string but got 0x%02x", ErrIllegalResponse, b)
+	}
+	if err != nil {
+		return "", err
+	}
+	result := make([]byte, length)
+	if _, err := io.ReadFull(from, result); err != nil {
+		return "", err
+	}
+	return string(result), nil
+}
+
+func readLength(from *bufio.Reader, size int) (int, error) {
+	b := make([]byte, size)
+	if _, err := io.ReadFull(from, b); err != nil {
+		return 0, err
+	}
+	var result uint64
+	for _, c := range b {
+		result = result<<8 | uint64(c)
+	}
+	return int(result), nil
+}
@@ -0,0 +1,625 @@
+package httpbatch
+
+import (
+	"bytes"
+	"compress/gzip"
+	"errors"
+	"fmt"
+	"io"
+	"math/rand"
+	"net/http"
+	"os"
+	"strconv"
+	"sync"
+	"time"
+
+	log "github.com/echocat/slf4g"
+	"github.com/echocat/slf4g/fields"
+	"github.com/echocat/slf4g/level"
+	"github.com/echocat/slf4g/native/formatter"
+	"github.com/echocat/slf4g/native/interceptor"
+	nlevel "github.com/echocat/slf4g/native/level"
+)
+
+var (
+	// DefaultBatchSize is the default maximum amount of entries sent within
+	// one request. See Consumer.BatchSize for more details.
+	DefaultBatchSize = 500
+
+	// DefaultBatchBytes is the default maximum size of the formatted entries
+	// sent within one request. See Consumer.BatchBytes for more details.
+	DefaultBatchBytes = 1024 * 1024
+
+	// DefaultFlushInterval is the default interval in which pending entries
+	// are sent. See Consumer.FlushInterval for more details.
+	DefaultFlushInterval = time.Second
+
+	// DefaultBufferSize is the default maximum amount of entries which are
+	// buffered. See Consumer.BufferSize for more details.
+	DefaultBufferSize = 10000
+
+	// DefaultMaxRetries is the default amount of retries of a failed request.
+	// See Consumer.MaxRetries for more details.
+	DefaultMaxRetries = 5
+
+	// DefaultMinBackoff is the default time to wait before the first retry.
+	// See Consumer.MinBackoff for more details.
+	DefaultMinBackoff = 100 * time.Millisecond
+
+	// DefaultMaxBackoff is the default maximum time to wait between retries.
+	// See Consumer.MaxBackoff for more details.
+	DefaultMaxBackoff = 30 * time.Second
+
+	// DefaultSpillMaxBytes is the default maximum size of the spill
+	// directory. See Consumer.SpillMaxBytes for more details.
+	DefaultSpillMaxBytes = int64(100 * 1024 * 1024)
+
+	// DefaultTimeout is the default timeout of each request if no explicit
+	// Consumer.Client was provided.
+	DefaultTimeout = 30 * time.Second
+)
+
+var (
+	// ErrBufferOverflow is reported (see Consumer.OnError) if events were
+	// dropped because the buffer is full.
+	ErrBufferOverflow = errors.New("buffer overflow")
+
+	// ErrUnexpectedStatus is reported (see Consumer.OnError) if the server
+	// responded with an unexpected status code.
+	ErrUnexpectedStatus = errors.New("unexpected status")
+
+	// ErrRejected is reported (see Consumer.OnError) if the server responded
+	// successfully but reported that entries were rejected (see
+	// ResponseChecker).
+	ErrRejected = errors.New("entries rejected")
+
+	// ErrClosed is reported (see Consumer.OnError) if events are consumed
+	// after the Consumer was closed.
+	ErrClosed = errors.New("consumer is closed")
+)
+
+// Consumer is an implementation of consumer.Consumer which ships all consumed
+// events in batches via HTTP to the configured URL. The body of each request
+// is created by the configured Encoder (see Loki, Elasticsearch and Splunk).
+//
+// Events are formatted while they are consumed, buffered and sent in the
+// background as soon as either BatchSize or BatchBytes is reached or after
+// FlushInterval. Failed requests (network errors, status 429 and 5xx) are
+// retried with an exponential backoff and jitter (see MaxRetries, MinBackoff
+// and MaxBackoff). If a request still fails it is stored in SpillDirectory
+// (if configured) and sent again as soon as the server is reachable again.
+// Stored requests are always sent before newer ones; as long as they could not
+// be sent newer requests are stored as well, so the order is preserved.
+// Entries which were rejected although the response was successful (see
+// ResponseChecker) are reported as ErrRejected.
+//
+// NewConsumer() is used to create a new instance. Close() has to be called to
+// flush pending entries.
+type Consumer struct {
+	// Client is used to send the requests. If nothing was provided a client
+	// with DefaultTimeout will be used.
+	Client *http.Client
+
+	// Header contains additional headers of each request (for example for
+	// authentication).
+	Header http.Header
+
+	// Formatter is used to format each consumed event (see Entry.Formatted).
+	// If nothing was provided a formatter.Json with sorted keys will be used.
+	Formatter formatter.Formatter
+
+	// Interceptor can be used to intercept the consumption of an event shortly
+	// before the actual consumption or directly afterward. If nothing was
+	// provided interceptor.Default will be used.
+	Interceptor interceptor.Interceptor
+
+	// BatchSize is the maximum amount of entries sent within one request. If
+	// not set DefaultBatchSize will be used.
+	BatchSize int
+
+	// BatchBytes is the maximum size of all formatted entries sent within one
+	// request. If not set DefaultBatchBytes will be used.
+	BatchBytes int
+
+	// FlushInterval is the interval in which pending entries are sent. If not
+	// set DefaultFlushInterval will be used.
+	FlushInterval time.Duration
+
+	// BufferSize is the maximum amount of entries which are buffered. If
+	// exceeded new entries are dropped and ErrBufferOverflow is reported. If
+	// not set DefaultBufferSize will be used.
+	BufferSize int
+
+	// Gzip will (if set to true) compress the body of each request.
+	Gzip bool
+
+	// MaxRetries is the amount of retries of a failed request. If not set
+	// DefaultMaxRetries will be used.
+	MaxRetries *int
+
+	// MinBackoff is the time to wait before the first retry; it is doubled
+	// with every retry and a random jitter is applied. A Retry-After header of
+	// the server is respected. If not set DefaultMinBackoff will be used.
+	MinBackoff time.Duration
+
+	// MaxBackoff is the maximum time to wait between retries. If not set
+	// DefaultMaxBackoff will be used.
+	MaxBackoff time.Duration
+
+	// SpillDirectory is the directory where requests are stored which still
+	// failed after all retries. They are sent again as soon as the server is
+	// reachable again; also after a restart of the application. If not set
+	// such requests are dropped.
+	SpillDirectory string
+
+	// SpillMaxBytes is the maximum size of all stored requests in
+	// SpillDirectory. If exceeded the oldest ones are dropped. If not set
+	// DefaultSpillMaxBytes will be used.
+	SpillMaxBytes int64
+
+	// OnError will be called if events could not be sent or were dropped. It
+	// will be called from the background goroutine. If nothing was provided
+	// these errors will be silently swallowed.
+	OnError func(*Consumer, error)
+
+	url     string
+	encoder Encoder
+	spill   *spill
+
+	queue    chan Entry
+	closing  chan struct{}
+	closed   chan struct{}
+	closeErr error
+	once     sync.Once
+	mutex    sync.RWMutex
+}
+
+// NewConsumer creates a new instance of Consumer which sends the events to the
+// given url, encoded by the given Encoder and is ready to use.
+func NewConsumer(url string, encoder Encoder, customizer ...func(*Consumer)) *Consumer {
+	result := &Consumer{
+		url:     url,
+		encoder: encoder,
+		closing: make(chan struct{}),
+		closed:  make(chan struct{}),
+	}
+	for _, c := range customizer {
+		c(result)
+	}
+	if v := result.SpillDirectory; v != "" {
+		result.spill = &spill{
+			directory: v,
+			maxBytes:  result.getSpillMaxBytes(),
+		}
+	}
+	result.queue = make(chan Entry, result.getBufferSize())
+	go result.run()
+	return result
+}
+
+// Consume implements consumer.Consumer#Consume()
+func (instance *Consumer) Consume(event log.Event, source log.CoreLogger) {
+	if event == nil {
+		return
+	}
+
+	if event = instance.getInterceptor().OnBeforeLog(event, source.GetProvider()); event == nil {
+		return
+	}
+
+	if !source.IsLevelEnabled(event.GetLevel()) {
+		return
+	}
+
+	if err := instance.enqueue(event, source.GetProvider()); err != nil {
+		instance.onError(err)
+	}
+
+	_ = instance.getInterceptor().OnAfterLog(event, source.GetProvider())
+}
+
+func (instance *Consumer) enqueue(event log.Event, using log.Provider) error {
+	formatted, err := instance.getFormatter().Format(event, using, nil)
+	if err != nil {
+		return fmt.Errorf("cannot format event %v: %w", event, err)
+	}
+
+	e := Entry{
+		Timestamp: time.Now(),
+		Level:     event.GetLevel(),
+		LevelName: levelNameOf(event.GetLevel(), using),
+		Formatted: bytes.TrimRight(formatted, "\r\n"),
+	}
+	if v := log.GetTimestampOf(event, using); v != nil {
+		e.Timestamp = *v
+	}
+	if v := log.GetLoggerOf(event, using); v != nil {
+		e.Logger = *v
+	}
+
+	instance.mutex.RLock()
+	defer instance.mutex.RUnlock()
+	select {
+	case <-instance.closing:
+		return ErrClosed
+	default:
+	}
+	select {
+	case instance.queue <- e:
+		return nil
+	default:
+		return fmt.Errorf("%w: dropped event %v", ErrBufferOverflow, event)
+	}
+}
+
+func levelNameOf(l level.Level, using log.Provider) string {
+	names := nlevel.DefaultNames
+	if v, ok := using.(level.NamesAware); ok {
+		names = v.GetLevelNames()
+	}
+	if names != nil {
+		if v, err := names.ToName(l); err == nil {
+			return v
+		}
+	}
+	return strconv.Itoa(int(l))
+}
+
+// Close flushes all pending entries and waits until they are sent. Entries
+// which could not be sent are stored in SpillDirectory (if configured). It
+// returns an error if entries were dropped while closing.
+func (instance *Consumer) Close() error {
+	instance.once.Do(func() {
+		instance.mutex.Lock()
+		close(instance.closing)
+		instance.mutex.Unlock()
+	})
+	<-instance.closed
+	return instance.closeErr
+}
+
+func (instance *Consumer) run() {
+	defer close(instance.closed)
+
+	ticker := time.NewTicker(instance.getFlushInterval())
+	defer ticker.Stop()
+
+	var pending []Entry
+	var pendingBytes int
+	for {
+		select {
+		case e := <-instance.queue:
+			pending = append(pending, e)
+			pendingBytes += len(e.Formatted)
+			if len(pending) >= instance.getBatchSize() || pendingBytes >= instance.getBatchBytes() {
+				instance.flush(pending)
+				pending, pendingBytes = nil, 0
+			}
+		case <-ticker.C:
+			if len(pending) > 0 {
+				instance.flush(pending)
+				pending, pendingBytes = nil, 0
+			} else {
+				instance.replay()
+			}
+		case <-instance.closing:
+			for more := true; more; {
+				select {
+				case e := <-instance.queue:
+					pending = append(pending, e)
+				default:
+					more = false
+				}
+			}
+			instance.closeErr = instance.flush(pending)
+			return
+		}
+	}
+}
+
+// flush sends the given entries in batches of BatchSize and BatchBytes.
+func (instance *Consumer) flush(entries []Entry) (err error) {
+	batchSize, batchBytes := instance.getBatchSize(), instance.getBatchBytes()
+	for len(entries) > 0 {
+		n, size := 0, 0
+		for n < len(entries) && n < batchSize && (n == 0 || size+len(entries[n].Formatted) <= batchBytes) {
+			size += len(entries[n].Formatted)
+			n++
+		}
+		if bErr := instance.sendBatch(entries[:n]); bErr != nil {
+			err = bErr
+		}
+		entries = entries[n:]
+	}
+	return err
+}
+
+func (instance *Consumer) sendBatch(entries []Entry) error {
+	body, err := instance.encoder.Encode(entries)
+	if err != nil {
+		err = fmt.Errorf("cannot encode %d entries: %w", len(entries), err)
+		instance.onError(err)
+		return err
+	}
+
+	// Spilled requests are older than this batch; so they have to be sent
+	// first. Otherwise, endpoints like Loki reject them as out of order.
+	if !instance.replay() {
+		return instance.store(body, len(entries))
+	}
+
+	if err := instance.sendWithRetries(body); err != nil {
+		return instance.onSendFailed(body, len(entries), err)
+	}
+	return nil
+}
+
+func (instance *Consumer) onSendFailed(body []byte, entries int, err error) error {
+	var permanent permanentError
+	if instance.spill == nil || errors.As(err, &permanent) {
+		err = fmt.Errorf("dropped %d entries: %w", entries, err)
+		instance.onError(err)
+		return err
+	}
+	instance.onError(fmt.Errorf("spilled %d entries: %w", entries, err))
+	return instance.store(body, entries)
+}
+
+// store stores the given request in SpillDirectory.
+func (instance *Consumer) store(body []byte, entries int) error {
+	dropped, sErr := instance.spill.store(body)
+	for _, file := range dropped {
+		instance.onError(fmt.Errorf("%w: dropped spilled request %s", ErrBufferOverflow, file))
+	}
+	if sErr != nil {
+		sErr = fmt.Errorf("dropped %d entries: cannot spill: %w", entries, sErr)
+		instance.onError(sErr)
+		return sErr
+	}
+	return nil
+}
+
+// replay sends all requests stored in SpillDirectory (the oldest first) until
+// the first one fails. It returns true if no spilled request remains.
+func (instance *Consumer) replay() bool {
+	if instance.spill == nil {
+		return true
+	}
+	files, err := instance.spill.files()
+	if err != nil {
+		instance.onError(fmt.Errorf("cannot read spilled requests: %w", err))
+		return false
+	}
+	for _, file := range files {
+		body, err := os.ReadFile(file)
+		if err != nil {
+			instance.onError(fmt.Errorf("cannot read spilled request %s: %w", file, err))
+			return false
+		}
+		if err := instance.send(body); err != nil {
+			var permanent permanentError
+			if !errors.As(err, &permanent) {
+				return false
+			}
+			instance.onError(fmt.Errorf("dropped spilled request %s: %w", file, err))
+		}
+		if err := os.Remove(file); err != nil {
+			instance.onError(fmt.Errorf("cannot remove spilled request %s: %w", file, err))
+			return false
+		}
+	}
+	return true
+}
+
+func (instance *Consumer) sendWithRetries(body []byte) error {
+	maxRetries := instance.getMaxRetries()
+	backoff := instance.getMinBackoff()
+	for attempt := 0; ; attempt++ {
+		err := instance.send(body)
+		if err == nil {
+			return nil
+		}
+		var permanent permanentError
+		if errors.As(err, &permanent) || attempt >= maxRetries {
+			return err
+		}
+
+		wait := backoff/2 + time.Duration(rand.Int63n(int64(backoff/2)+1))
+		var retryAfter retryAfterError
+		if errors.As(err, &retryAfter) && retryAfter.after > wait {
+			wait = retryAfter.after
+		}
+		if max := instance.getMaxBackoff(); wait > max {
+			wait = max
+		}
+		select {
+		case <-time.After(wait):
+		case <-instance.closing:
+			// We do not wait while closing...
+			return err
+		}
+		if backoff *= 2; backoff > instance.getMaxBackoff() {
+			backoff = instance.getMaxBackoff()
+		}
+	}
+}
+
+func (instance *Consumer) send(body []byte) error {
+	var reader io.Reader = bytes.NewReader(body)
+	if instance.Gzip {
+		var buf bytes.Buffer
+		w := gzip.NewWriter(&buf)
+		if _, err := w.Write(body); err != nil {
+			return permanentError{err}
+		}
+		if err := w.Close(); err != nil {
+			return permanentError{err}
+		}
+		reader = &buf
+	}
+
+	req, err := http.NewRequest(http.MethodPost, instance.url, reader)
+	if err != nil {
+		return permanentError{err}
+	}
+	for k, vs := range instance.Header {
+		req.Header[k] = vs
+	}
+	req.Header.Set("Content-Type", instance.encoder.ContentType())
+	if instance.Gzip {
+		req.Header.Set("Content-Encoding", "gzip")
+	}
+
+	resp, err := instance.getClient().Do(req)
+	if err != nil {
+		return err
+	}
+	defer func() { _ = resp.Body.Close() }()
+	checker, checked := instance.encoder.(ResponseChecker)
+	limit := int64(maxErrorResponseBytes)
+	if checked {
+		limit = maxCheckedResponseBytes
+	}
+	respBody, _ := io.ReadAll(io.LimitReader(resp.Body, limit))
+
+	if resp.StatusCode >= 200 && resp.StatusCode < 300 {
+		if checked {
+			if err := checker.CheckResponse(resp.StatusCode, respBody); err != nil {
+				return permanentError{fmt.Errorf("%w: %v", ErrRejected, err)}
+			}
+		}
+		return nil
+	}
+	if len(respBody) > maxErrorResponseBytes {
+		respBody = respBody[:maxErrorResponseBytes]
+	}
+	err = fmt.Errorf("%w: %s (%s)", ErrUnexpectedStatus, resp.Status, bytes.TrimSpace(respBody))
+	if resp.StatusCode == http.StatusTooManyRequests || resp.StatusCode >= 500 {
+		if seconds, pErr := strconv.Atoi(resp.Header.Get("Retry-After")); pErr == nil && seconds > 0 {
+			return retryAfterError{err, time.Duration(seconds) * time.Second}
+		}
+		return err
+	}
+	return permanentError{err}
+}
+
+const (
+	// maxErrorResponseBytes is the maximum amount of bytes of a failed
+	// response which are included in the reported error.
+	maxErrorResponseBytes = 1024
+
+	// maxCheckedResponseBytes is the maximum amount of bytes of a response
+	// which are handed over to ResponseChecker.
+	maxCheckedResponseBytes = 16 * 1024 * 1024
+)
+
+// permanentError marks errors which will not go away by retrying.
+type permanentError struct {
+	error
+}
+
+func (instance permanentError) Unwrap() error {
+	return instance.error
+}
+
+// retryAfterError marks errors where the server told us how long to wait.
+type retryAfterError struct {
+	error
+	after time.Duration
+}
+
+func (instance retryAfterError) Unwrap() error {
+	return instance.error
+}
+
+func (instance *Consumer) onError(err error) {
+	if v := instance.OnError; v != nil {
+		v(instance, err)
+	}
+}
+
+var defaultClient = &http.Client{Timeout: DefaultTimeout}
+
+func (instance *Consumer) getClient() *http.Client {
+	if v := instance.Client; v != nil {
+		return v
+	}
+	return defaultClient
+}
+
+func (instance *Consumer) getFormatter() formatter.Formatter {
+	if v := instance.Formatter; v != nil {
+		return v
+	}
+	return defaultFormatter
+}
+
+var defaultFormatter = formatter.NewJson(func(v *formatter.Json) {
+	v.KeySorter = fields.DefaultKeySorter
+})
+
+func (instance *Consumer) getInterceptor() interceptor.Interceptor {
+	if v := instance.Interceptor; v != nil {
+		return v
+	}
+	if v := interceptor.Default; v != nil {
+		return v
+	}
+	return interceptor.Noop()
+}
+
+func (instance *Consumer) getBatchSize() int {
+	if v := instance.BatchSize; v > 0 {
+		return v
+	}
+	return DefaultBatchSize
+}
+
+func (instance *Consumer) getBatchBytes() int {
+	if v := instance.BatchBytes; v > 0 {
+		return v
+	}
+	return DefaultBatchBytes
+}
+
+func (instance *Consumer) getFlushInterval() time.Duration {
+	if v := instance.FlushInterval; v > 0 {
+		return v
+	}
+	return DefaultFlushInterval
+}
+
+func (instance *Consumer) getBufferSize() int {
+	if v := instance.BufferSize; v > 0 {
+		return v
+	}
+	return DefaultBufferSize
+}
+
+func (instance *Consumer) getMaxRetries() int {
+	if v := instance.MaxRetries; v != nil {
+		return *v
+	}
+	return DefaultMaxRetries
+}
+
+func (instance *Consumer) getMinBackoff() time.Duration {
+	if v := instance.MinBackoff; v > 0 {
+		return v
+	}
+	return DefaultMinBackoff
+}
+
+func (instance *Consumer) getMaxBackoff() time.Duration {
+	if v := instance.MaxBackoff; v > 0 {
+		return v
+	}
+	return DefaultMaxBackoff
+}
+
+func (instance *Consumer) getSpillMaxBytes() int64 {
+	if v := instance.SpillMaxBytes; v > 0 {
+		return v
+	}
+	return DefaultSpillMaxBytes
+}
@@ -0,0 +1,407 @@
+package httpbatch
+
+import (
+	"compress/gzip"
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	log "github.com/echocat/slf4g"
+	"github.com/echocat/slf4g/internal/test/assert"
+	"github.com/echocat/slf4g/native"
+	"github.com/echocat/slf4g/native/consumer"
+	"github.com/echocat/slf4g/native/interceptor"
+	"github.com/echocat/slf4g/native/location"
+)
+
+func Test_Consumer_Consume(t *testing.T) {
+	server := newTestServer(t)
+	instance := NewConsumer(server.URL, NewLoki(), func(v *Consumer) {
+		v.Interceptor = interceptor.Noop()
+		v.Header = http.Header{"X-Scope-Orgid": {"aTenant"}}
+	})
+	provider := newProvider(instance)
+
+	provider.GetLogger("foo").Info("first")
+	provider.GetLogger("foo").With("user", "bar").Warn("second")
+	assert.ToBeNoError(t, instance.Close())
+
+	actual := server.receivedRequests()
+	assert.ToBeEqual(t, 1, len(actual))
+	assert.ToBeEqual(t, "application/json", actual[0].header.Get("Content-Type"))
+	assert.ToBeEqual(t, "aTenant", actual[0].header.Get("X-Scope-OrgID"))
+	assert.ToBeEqual(t, `{"streams":[`+
+		`{"stream":{"level":"info","logger":"foo"},"values":[["1609593255123400000","{\"level\":\"INFO\",\"logger\":\"foo\",\"message\":\"first\",\"timestamp\":\"2021-01-02T13:14:15.1234Z\"}"]]},`+
+		`{"stream":{"level":"warn","logger":"foo"},"values":[["1609593255123400000","{\"level\":\"WARN\",\"logger\":\"foo\",\"message\":\"second\",\"timestamp\":\"2021-01-02T13:14:15.1234Z\",\"user\":\"bar\"}"]]}`+
+		`]}`, actual[0].body)
+}
+
+func Test_Consumer_Consume_batches(t *testing.T) {
+	cases := []struct {
+		name       string
+		batchSize  int
+		batchBytes int
+		expected   []int
+	}{
+		{"bySize", 2, 0, []int{2, 2, 1}},
+		{"byBytes", 0, 30, []int{1, 1, 1, 1, 1}},
+		{"byBytesMultiple", 0, 300, []int{4, 1}},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			server := newTestServer(t)
+			instance := NewConsumer(server.URL, NewElasticsearch("logs"), func(v *Consumer) {
+				v.Interceptor = interceptor.Noop()
+				v.BatchSize = c.batchSize
+				v.BatchBytes = c.batchBytes
+				v.FlushInterval = time.Hour
+			})
+			logger := newProvider(instance).GetRootLogger()
+
+			for i := 0; i < 5; i++ {
+				logger.Info(i)
+			}
+			assert.ToBeNoError(t, instance.Close())
+
+			var actual []int
+			for _, r := range server.receivedRequests() {
+				actual = append(actual, strings.Count(r.body, "\n")/2)
+			}
+			assert.ToBeEqual(t, c.expected, actual)
+		})
+	}
+}
+
+func Test_Consumer_Consume_gzip(t *testing.T) {
+	server := newTestServer(t)
+	instance := NewConsumer(server.URL, NewSplunk(), func(v *Consumer) {
+		v.Interceptor = interceptor.Noop()
+		v.Gzip = true
+	})
+
+	newProvider(instance).GetRootLogger().Info("hello")
+	assert.ToBeNoError(t, instance.Close())
+
+	actual := server.receivedRequests()
+	assert.ToBeEqual(t, 1, len(actual))
+	assert.ToBeEqual(t, "gzip", actual[0].header.Get("Content-Encoding"))
+	assert.ToBeEqual(t, `{"time":1609593255.123,"event":{"level":"INFO","message":"hello","timestamp":"2021-01-02T13:14:15.1234Z"}}`, actual[0].body)
+}
+
+func Test_Consumer_Consume_retries(t *testing.T) {
+	server := newTestServer(t, http.StatusServiceUnavailable, http.StatusTooManyRequests)
+	var actualErrs errorRecorder
+	instance := NewConsumer(server.URL, NewSplunk(), func(v *Consumer) {
+		v.Interceptor = interceptor.Noop()
+		v.FlushInterval = 5 * time.Millisecond
+		v.MinBackoff = time.Millisecond
+		v.OnError = actualErrs.record
+	})
+
+	newProvider(instance).GetRootLogger().Info("hello")
+	server.awaitRequests(3)
+	assert.ToBeNoError(t, instance.Close())
+
+	actual := server.receivedRequests()
+	assert.ToBeEqual(t, 3, len(actual))
+	assert.ToBeEqual(t, actual[0].body, actual[2].body)
+	assert.ToBeEqual(t, 0, actualErrs.len())
+}
+
+func Test_Consumer_Consume_spillsAfterRetries(t *testing.T) {
+	givenSpillDirectory := t.TempDir()
+	givenMaxRetries := 1
+	server := newTestServer(t, http.StatusBadGateway, http.StatusBadGateway)
+	var actualErrs errorRecorder
+	instance := NewConsumer(server.URL, NewSplunk(), func(v *Consumer) {
+		v.Interceptor = interceptor.Noop()
+		v.BatchSize = 1
+		v.MaxRetries = &givenMaxRetries
+		v.MinBackoff = time.Millisecond
+		v.SpillDirectory = givenSpillDirectory
+		v.OnError = actualErrs.record
+	})
+
+	newProvider(instance).GetRootLogger().Info("hello")
+	server.awaitRequests(2)
+	assert.ToBeNoError(t, instance.Close())
+
+	assert.ToBeEqual(t, 2, len(server.receivedRequests()))
+	assert.ToBeEqual(t, 1, actualErrs.len())
+	assert.ToBeMatching(t, `^spilled 1 entries: unexpected status: 502 Bad Gateway`, actualErrs.get(0))
+	actualFiles, _ := filepath.Glob(filepath.Join(givenSpillDirectory, "*"+spillFileSuffix))
+	assert.ToBeEqual(t, 1, len(actualFiles))
+}
+
+func Test_Consumer_Consume_dropsOnPermanentError(t *testing.T) {
+	server := newTestServer(t, http.StatusBadRequest)
+	var actualErrs errorRecorder
+	instance := NewConsumer(server.URL, NewSplunk(), func(v *Consumer) {
+		v.Interceptor = interceptor.Noop()
+		v.MinBackoff = time.Millisecond
+		v.SpillDirectory = t.TempDir()
+		v.OnError = actualErrs.record
+	})
+
+	newProvider(instance).GetRootLogger().Info("hello")
+
+	assert.ToBeMatching(t, `^dropped 1 entries: unexpected status: 400 Bad Request \(expected\)$`, instance.Close())
+	assert.ToBeEqual(t, 1, len(server.receivedRequests()))
+	assert.ToBeEqual(t, 1, actualErrs.len())
+	assert.ToBeEqual(t, true, errors.Is(actualErrs.get(0), ErrUnexpectedStatus))
+}
+
+func Test_Consumer_Consume_reportsRejectedEntries(t *testing.T) {
+	givenSpillDirectory := t.TempDir()
+	var requests int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		atomic.AddInt32(&requests, 1)
+		_, _ = w.Write([]byte(`{"errors":true,"items":[{"create":{"status":400,"error":{"type":"mapper_parsing_exception"}}}]}`))
+	}))
+	t.Cleanup(server.Close)
+	var actualErrs errorRecorder
+
+	instance := NewConsumer(server.URL, NewElasticsearch("logs"), func(v *Consumer) {
+		v.Interceptor = interceptor.Noop()
+		v.SpillDirectory = givenSpillDirectory
+		v.OnError = actualErrs.record
+	})
+	newProvider(instance).GetRootLogger().Info("first")
+	actualErr := instance.Close()
+
+	// Rejected entries are neither retried nor spilled.
+	assert.ToBeMatching(t, `^dropped 1 entries: entries rejected: 1 of 1 documents failed; first: 400 {"type":"mapper_parsing_exception"}$`, actualErr)
+	assert.ToBeEqual(t, true, errors.Is(actualErr, ErrRejected))
+	assert.ToBeEqual(t, int32(1), atomic.LoadInt32(&requests))
+	assert.ToBeEqual(t, 1, actualErrs.len())
+	actualFiles, _ := filepath.Glob(filepath.Join(givenSpillDirectory, "*"+spillFileSuffix))
+	assert.ToBeEqual(t, 0, len(actualFiles))
+}
+
+func Test_Consumer_Consume_spillsAndReplays(t *testing.T) {
+	givenSpillDirectory := t.TempDir()
+	server := newTestServer(t, http.StatusBadGateway)
+	var actualErrs errorRecorder
+	customizer := func(v *Consumer) {
+		v.Interceptor = interceptor.Noop()
+		v.MinBackoff = time.Hour
+		v.SpillDirectory = givenSpillDirectory
+		v.OnError = actualErrs.record
+	}
+
+	instance := NewConsumer(server.URL, NewSplunk(), customizer)
+	newProvider(instance).GetRootLogger().Info("first")
+	// While closing failed requests are not retried but spilled directly.
+	assert.ToBeNoError(t, instance.Close())
+
+	assert.ToBeEqual(t, 1, len(server.receivedRequests()))
+	assert.ToBeEqual(t, 1, actualErrs.len())
+	assert.ToBeMatching(t, `^spilled 1 entries: unexpected status: 502 Bad Gateway`, actualErrs.get(0))
+	actualFiles, _ := filepath.Glob(filepath.Join(givenSpillDirectory, "*"+spillFileSuffix))
+	assert.ToBeEqual(t, 1, len(actualFiles))
+
+	// Outage is over; after a restart the spilled request is sent before the new one.
+	instance = NewConsumer(server.URL, NewSplunk(), customizer)
+	newProvider(instance).GetRootLogger().Info("second")
+	assert.ToBeNoError(t, instance.Close())
+
+	actual := server.receivedRequests()
+	assert.ToBeEqual(t, 3, len(actual))
+	assert.ToBeMatching(t, `"message":"first"`, actual[1].body)
+	assert.ToBeMatching(t, `"message":"second"`, actual[2].body)
+	actualFiles, _ = filepath.Glob(filepath.Join(givenSpillDirectory, "*"+spillFileSuffix))
+	assert.ToBeEqual(t, 0, len(actualFiles))
+}
+
+func Test_Consumer_Consume_spillsWhileSpilledRemain(t *testing.T) {
+	givenSpillDirectory := t.TempDir()
+	assert.ToBeNoError(t, os.WriteFile(filepath.Join(givenSpillDirectory, "00000000000000000001-000001"+spillFileSuffix), []byte("spilled"), 0600))
+	server := newTestServer(t, http.StatusBadGateway)
+
+	instance := NewConsumer(server.URL, NewSplunk(), func(v *Consumer) {
+		v.Interceptor = interceptor.Noop()
+		v.MinBackoff = time.Hour
+		v.SpillDirectory = givenSpillDirectory
+	})
+	newProvider(instance).GetRootLogger().Info("first")
+	assert.ToBeNoError(t, instance.Close())
+
+	// Only the spilled request was tried; the new one must not overtake it.
+	actual := server.receivedRequests()
+	assert.ToBeEqual(t, 1, len(actual))
+	assert.ToBeEqual(t, "spilled", actual[0].body)
+	actualFiles, _ := filepath.Glob(filepath.Join(givenSpillDirectory, "*"+spillFileSuffix))
+	assert.ToBeEqual(t, 2, len(actualFiles))
+	content, _ := os.ReadFile(actualFiles[1])
+	assert.ToBeMatching(t, `"message":"first"`, string(content))
+}
+
+func Test_Consumer_Consume_replaysWithoutNewEntries(t *testing.T) {
+	givenSpillDirectory := t.TempDir()
+	assert.ToBeNoError(t, os.WriteFile(filepath.Join(givenSpillDirectory, "00000000000000000001-000001"+spillFileSuffix), []byte("spilled"), 0600))
+	server := newTestServer(t)
+
+	instance := NewConsumer(server.URL, NewSplunk(), func(v *Consumer) {
+		v.FlushInterval = time.Millisecond
+		v.SpillDirectory = givenSpillDirectory
+	})
+	server.awaitRequests(1)
+	assert.ToBeNoError(t, instance.Close())
+
+	actual := server.receivedRequests()
+	assert.ToBeEqual(t, 1, len(actual))
+	assert.ToBeEqual(t, "spilled", actual[0].body)
+}
+
+func Test_Consumer_Consume_afterClose(t *testing.T) {
+	server := newTestServer(t)
+	var actualErrs errorRecorder
+	instance := NewConsumer(server.URL, NewSplunk(), func(v *Consumer) {
+		v.Interceptor = interceptor.Noop()
+		v.OnError = actualErrs.record
+	})
+	assert.ToBeNoError(t, instance.Close())
+
+	newProvider(instance).GetRootLogger().Info("hello")
+
+	assert.ToBeEqual(t, 1, actualErrs.len())
+	assert.ToBeSame(t, ErrClosed, actualErrs.get(0))
+	assert.ToBeEqual(t, 0, len(server.receivedRequests()))
+}
+
+func Test_spill_store_shrinks(t *testing.T) {
+	instance := &spill{directory: t.TempDir(), maxBytes: 10}
+
+	_, err := instance.store([]byte("1234"))
+	assert.ToBeNoError(t, err)
+	_, err = instance.store([]byte("5678"))
+	assert.ToBeNoError(t, err)
+	actualDropped, err := instance.store([]byte("9012"))
+	assert.ToBeNoError(t, err)
+
+	assert.ToBeEqual(t, 1, len(actualDropped))
+	actualFiles, err := instance.files()
+	assert.ToBeNoError(t, err)
+	assert.ToBeEqual(t, 2, len(actualFiles))
+	actualContent, err := os.ReadFile(actualFiles[0])
+	assert.ToBeNoError(t, err)
+	assert.ToBeEqual(t, "5678", string(actualContent))
+}
+
+var givenEventTimestamp = time.Date(2021, 1, 2, 13, 14, 15, 123400000, time.UTC)
+
+func newProvider(c consumer.Consumer) *native.Provider {
+	return &native.Provider{
+		LocationDiscovery: location.NoopDiscovery(),
+		CoreLoggerCustomizer: func(_ *native.Provider, cl *native.CoreLogger) log.CoreLogger {
+			cl.Consumer = consumer.Func(func(event log.Event, source log.CoreLogger) {
+				c.Consume(event.With("timestamp", givenEventTimestamp), source)
+			})
+			return cl
+		},
+	}
+}
+
+type receivedRequest struct {
+	header http.Header
+	body   string
+}
+
+type testServer struct {
+	*httptest.Server
+	// statuses are responded in order to the first requests; all others are
+	// responded with 204.
+	statuses []int
+	requests []receivedRequest
+	mutex    sync.Mutex
+	cond     *sync.Cond
+}
+
+func newTestServer(t *testing.T, statuses ...int) *testServer {
+	result := &testServer{statuses: statuses}
+	result.cond = sync.NewCond(&result.mutex)
+	result.Server = httptest.NewServer(http.HandlerFunc(result.handle))
+	t.Cleanup(result.Close)
+	return result
+}
+
+func (instance *testServer) handle(w http.ResponseWriter, r *http.Request) {
+	var reader io.Reader = r.Body
+	if r.Header.Get("Content-Encoding") == "gzip" {
+		gr, err := gzip.NewReader(r.Body)
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+		reader = gr
+	}
+	body, err := io.ReadAll(reader)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	instance.mutex.Lock()
+	defer instance.mutex.Unlock()
+	status := http.StatusNoContent
+	if i := len(instance.requests); i < len(instance.statuses) {
+		status = instance.statuses[i]
+	}
+	instance.requests = append(instance.requests, receivedRequest{r.Header, string(body)})
+	instance.cond.Broadcast()
+
+	if status == http.StatusTooManyRequests {
+		w.Header().Set("Retry-After", "0")
+	}
+	if status >= 300 {
+		http.Error(w, "expected", status)
+		return
+	}
+	w.WriteHeader(status)
+}
+
+func (instance *testServer) awaitRequests(n int) {
+	instance.mutex.Lock()
+	defer instance.mutex.Unlock()
+	for len(instance.requests) < n {
+		instance.cond.Wait()
+	}
+}
+
+func (instance *testServer) receivedRequests() []receivedRequest {
+	instance.mutex.Lock()
+	defer instance.mutex.Unlock()
+	return append([]receivedRequest{}, instance.requests...)
+}
+
+type errorRecorder struct {
+	errs  []error
+	mutex sync.Mutex
+}
+
+func (instance *errorRecorder) record(_ *Consumer, err error) {
+	instance.mutex.Lock()
+	defer instance.mutex.Unlock()
+	instance.errs = append(instance.errs, err)
+}
+
+func (instance *errorRecorder) len() int {
+	instance.mutex.Lock()
+	defer instance.mutex.Unlock()
+	return len(instance.errs)
+}
+
+func (instance *errorRecorder) get(i int) error {
+	instance.mutex.Lock()
+	defer instance.mutex.Unlock()
+	return instance.errs[i]
+}
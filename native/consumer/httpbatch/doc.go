@@ -0,0 +1,3 @@
+// Package httpbatch provides a consumer.Consumer which ships log events in
+// batches via HTTP; for example to Grafana Loki, Elasticsearch or Splunk HEC.
+package httpbatch
@@ -0,0 +1,162 @@
+package httpbatch
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"time"
+)
+
+// DefaultElasticsearchAction is the default bulk action. See
+// Elasticsearch.Action for more information.
+var DefaultElasticsearchAction = "create"
+
+// DefaultElasticsearchTimestampField is the default field the timestamp of
+// entries is stored with. See Elasticsearch.TimestampField for more
+// information.
+var DefaultElasticsearchTimestampField = "@timestamp"
+
+// Elasticsearch is an implementation of Encoder which encodes entries for the
+// bulk API of Elasticsearch (/_bulk). Every entry becomes one document; this
+// requires Consumer.Formatter to produce JSON objects (which is the default).
+type Elasticsearch struct {
+	// Index is the index (or data stream) the documents are written to.
+	Index string
+
+	// Action is the bulk action which is used for each document (create or
+	// index). Data streams require create. If not set
+	// DefaultElasticsearchAction will be used.
+	Action string
+
+	// TimestampField is the field the timestamp is added to each document
+	// with. If set to - the timestamp is not added. If not set
+	// DefaultElasticsearchTimestampField will be used.
+	TimestampField string
+}
+
+// NewElasticsearch creates a new instance of Elasticsearch which writes into
+// the given index and is ready to use.
+func NewElasticsearch(index string, customizer ...func(*Elasticsearch)) *Elasticsearch {
+	result := &Elasticsearch{
+		Index: index,
+	}
+	for _, c := range customizer {
+		c(result)
+	}
+	return result
+}
+
+// ContentType implements Encoder.ContentType()
+func (instance *Elasticsearch) ContentType() string {
+	return "application/x-ndjson"
+}
+
+// Encode implements Encoder.Encode()
+func (instance *Elasticsearch) Encode(entries []Entry) ([]byte, error) {
+	action, err := json.Marshal(map[string]interface{}{
+		instance.getAction(): map[string]string{"_index": instance.Index},
+	})
+	if err != nil {
+		return nil, err
+	}
+	timestampField := instance.getTimestampField()
+
+	var buf bytes.Buffer
+	for _, e := range entries {
+		buf.Write(action)
+		buf.WriteByte('\n')
+		if err := instance.writeDocument(e, timestampField, &buf); err != nil {
+			return nil, err
+		}
+		buf.WriteByte('\n')
+	}
+	return buf.Bytes(), nil
+}
+
+// CheckResponse implements ResponseChecker.CheckResponse()
+//
+// The bulk API responds with 200 even if single documents were rejected (for
+// example because of mapping conflicts); this is reported by errors and the
+// error of the affected items.
+func (instance *Elasticsearch) CheckResponse(_ int, body []byte) error {
+	var response struct {
+		Errors bool `json:"errors"`
+		Items  []map[string]struct {
+			Status int             `json:"status"`
+			Error  json.RawMessage `json:"error"`
+		} `json:"items"`
+	}
+	if len(bytes.TrimSpace(body)) == 0 {
+		// Nothing to check; for example if the response was answered by a
+		// proxy with 204.
+		return nil
+	}
+	if err := json.Unmarshal(body, &response); err != nil {
+		return fmt.Errorf("cannot parse bulk response: %w", err)
+	}
+	if !response.Errors {
+		return nil
+	}
+	failed := 0
+	var first string
+	for _, item := range response.Items {
+		for _, result := range item {
+			if len(result.Error) == 0 || string(result.Error) == "null" {
+				continue
+			}
+			if failed == 0 {
+				first = fmt.Sprintf("%d %s", result.Status, result.Error)
+			}
+			failed++
+		}
+	}
+	return fmt.Errorf("%d of %d documents failed; first: %s", failed, len(response.Items), first)
+}
+
+func (instance *Elasticsearch) writeDocument(e Entry, timestampField string, to *bytes.Buffer) error {
+	document := bytes.TrimSpace(e.Formatted)
+	if timestampField == "-" {
+		_, err := to.Write(document)
+		return err
+	}
+	if len(document) < 2 || document[0] != '{' {
+		// Not an object; so we wrap it.
+		b, err := json.Marshal(map[string]interface{}{
+			timestampField: e.Timestamp.Format(time.RFC3339Nano),
+			"message":      string(document),
+		})
+		if err != nil {
+			return err
+		}
+		_, err = to.Write(b)
+		return err
+	}
+
+	key, err := json.Marshal(timestampField)
+	if err != nil {
+		return err
+	}
+	to.WriteByte('{')
+	to.Write(key)
+	to.WriteByte(':')
+	to.WriteString(`"` + e.Timestamp.Format(time.RFC3339Nano) + `"`)
+	if rest := bytes.TrimSpace(document[1:]); len(rest) > 0 && rest[0] != '}' {
+		to.WriteByte(',')
+	}
+	to.Write(document[1:])
+	return nil
+}
+
+func (instance *Elasticsearch) getAction() string {
+	if v := instance.Action; v != "" {
+		return v
+	}
+	return DefaultElasticsearchAction
+}
+
+func (instance *Elasticsearch) getTimestampField() string {
+	if v := instance.TimestampField; v != "" {
+		return v
+	}
+	return DefaultElasticsearchTimestampField
+}
@@ -0,0 +1,68 @@
+package httpbatch
+
+import (
+	"testing"
+
+	"github.com/echocat/slf4g/internal/test/assert"
+)
+
+func Test_Elasticsearch_Encode(t *testing.T) {
+	instance := NewElasticsearch("logs")
+
+	actual, actualErr := instance.Encode(append(givenEntries(), Entry{Timestamp: givenTimestamp, Formatted: []byte("{}")}))
+
+	assert.ToBeNoError(t, actualErr)
+	assert.ToBeEqual(t, "application/x-ndjson", instance.ContentType())
+	assert.ToBeEqual(t, `{"create":{"_index":"logs"}}
+{"@timestamp":"2021-01-02T13:14:15.1234Z","level":"INFO","message":"first"}
+{"create":{"_index":"logs"}}
+{"@timestamp":"2021-01-02T13:14:16.1234Z","level":"WARN","message":"second"}
+{"create":{"_index":"logs"}}
+{"@timestamp":"2021-01-02T13:14:17.1234Z","message":"plain"}
+{"create":{"_index":"logs"}}
+{"@timestamp":"2021-01-02T13:14:15.1234Z"}
+`, string(actual))
+}
+
+func Test_Elasticsearch_Encode_customized(t *testing.T) {
+	instance := NewElasticsearch("logs", func(v *Elasticsearch) {
+		v.Action = "index"
+		v.TimestampField = "-"
+	})
+
+	actual, actualErr := instance.Encode(givenEntries()[:1])
+
+	assert.ToBeNoError(t, actualErr)
+	assert.ToBeEqual(t, `{"index":{"_index":"logs"}}
+{"level":"INFO","message":"first"}
+`, string(actual))
+}
+
+func Test_Elasticsearch_CheckResponse(t *testing.T) {
+	cases := []struct {
+		name     string
+		body     string
+		expected string
+	}{
+		{"success", `{"took":3,"errors":false,"items":[{"create":{"status":201}}]}`, ""},
+		{"failed", `{"took":3,"errors":true,"items":[` +
+			`{"create":{"status":201}},` +
+			`{"create":{"status":400,"error":{"type":"mapper_parsing_exception","reason":"failed to parse"}}},` +
+			`{"create":{"status":409,"error":{"type":"version_conflict_engine_exception"}}}` +
+			`]}`, `^2 of 3 documents failed; first: 400 {"type":"mapper_parsing_exception","reason":"failed to parse"}$`},
+		{"broken", `{"took":`, `^cannot parse bulk response: `},
+		{"empty", ``, ""},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			actual := NewElasticsearch("logs").CheckResponse(200, []byte(c.body))
+
+			if c.expected == "" {
+				assert.ToBeNoError(t, actual)
+			} else {
+				assert.ToBeMatching(t, c.expected, actual)
+			}
+		})
+	}
+}
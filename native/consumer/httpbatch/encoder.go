@@ -0,0 +1,46 @@
+package httpbatch
+
+import (
+	"time"
+
+	"github.com/echocat/slf4g/level"
+)
+
+// Entry is one consumed log.Event which is ready to be encoded by an Encoder.
+type Entry struct {
+	// Timestamp of the event. If the event does not contain one, this is the
+	// time when it was consumed.
+	Timestamp time.Time
+
+	// Level of the event.
+	Level level.Level
+
+	// LevelName is the name of Level (for example INFO).
+	LevelName string
+
+	// Logger is the name of the logger which logged the event.
+	Logger string
+
+	// Formatted is the event formatted by Consumer.Formatter (by default as a
+	// JSON object) without tailing line breaks.
+	Formatted []byte
+}
+
+// Encoder encodes batches of entries into the body of HTTP requests.
+type Encoder interface {
+	// ContentType returns the content type of the encoded bodies.
+	ContentType() string
+
+	// Encode encodes the given entries into the body of one request.
+	Encode(entries []Entry) ([]byte, error)
+}
+
+// ResponseChecker is an optional interface which could be implemented by an
+// Encoder to check the body of successful (2xx) responses. Some APIs (like
+// the bulk API of Elasticsearch) report failures of single entries this way.
+type ResponseChecker interface {
+	// CheckResponse returns an error if the response with the given status
+	// and body reports that entries were rejected. Such requests are neither
+	// retried nor spilled, because the server already processed them.
+	CheckResponse(status int, body []byte) error
+}
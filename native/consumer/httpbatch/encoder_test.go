@@ -0,0 +1,31 @@
+package httpbatch
+
+import (
+	"time"
+
+	"github.com/echocat/slf4g/level"
+)
+
+var givenTimestamp = time.Date(2021, 1, 2, 13, 14, 15, 123400000, time.UTC)
+
+func givenEntries() []Entry {
+	return []Entry{{
+		Timestamp: givenTimestamp,
+		Level:     level.Info,
+		LevelName: "INFO",
+		Logger:    "foo",
+		Formatted: []byte(`{"level":"INFO","message":"first"}`),
+	}, {
+		Timestamp: givenTimestamp.Add(time.Second),
+		Level:     level.Warn,
+		LevelName: "WARN",
+		Logger:    "foo",
+		Formatted: []byte(`{"level":"WARN","message":"second"}`),
+	}, {
+		Timestamp: givenTimestamp.Add(2 * time.Second),
+		Level:     level.Info,
+		LevelName: "INFO",
+		Logger:    "foo",
+		Formatted: []byte(`plain`),
+	}}
+}
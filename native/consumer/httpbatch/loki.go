@@ -0,0 +1,118 @@
+package httpbatch
+
+import (
+	"encoding/json"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// DefaultLokiLevelLabel is the default label the level of entries is stored
+// with. See Loki.LevelLabel for more information.
+var DefaultLokiLevelLabel = "level"
+
+// DefaultLokiLoggerLabel is the default label the logger of entries is stored
+// with. See Loki.LoggerLabel for more information.
+var DefaultLokiLoggerLabel = "logger"
+
+// Loki is an implementation of Encoder which encodes entries for the push
+// API of Grafana Loki (/loki/api/v1/push). Entries with the same labels are
+// grouped into the same stream; the line is the formatted event.
+type Loki struct {
+	// Labels are static labels which are added to every stream (for example
+	// app or env).
+	Labels map[string]string
+
+	// LevelLabel is the label the (lower case) name of the level is stored
+	// with. If not set DefaultLokiLevelLabel will be used.
+	LevelLabel string
+
+	// LoggerLabel is the label the name of the logger is stored with. If not
+	// set DefaultLokiLoggerLabel will be used.
+	LoggerLabel string
+}
+
+// NewLoki creates a new instance of Loki which is ready to use.
+func NewLoki(customizer ...func(*Loki)) *Loki {
+	result := &Loki{}
+	for _, c := range customizer {
+		c(result)
+	}
+	return result
+}
+
+// ContentType implements Encoder.ContentType()
+func (instance *Loki) ContentType() string {
+	return "application/json"
+}
+
+// Encode implements Encoder.Encode()
+func (instance *Loki) Encode(entries []Entry) ([]byte, error) {
+	type stream struct {
+		Stream map[string]string `json:"stream"`
+		Values [][2]string       `json:"values"`
+	}
+
+	var streams []*stream
+	byKey := map[string]*stream{}
+	for _, e := range entries {
+		labels := instance.labelsOf(e)
+		key := lokiKeyOf(labels)
+		s, ok := byKey[key]
+		if !ok {
+			s = &stream{Stream: labels}
+			byKey[key] = s
+			streams = append(streams, s)
+		}
+		s.Values = append(s.Values, [2]string{
+			strconv.FormatInt(e.Timestamp.UnixNano(), 10),
+			string(e.Formatted),
+		})
+	}
+
+	return json.Marshal(struct {
+		Streams []*stream `json:"streams"`
+	}{streams})
+}
+
+func (instance *Loki) labelsOf(e Entry) map[string]string {
+	result := make(map[string]string, len(instance.Labels)+2)
+	for k, v := range instance.Labels {
+		result[k] = v
+	}
+	result[instance.getLevelLabel()] = strings.ToLower(e.LevelName)
+	if e.Logger != "" {
+		result[instance.getLoggerLabel()] = e.Logger
+	}
+	return result
+}
+
+func lokiKeyOf(labels map[string]string) string {
+	keys := make([]string, 0, len(labels))
+	for k := range labels {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	var sb strings.Builder
+	for _, k := range keys {
+		sb.WriteString(strconv.Quote(k))
+		sb.WriteByte('=')
+		sb.WriteString(strconv.Quote(labels[k]))
+		sb.WriteByte(',')
+	}
+	return sb.String()
+}
+
+func (instance *Loki) getLevelLabel() string {
+	if v := instance.LevelLabel; v != "" {
+		return v
+	}
+	return DefaultLokiLevelLabel
+}
+
+func (instance *Loki) getLoggerLabel() string {
+	if v := instance.LoggerLabel; v != "" {
+		return v
+	}
+	return DefaultLokiLoggerLabel
+}
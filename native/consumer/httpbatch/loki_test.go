@@ -0,0 +1,34 @@
+package httpbatch
+
+import (
+	"testing"
+
+	"github.com/echocat/slf4g/internal/test/assert"
+)
+
+func Test_Loki_Encode(t *testing.T) {
+	instance := NewLoki(func(v *Loki) {
+		v.Labels = map[string]string{"app": "bar"}
+	})
+
+	actual, actualErr := instance.Encode(givenEntries())
+
+	assert.ToBeNoError(t, actualErr)
+	assert.ToBeEqual(t, "application/json", instance.ContentType())
+	assert.ToBeEqual(t, `{"streams":[`+
+		`{"stream":{"app":"bar","level":"info","logger":"foo"},"values":[["1609593255123400000","{\"level\":\"INFO\",\"message\":\"first\"}"],["1609593257123400000","plain"]]},`+
+		`{"stream":{"app":"bar","level":"warn","logger":"foo"},"values":[["1609593256123400000","{\"level\":\"WARN\",\"message\":\"second\"}"]]}`+
+		`]}`, string(actual))
+}
+
+func Test_Loki_Encode_withCustomLabels(t *testing.T) {
+	instance := NewLoki(func(v *Loki) {
+		v.LevelLabel = "severity"
+		v.LoggerLabel = "component"
+	})
+
+	actual, actualErr := instance.Encode(givenEntries()[1:2])
+
+	assert.ToBeNoError(t, actualErr)
+	assert.ToBeEqual(t, `{"streams":[{"stream":{"component":"foo","severity":"warn"},"values":[["1609593256123400000","{\"level\":\"WARN\",\"message\":\"second\"}"]]}]}`, string(actual))
+}
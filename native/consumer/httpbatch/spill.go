@@ -0,0 +1,86 @@
+package httpbatch
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"sync/atomic"
+	"time"
+)
+
+const spillFileSuffix = ".batch"
+
+// spill stores request bodies which could not be delivered in a directory;
+// one file per body. The files are named by the time they were written, so
+// they can be replayed in order.
+type spill struct {
+	directory string
+	maxBytes  int64
+	sequence  uint64
+}
+
+func (instance *spill) store(body []byte) (dropped []string, err error) {
+	if err := os.MkdirAll(instance.directory, 0700); err != nil {
+		return nil, err
+	}
+	name := fmt.Sprintf("%020d-%06d%s", time.Now().UnixNano(), atomic.AddUint64(&instance.sequence, 1)%1000000, spillFileSuffix)
+	tmp := filepath.Join(instance.directory, "."+name+".tmp")
+	if err := os.WriteFile(tmp, body, 0600); err != nil {
+		return nil, err
+	}
+	if err := os.Rename(tmp, filepath.Join(instance.directory, name)); err != nil {
+		return nil, err
+	}
+	return instance.shrink()
+}
+
+// shrink removes the oldest files until all files together are smaller than
+// maxBytes.
+func (instance *spill) shrink() (dropped []string, err error) {
+	if instance.maxBytes <= 0 {
+		return nil, nil
+	}
+	files, err := instance.files()
+	if err != nil {
+		return nil, err
+	}
+	sizes := make([]int64, len(files))
+	var total int64
+	for i, file := range files {
+		fi, err := os.Stat(file)
+		if err != nil {
+			return dropped, err
+		}
+		sizes[i] = fi.Size()
+		total += sizes[i]
+	}
+	for i := 0; i < len(files)-1 && total > instance.maxBytes; i++ {
+		if err := os.Remove(files[i]); err != nil {
+			return dropped, err
+		}
+		total -= sizes[i]
+		dropped = append(dropped, files[i])
+	}
+	return dropped, nil
+}
+
+// files returns all stored files; the oldest first.
+func (instance *spill) files() ([]string, error) {
+	entries, err := os.ReadDir(instance.directory)
+	if os.IsNotExist(err) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	var result []string
+	for _, entry := range entries {
+		if !entry.IsDir() && strings.HasSuffix(entry.Name(), spillFileSuffix) && !strings.HasPrefix(entry.Name(), ".") {
+			result = append(result, filepath.Join(instance.directory, entry.Name()))
+		}
+	}
+	sort.Strings(result)
+	return result, nil
+}
@@ -0,0 +1,92 @@
+package httpbatch
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+)
+
+// Splunk is an implementation of Encoder which encodes entries for the event
+// endpoint of the Splunk HTTP Event Collector (/services/collector/event).
+// The token has to be provided as header of the Consumer; for example:
+//
+//	v.Header = http.Header{"Authorization": {"Splunk <token>"}}
+type Splunk struct {
+	// Host is the optional host field of each event.
+	Host string
+
+	// Source is the optional source field of each event.
+	Source string
+
+	// SourceType is the optional sourcetype field of each event.
+	SourceType string
+
+	// Index is the optional index field of each event.
+	Index string
+}
+
+// NewSplunk creates a new instance of Splunk which is ready to use.
+func NewSplunk(customizer ...func(*Splunk)) *Splunk {
+	result := &Splunk{}
+	for _, c := range customizer {
+		c(result)
+	}
+	return result
+}
+
+// ContentType implements Encoder.ContentType()
+func (instance *Splunk) ContentType() string {
+	return "application/json"
+}
+
+// Encode implements Encoder.Encode()
+func (instance *Splunk) Encode(entries []Entry) ([]byte, error) {
+	type event struct {
+		Time       json.Number `json:"time"`
+		Host       string      `json:"host,omitempty"`
+		Source     string      `json:"source,omitempty"`
+		SourceType string      `json:"sourcetype,omitempty"`
+		Index      string      `json:"index,omitempty"`
+		Event      interface{} `json:"event"`
+	}
+
+	var buf bytes.Buffer
+	for _, e := range entries {
+		var payload interface{} = string(e.Formatted)
+		if formatted := bytes.TrimSpace(e.Formatted); json.Valid(formatted) {
+			payload = json.RawMessage(formatted)
+		}
+		b, err := json.Marshal(event{
+			Time:       json.Number(fmt.Sprintf("%d.%03d", e.Timestamp.Unix(), e.Timestamp.Nanosecond()/1e6)),
+			Host:       instance.Host,
+			Source:     instance.Source,
+			SourceType: instance.SourceType,
+			Index:      instance.Index,
+			Event:      payload,
+		})
+		if err != nil {
+			return nil, err
+		}
+		buf.Write(b)
+	}
+	return buf.Bytes(), nil
+}
+
+// CheckResponse implements ResponseChecker.CheckResponse()
+//
+// The HTTP Event Collector reports the result with code (0 means success)
+// and text inside the body.
+func (instance *Splunk) CheckResponse(_ int, body []byte) error {
+	var response struct {
+		Text string `json:"text"`
+		Code *int   `json:"code"`
+	}
+	if err := json.Unmarshal(body, &response); err != nil || response.Code == nil {
+		// Responses without a code (like from proxies) are accepted.
+		return nil
+	}
+	if *response.Code != 0 {
+		return fmt.Errorf("code %d: %s", *response.Code, response.Text)
+	}
+	return nil
+}
@@ -0,0 +1,46 @@
+package httpbatch
+
+import (
+	"testing"
+
+	"github.com/echocat/slf4g/internal/test/assert"
+)
+
+func Test_Splunk_Encode(t *testing.T) {
+	instance := NewSplunk(func(v *Splunk) {
+		v.Host = "aHost"
+		v.SourceType = "_json"
+	})
+
+	actual, actualErr := instance.Encode(givenEntries())
+
+	assert.ToBeNoError(t, actualErr)
+	assert.ToBeEqual(t, "application/json", instance.ContentType())
+	assert.ToBeEqual(t, `{"time":1609593255.123,"host":"aHost","sourcetype":"_json","event":{"level":"INFO","message":"first"}}`+
+		`{"time":1609593256.123,"host":"aHost","sourcetype":"_json","event":{"level":"WARN","message":"second"}}`+
+		`{"time":1609593257.123,"host":"aHost","sourcetype":"_json","event":"plain"}`, string(actual))
+}
+
+func Test_Splunk_CheckResponse(t *testing.T) {
+	cases := []struct {
+		name     string
+		body     string
+		expected string
+	}{
+		{"success", `{"text":"Success","code":0}`, ""},
+		{"failed", `{"text":"Invalid data format","code":6,"invalid-event-number":1}`, `^code 6: Invalid data format$`},
+		{"withoutCode", `OK`, ""},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			actual := NewSplunk().CheckResponse(200, []byte(c.body))
+
+			if c.expected == "" {
+				assert.ToBeNoError(t, actual)
+			} else {
+				assert.ToBeMatching(t, c.expected, actual)
+			}
+		})
+	}
+}
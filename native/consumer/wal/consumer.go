@@ -0,0 +1,395 @@
+package wal
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"sync"
+	"time"
+
+	log "github.com/echocat/slf4g"
+	"github.com/echocat/slf4g/native/consumer"
+)
+
+var (
+	// DefaultMaxBytes is the default maximum size of all segments on disk.
+	// See Consumer.MaxBytes for more details.
+	DefaultMaxBytes int64 = 1024 * 1024 * 1024
+
+	// DefaultSegmentSize is the default size after which a new segment is
+	// started. See Consumer.SegmentSize for more details.
+	DefaultSegmentSize int64 = 16 * 1024 * 1024
+
+	// DefaultCheckpointInterval is the default amount of delivered events
+	// after which the position is persisted. See Consumer.CheckpointInterval
+	// for more details.
+	DefaultCheckpointInterval = 100
+
+	// DefaultMinBackoff is the default time to wait before the first retry
+	// of a failed delivery. See Consumer.MinBackoff for more details.
+	DefaultMinBackoff = 100 * time.Millisecond
+
+	// DefaultMaxBackoff is the default maximum time to wait between retries
+	// of a failed delivery. See Consumer.MaxBackoff for more details.
+	DefaultMaxBackoff = 30 * time.Second
+)
+
+var (
+	// ErrEvicted is reported (see Consumer.OnError) if records were dropped
+	// because the buffer exceeded Consumer.MaxBytes.
+	ErrEvicted = errors.New("records evicted")
+
+	// ErrDeliveryFailed is reported (see Consumer.OnError) if the Delegate
+	// failed to consume an event. The delivery will be retried.
+	ErrDeliveryFailed = errors.New("delivery failed")
+
+	// ErrClosed is reported (see Consumer.OnError) if events are consumed
+	// after the Consumer was closed.
+	ErrClosed = errors.New("consumer is closed")
+)
+
+// CheckedConsumer is a consumer.Consumer which is able to report if an event
+// could not be consumed. If the Consumer.Delegate implements this interface,
+// failed deliveries are retried. Otherwise only a panic of
+// consumer.Consumer#Consume() is treated as failure.
+type CheckedConsumer interface {
+	consumer.Consumer
+
+	// ConsumeChecked is like consumer.Consumer#Consume() but returns an error
+	// if the given event could not be consumed.
+	ConsumeChecked(event log.Event, source log.CoreLogger) error
+}
+
+// Consumer is an implementation of consumer.Consumer which writes all
+// consumed events ahead into segment files inside a directory before they are
+// delivered to the Delegate.
+//
+// Each event is appended as a record (protected by a CRC-32C checksum) to the
+// current segment. A background goroutine delivers the records in order to
+// the Delegate; failed deliveries are retried with an exponential backoff
+// (see MinBackoff and MaxBackoff) while new events are still appended. The
+// position of the last delivered record is persisted regularly (see
+// CheckpointInterval), so records which were not delivered before the
+// process ended are replayed after the next start (at least once semantics).
+// Records which were written only partially (for example because of a crash)
+// are discarded.
+//
+// If the segments exceed MaxBytes the oldest segments are evicted and
+// ErrEvicted is reported.
+//
+// NewConsumer() is used to create a new instance. Close() has to be called to
+// persist the position and to release the files.
+type Consumer struct {
+	// Delegate is the consumer.Consumer to which all events are delivered. If
+	// it implements CheckedConsumer failed deliveries can be detected and
+	// retried.
+	Delegate consumer.Consumer
+
+	// Provider is used to recreate the events and their loggers while
+	// replaying. If not set the provider of the first consumed event is used;
+	// until then no records are replayed.
+	Provider log.Provider
+
+	// MaxBytes is the maximum size of all segments on disk. If exceeded the
+	// oldest segments are evicted. If not set DefaultMaxBytes will be used.
+	MaxBytes int64
+
+	// SegmentSize is the size after which a new segment is started. If not
+	// set DefaultSegmentSize will be used.
+	SegmentSize int64
+
+	// Synchronous will (if set to true) sync the segment to disk after each
+	// appended event. This ensures that no event is lost in case of a crash
+	// of the operating system, but is considerable slower.
+	Synchronous bool
+
+	// CheckpointInterval is the amount of delivered events after which the
+	// position is persisted. Lower values reduce the amount of events which
+	// are delivered twice after a crash. If not set
+	// DefaultCheckpointInterval will be used.
+	CheckpointInterval int
+
+	// MinBackoff is the time to wait before the first retry of a failed
+	// delivery; it is doubled with every failed attempt. If not set
+	// DefaultMinBackoff will be used.
+	MinBackoff time.Duration
+
+	// MaxBackoff is the maximum time to wait between retries of a failed
+	// delivery. If not set DefaultMaxBackoff will be used.
+	MaxBackoff time.Duration
+
+	// OnError will be called if events could not be written, delivered or
+	// were evicted. If nothing was provided these errors will be silently
+	// swallowed.
+	OnError func(*Consumer, error)
+
+	journal  *journal
+	provider log.Provider
+
+	notify   chan struct{}
+	closing  chan struct{}
+	closed   chan struct{}
+	closeErr error
+	once     sync.Once
+	mutex    sync.Mutex
+}
+
+// NewConsumer creates a new instance of Consumer which stores its segments
+// inside the given directory and delivers all events to the given delegate.
+// Records which are still pending from a previous run are replayed
+// immediately (if Consumer.Provider is set) or with the first consumed
+// event.
+func NewConsumer(directory string, delegate consumer.Consumer, customizer ...func(*Consumer)) (*Consumer, error) {
+	result := &Consumer{
+		Delegate: delegate,
+		notify:   make(chan struct{}, 1),
+		closing:  make(chan struct{}),
+		closed:   make(chan struct{}),
+	}
+	for _, c := range customizer {
+		c(result)
+	}
+	j, err := openJournal(directory, result.getSegmentSize(), result.getMaxBytes(), result.Synchronous)
+	if err != nil {
+		return nil, fmt.Errorf("cannot open write-ahead log in %s: %w", directory, err)
+	}
+	result.journal = j
+	result.provider = result.Provider
+	go result.run()
+	result.signal()
+	return result, nil
+}
+
+// Consume implements consumer.Consumer#Consume()
+func (instance *Consumer) Consume(event log.Event, source log.CoreLogger) {
+	if event == nil {
+		return
+	}
+
+	if !source.IsLevelEnabled(event.GetLevel()) {
+		return
+	}
+
+	if err := instance.append(event, source); err != nil {
+		instance.onError(err)
+	}
+}
+
+func (instance *Consumer) append(event log.Event, source log.CoreLogger) error {
+	payload, err := encodeRecord(event, source)
+	if err != nil {
+		return err
+	}
+
+	evicted, err := instance.appendPayload(payload, source.GetProvider())
+	if evicted > 0 {
+		instance.onError(fmt.Errorf("%w: %d bytes exceeded the maximum of %d bytes", ErrEvicted, evicted, instance.getMaxBytes()))
+	}
+	if err != nil {
+		return fmt.Errorf("cannot write event (%v): %w", event, err)
+	}
+	instance.signal()
+	return nil
+}
+
+func (instance *Consumer) appendPayload(payload []byte, using log.Provider) (int64, error) {
+	instance.mutex.Lock()
+	defer instance.mutex.Unlock()
+	if instance.journal == nil {
+		return 0, ErrClosed
+	}
+	if instance.provider == nil {
+		instance.provider = using
+	}
+	return instance.journal.append(payload)
+}
+
+func (instance *Consumer) signal() {
+	select {
+	case instance.notify <- struct{}{}:
+	default:
+	}
+}
+
+// Close persists the position of the last delivered record and releases all
+// files. Before, all pending records are delivered as long as the Delegate
+// accepts them; remaining records are replayed after the next start.
+func (instance *Consumer) Close() error {
+	instance.once.Do(func() {
+		close(instance.closing)
+		<-instance.closed
+
+		instance.mutex.Lock()
+		defer instance.mutex.Unlock()
+		instance.closeErr = instance.journal.close()
+		instance.journal = nil
+	})
+	return instance.closeErr
+}
+
+func (instance *Consumer) run() {
+	defer close(instance.closed)
+
+	var backoff time.Duration
+	undelivered := 0
+	for {
+		delivered, err := instance.deliverNext()
+		if delivered {
+			backoff = 0
+			undelivered++
+			if undelivered >= instance.getCheckpointInterval() {
+				instance.checkpoint()
+				undelivered = 0
+			}
+			continue
+		}
+		if err != nil && err != io.EOF {
+			instance.onError(err)
+		}
+		if undelivered > 0 {
+			instance.checkpoint()
+			undelivered = 0
+		}
+
+		if err != nil && err != io.EOF {
+			backoff = instance.nextBackoff(backoff)
+			timer := time.NewTimer(backoff)
+			select {
+			case <-timer.C:
+				continue
+			case <-instance.closing:
+				timer.Stop()
+				return
+			}
+		}
+
+		select {
+		case <-instance.notify:
+		case <-instance.closing:
+			instance.drain()
+			return
+		}
+	}
+}
+
+// drain delivers all pending records until the first one fails.
+func (instance *Consumer) drain() {
+	for {
+		if delivered, _ := instance.deliverNext(); !delivered {
+			return
+		}
+	}
+}
+
+// deliverNext delivers the next record to the Delegate. It returns io.EOF if
+// there is nothing to deliver.
+func (instance *Consumer) deliverNext() (bool, error) {
+	instance.mutex.Lock()
+	using := instance.provider
+	if using == nil {
+		instance.mutex.Unlock()
+		return false, io.EOF
+	}
+	payload, next, err := instance.journal.peek()
+	instance.mutex.Unlock()
+	if errors.Is(err, ErrCorrupt) {
+		// The rest of the affected segment was already skipped by peek().
+		instance.onError(err)
+		return true, nil
+	}
+	if err != nil {
+		return false, err
+	}
+
+	r, values, err := decodeRecord(payload)
+	if err == nil {
+		event, source := newEvent(r, values, using)
+		if err = instance.deliver(event, source); err != nil {
+			// Keep the position, so that this record is delivered again.
+			return false, err
+		}
+	} else {
+		// Records which can not be decoded will never succeed; skip them.
+		instance.onError(fmt.Errorf("%w: %v", ErrCorrupt, err))
+	}
+
+	instance.mutex.Lock()
+	instance.journal.advance(next)
+	instance.mutex.Unlock()
+	return true, nil
+}
+
+func (instance *Consumer) deliver(event log.Event, source log.CoreLogger) (err error) {
+	defer func() {
+		if r := recover(); r != nil {
+			err = fmt.Errorf("%w: %v", ErrDeliveryFailed, r)
+		}
+	}()
+	if c, ok := instance.Delegate.(CheckedConsumer); ok {
+		if err := c.ConsumeChecked(event, source); err != nil {
+			return fmt.Errorf("%w: %v", ErrDeliveryFailed, err)
+		}
+		return nil
+	}
+	instance.Delegate.Consume(event, source)
+	return nil
+}
+
+func (instance *Consumer) checkpoint() {
+	instance.mutex.Lock()
+	defer instance.mutex.Unlock()
+	if err := instance.journal.checkpoint(); err != nil {
+		instance.onError(fmt.Errorf("cannot write checkpoint: %w", err))
+	}
+}
+
+func (instance *Consumer) nextBackoff(current time.Duration) time.Duration {
+	if current <= 0 {
+		return instance.getMinBackoff()
+	}
+	if current *= 2; current > instance.getMaxBackoff() {
+		return instance.getMaxBackoff()
+	}
+	return current
+}
+
+func (instance *Consumer) onError(err error) {
+	if v := instance.OnError; v != nil {
+		v(instance, err)
+	}
+}
+
+func (instance *Consumer) getMaxBytes() int64 {
+	if v := instance.MaxBytes; v > 0 {
+		return v
+	}
+	return DefaultMaxBytes
+}
+
+func (instance *Consumer) getSegmentSize() int64 {
+	if v := instance.SegmentSize; v > 0 {
+		return v
+	}
+	return DefaultSegmentSize
+}
+
+func (instance *Consumer) getCheckpointInterval() int {
+	if v := instance.CheckpointInterval; v > 0 {
+		return v
+	}
+	return DefaultCheckpointInterval
+}
+
+func (instance *Consumer) getMinBackoff() time.Duration {
+	if v := instance.MinBackoff; v > 0 {
+		return v
+	}
+	return DefaultMinBackoff
+}
+
+func (instance *Consumer) getMaxBackoff() time.Duration {
+	if v := instance.MaxBackoff; v > 0 {
+		return v
+	}
+	return DefaultMaxBackoff
+}
@@ -0,0 +1,420 @@
+package wal
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sync"
+	"testing"
+	"time"
+
+	log "github.com/echocat/slf4g"
+	"github.com/echocat/slf4g/internal/test/assert"
+	"github.com/echocat/slf4g/native"
+	"github.com/echocat/slf4g/native/consumer"
+	"github.com/echocat/slf4g/native/location"
+)
+
+var givenEventTimestamp = time.Date(2021, 1, 2, 13, 14, 15, 123400000, time.UTC)
+
+func Test_Consumer_Consume(t *testing.T) {
+	delegate := newTestDelegate(0)
+	instance, err := NewConsumer(t.TempDir(), delegate)
+	assert.ToBeNoError(t, err)
+	provider := newProvider(instance)
+
+	provider.GetLogger("foo").Info("first")
+	provider.GetLogger("foo").With("user", "bar").With("count", 42).Warn("second")
+	provider.GetRootLogger().Error("third")
+	delegate.await(3)
+	assert.ToBeNoError(t, instance.Close())
+
+	assert.ToBeEqual(t, []string{
+		"3000 foo 2021-01-02T13:14:15.1234Z first",
+		"4000 foo 2021-01-02T13:14:15.1234Z second count=42 user=bar",
+		"5000 ROOT 2021-01-02T13:14:15.1234Z third",
+	}, delegate.get())
+}
+
+func Test_Consumer_Consume_retries(t *testing.T) {
+	delegate := newTestDelegate(2)
+	errs := &errorRecorder{}
+	instance, err := NewConsumer(t.TempDir(), delegate, func(v *Consumer) {
+		v.MinBackoff = time.Millisecond
+		v.OnError = errs.record
+	})
+	assert.ToBeNoError(t, err)
+	provider := newProvider(instance)
+
+	provider.GetLogger("foo").Info("first")
+	provider.GetLogger("foo").Info("second")
+	delegate.await(2)
+	assert.ToBeNoError(t, instance.Close())
+
+	assert.ToBeEqual(t, []string{
+		"3000 foo 2021-01-02T13:14:15.1234Z first",
+		"3000 foo 2021-01-02T13:14:15.1234Z second",
+	}, delegate.get())
+	assert.ToBeEqual(t, 2, errs.len())
+	assert.ToBeEqual(t, true, errors.Is(errs.get(0), ErrDeliveryFailed))
+}
+
+func Test_Consumer_Consume_retriesOnPanic(t *testing.T) {
+	delegate := newTestDelegate(1)
+	instance, err := NewConsumer(t.TempDir(), consumer.Func(func(event log.Event, source log.CoreLogger) {
+		if err := delegate.ConsumeChecked(event, source); err != nil {
+			panic(err)
+		}
+	}), func(v *Consumer) {
+		v.MinBackoff = time.Millisecond
+	})
+	assert.ToBeNoError(t, err)
+	provider := newProvider(instance)
+
+	provider.GetLogger("foo").Info("first")
+	delegate.await(1)
+	assert.ToBeNoError(t, instance.Close())
+
+	assert.ToBeEqual(t, []string{
+		"3000 foo 2021-01-02T13:14:15.1234Z first",
+	}, delegate.get())
+}
+
+func Test_Consumer_Consume_survivesRestart(t *testing.T) {
+	directory := t.TempDir()
+
+	failing := newTestDelegate(-1)
+	first, err := NewConsumer(directory, failing, func(v *Consumer) {
+		v.MinBackoff = time.Hour
+	})
+	assert.ToBeNoError(t, err)
+	provider := newProvider(first)
+	provider.GetLogger("foo").Info("first")
+	provider.GetLogger("bar").Warn("second")
+	provider.GetLogger("foo").Info("third")
+	assert.ToBeNoError(t, first.Close())
+	assert.ToBeEqual(t, 0, len(failing.get()))
+
+	delegate := newTestDelegate(0)
+	second, err := NewConsumer(directory, delegate, func(v *Consumer) {
+		v.Provider = newProvider(consumer.Noop())
+	})
+	assert.ToBeNoError(t, err)
+	delegate.await(3)
+	assert.ToBeNoError(t, second.Close())
+
+	assert.ToBeEqual(t, []string{
+		"3000 foo 2021-01-02T13:14:15.1234Z first",
+		"4000 bar 2021-01-02T13:14:15.1234Z second",
+		"3000 foo 2021-01-02T13:14:15.1234Z third",
+	}, delegate.get())
+}
+
+func Test_Consumer_Consume_doesNotReplayDelivered(t *testing.T) {
+	directory := t.TempDir()
+
+	delegate := newTestDelegate(0)
+	first, err := NewConsumer(directory, delegate)
+	assert.ToBeNoError(t, err)
+	provider := newProvider(first)
+	provider.GetLogger("foo").Info("first")
+	delegate.await(1)
+	assert.ToBeNoError(t, first.Close())
+
+	second, err := NewConsumer(directory, delegate)
+	assert.ToBeNoError(t, err)
+	provider = newProvider(second)
+	provider.GetLogger("foo").Info("second")
+	delegate.await(2)
+	assert.ToBeNoError(t, second.Close())
+
+	assert.ToBeEqual(t, []string{
+		"3000 foo 2021-01-02T13:14:15.1234Z first",
+		"3000 foo 2021-01-02T13:14:15.1234Z second",
+	}, delegate.get())
+}
+
+func Test_Consumer_Consume_truncatesTornRecord(t *testing.T) {
+	directory := t.TempDir()
+
+	first, err := NewConsumer(directory, newTestDelegate(-1), func(v *Consumer) {
+		v.MinBackoff = time.Hour
+	})
+	assert.ToBeNoError(t, err)
+	newProvider(first).GetLogger("foo").Info("first")
+	assert.ToBeNoError(t, first.Close())
+
+	// Simulate a crash while the next record was written...
+	f, err := os.OpenFile(filepath.Join(directory, fmt.Sprintf("%020d.wal", 1)), os.O_WRONLY|os.O_APPEND, 0600)
+	assert.ToBeNoError(t, err)
+	_, err = f.Write([]byte{0, 0, 0, 42, 1, 2, 3, 4, '{', '"'})
+	assert.ToBeNoError(t, err)
+	assert.ToBeNoError(t, f.Close())
+
+	delegate := newTestDelegate(0)
+	second, err := NewConsumer(directory, delegate)
+	assert.ToBeNoError(t, err)
+	newProvider(second).GetLogger("foo").Info("second")
+	delegate.await(2)
+	assert.ToBeNoError(t, second.Close())
+
+	assert.ToBeEqual(t, []string{
+		"3000 foo 2021-01-02T13:14:15.1234Z first",
+		"3000 foo 2021-01-02T13:14:15.1234Z second",
+	}, delegate.get())
+}
+
+func Test_Consumer_Consume_truncatesBrokenTail(t *testing.T) {
+	cases := []struct {
+		name string
+		tail []byte
+	}{
+		{"zeros", make([]byte, 4096)},
+		{"garbageLength", []byte{0xff, 0xff, 0xff, 0xf0, 1, 2, 3, 4, '{', '"'}},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			directory := t.TempDir()
+			name := filepath.Join(directory, fmt.Sprintf("%020d.wal", 1))
+
+			first, err := NewConsumer(directory, newTestDelegate(-1), func(v *Consumer) {
+				v.MinBackoff = time.Hour
+			})
+			assert.ToBeNoError(t, err)
+			newProvider(first).GetLogger("foo").Info("first")
+			assert.ToBeNoError(t, first.Close())
+			fi, err := os.Stat(name)
+			assert.ToBeNoError(t, err)
+			expectedSize := fi.Size()
+
+			f, err := os.OpenFile(name, os.O_WRONLY|os.O_APPEND, 0600)
+			assert.ToBeNoError(t, err)
+			_, err = f.Write(c.tail)
+			assert.ToBeNoError(t, err)
+			assert.ToBeNoError(t, f.Close())
+
+			delegate := newTestDelegate(0)
+			errs := &errorRecorder{}
+			second, err := NewConsumer(directory, delegate, func(v *Consumer) {
+				v.Provider = newProvider(consumer.Noop())
+				v.OnError = errs.record
+			})
+			assert.ToBeNoError(t, err)
+			fi, err = os.Stat(name)
+			assert.ToBeNoError(t, err)
+			assert.ToBeEqual(t, expectedSize, fi.Size())
+
+			newProvider(second).GetLogger("foo").Info("second")
+			delegate.await(2)
+			assert.ToBeNoError(t, second.Close())
+
+			assert.ToBeEqual(t, []string{
+				"3000 foo 2021-01-02T13:14:15.1234Z first",
+				"3000 foo 2021-01-02T13:14:15.1234Z second",
+			}, delegate.get())
+			assert.ToBeEqual(t, 0, errs.len())
+		})
+	}
+}
+
+func Test_readRecord_rejectsIllegalLength(t *testing.T) {
+	cases := []struct {
+		name     string
+		given    []byte
+		expected string
+	}{
+		{"empty", make([]byte, 16), `^empty record$`},
+		{"tooLong", []byte{0, 0, 0, 9, 0, 0, 0, 0, 1, 2, 3, 4, 5, 6, 7, 8}, `^record length 9 exceeds segment$`},
+		{"huge", []byte{0xff, 0xff, 0xff, 0xff, 0, 0, 0, 0}, `^record length 4294967295 exceeds segment$`},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			_, _, actual := readRecord(bytes.NewReader(c.given), 0, int64(len(c.given)))
+
+			assert.ToBeMatching(t, c.expected, actual)
+		})
+	}
+}
+
+func Test_Consumer_Consume_skipsCorruptRecord(t *testing.T) {
+	directory := t.TempDir()
+
+	first, err := NewConsumer(directory, newTestDelegate(-1), func(v *Consumer) {
+		v.MinBackoff = time.Hour
+		v.SegmentSize = 1
+	})
+	assert.ToBeNoError(t, err)
+	provider := newProvider(first)
+	provider.GetLogger("foo").Info("first")
+	provider.GetLogger("foo").Info("second")
+	assert.ToBeNoError(t, first.Close())
+
+	// Flip one byte of the payload of the first record...
+	name := filepath.Join(directory, fmt.Sprintf("%020d.wal", 1))
+	content, err := os.ReadFile(name)
+	assert.ToBeNoError(t, err)
+	content[recordHeader+2] ^= 0xff
+	assert.ToBeNoError(t, os.WriteFile(name, content, 0600))
+
+	delegate := newTestDelegate(0)
+	errs := &errorRecorder{}
+	second, err := NewConsumer(directory, delegate, func(v *Consumer) {
+		v.Provider = newProvider(consumer.Noop())
+		v.OnError = errs.record
+	})
+	assert.ToBeNoError(t, err)
+	delegate.await(1)
+	assert.ToBeNoError(t, second.Close())
+
+	assert.ToBeEqual(t, []string{
+		"3000 foo 2021-01-02T13:14:15.1234Z second",
+	}, delegate.get())
+	assert.ToBeEqual(t, 1, errs.len())
+	assert.ToBeEqual(t, true, errors.Is(errs.get(0), ErrCorrupt))
+}
+
+func Test_Consumer_Consume_evictsOldest(t *testing.T) {
+	directory := t.TempDir()
+
+	errs := &errorRecorder{}
+	first, err := NewConsumer(directory, newTestDelegate(-1), func(v *Consumer) {
+		v.MinBackoff = time.Hour
+		v.SegmentSize = 1
+		v.MaxBytes = 250
+		v.OnError = errs.record
+	})
+	assert.ToBeNoError(t, err)
+	provider := newProvider(first)
+	for i := 1; i <= 5; i++ {
+		provider.GetLogger("foo").Infof("message %d", i)
+	}
+	assert.ToBeNoError(t, first.Close())
+
+	assert.ToBeEqual(t, true, errs.len() > 0)
+	assert.ToBeEqual(t, true, errors.Is(errs.get(0), ErrEvicted))
+
+	delegate := newTestDelegate(0)
+	second, err := NewConsumer(directory, delegate, func(v *Consumer) {
+		v.Provider = newProvider(consumer.Noop())
+	})
+	assert.ToBeNoError(t, err)
+	delegate.await(2)
+	assert.ToBeNoError(t, second.Close())
+
+	assert.ToBeEqual(t, []string{
+		"3000 foo 2021-01-02T13:14:15.1234Z message 4",
+		"3000 foo 2021-01-02T13:14:15.1234Z message 5",
+	}, delegate.get())
+}
+
+func Test_Consumer_Consume_afterClose(t *testing.T) {
+	errs := &errorRecorder{}
+	instance, err := NewConsumer(t.TempDir(), newTestDelegate(0), func(v *Consumer) {
+		v.OnError = errs.record
+	})
+	assert.ToBeNoError(t, err)
+	assert.ToBeNoError(t, instance.Close())
+
+	newProvider(instance).GetLogger("foo").Info("first")
+
+	assert.ToBeEqual(t, 1, errs.len())
+	assert.ToBeEqual(t, true, errors.Is(errs.get(0), ErrClosed))
+}
+
+func newProvider(c consumer.Consumer) *native.Provider {
+	return &native.Provider{
+		LocationDiscovery: location.NoopDiscovery(),
+		CoreLoggerCustomizer: func(_ *native.Provider, cl *native.CoreLogger) log.CoreLogger {
+			cl.Consumer = consumer.Func(func(event log.Event, source log.CoreLogger) {
+				c.Consume(event.With("timestamp", givenEventTimestamp), source)
+			})
+			return cl
+		},
+	}
+}
+
+// testDelegate is a CheckedConsumer which records all consumed events in a
+// simple textual form. The first attempts (see failures) will fail; if
+// negative all attempts will fail.
+type testDelegate struct {
+	failures int
+	events   []string
+	mutex    sync.Mutex
+	cond     *sync.Cond
+}
+
+func newTestDelegate(failures int) *testDelegate {
+	result := &testDelegate{failures: failures}
+	result.cond = sync.NewCond(&result.mutex)
+	return result
+}
+
+func (instance *testDelegate) Consume(event log.Event, source log.CoreLogger) {
+	_ = instance.ConsumeChecked(event, source)
+}
+
+func (instance *testDelegate) ConsumeChecked(event log.Event, source log.CoreLogger) error {
+	instance.mutex.Lock()
+	defer instance.mutex.Unlock()
+	if instance.failures != 0 {
+		instance.failures--
+		return errors.New("expected")
+	}
+
+	provider := source.GetProvider()
+	formatted := fmt.Sprintf("%d %s %s %v",
+		event.GetLevel(),
+		source.GetName(),
+		log.GetTimestampOf(event, provider).Format(time.RFC3339Nano),
+		*log.GetMessageOf(event, provider),
+	)
+	for _, k := range []string{"count", "user"} {
+		if v, ok := event.Get(k); ok {
+			formatted += fmt.Sprintf(" %s=%v", k, v)
+		}
+	}
+	instance.events = append(instance.events, formatted)
+	instance.cond.Broadcast()
+	return nil
+}
+
+func (instance *testDelegate) await(n int) {
+	instance.mutex.Lock()
+	defer instance.mutex.Unlock()
+	for len(instance.events) < n {
+		instance.cond.Wait()
+	}
+}
+
+func (instance *testDelegate) get() []string {
+	instance.mutex.Lock()
+	defer instance.mutex.Unlock()
+	return append([]string{}, instance.events...)
+}
+
+type errorRecorder struct {
+	errs  []error
+	mutex sync.Mutex
+}
+
+func (instance *errorRecorder) record(_ *Consumer, err error) {
+	instance.mutex.Lock()
+	defer instance.mutex.Unlock()
+	instance.errs = append(instance.errs, err)
+}
+
+func (instance *errorRecorder) len() int {
+	instance.mutex.Lock()
+	defer instance.mutex.Unlock()
+	return len(instance.errs)
+}
+
+func (instance *errorRecorder) get(i int) error {
+	instance.mutex.Lock()
+	defer instance.mutex.Unlock()
+	return instance.errs[i]
+}
@@ -0,0 +1,7 @@
+// Package wal provides a consumer.Consumer which writes all consumed log
+// events ahead into a crash-safe, disk-backed buffer (write-ahead log) before
+// they are handed over to the actual consumer. If this consumer is
+// unavailable (for example a network consumer) the events are kept on disk
+// and replayed in order as soon as it recovers; also after a restart of the
+// application.
+package wal
@@ -0,0 +1,349 @@
+package wal
+
+import (
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"hash/crc32"
+	"io"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+const (
+	segmentSuffix  = ".wal"
+	checkpointName = "checkpoint"
+	recordHeader   = 8
+)
+
+var crcTable = crc32.MakeTable(crc32.Castagnoli)
+
+// ErrCorrupt is reported (see Consumer.OnError) if a record is corrupt. The
+// rest of the affected segment is skipped.
+var ErrCorrupt = errors.New("corrupt record")
+
+// position points to a record (or the end of a segment) within a journal.
+type position struct {
+	segment uint64
+	offset  int64
+}
+
+// journal is a sequence of segment files in one directory which contains
+// records. Each record consists of the length (4 bytes), the CRC-32C checksum
+// of the payload (4 bytes) and the payload itself.
+type journal struct {
+	directory   string
+	segmentSize int64
+	maxBytes    int64
+	sync        bool
+
+	segments []uint64
+	sizes    map[uint64]int64
+	writer   *os.File
+
+	read   position
+	reader *os.File
+}
+
+func openJournal(directory string, segmentSize, maxBytes int64, sync bool) (*journal, error) {
+	if err := os.MkdirAll(directory, 0700); err != nil {
+		return nil, err
+	}
+	result := &journal{
+		directory:   directory,
+		segmentSize: segmentSize,
+		maxBytes:    maxBytes,
+		sync:        sync,
+		sizes:       map[uint64]int64{},
+	}
+	if err := result.load(); err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+func (instance *journal) load() error {
+	entries, err := os.ReadDir(instance.directory)
+	if err != nil {
+		return err
+	}
+	for _, entry := range entries {
+		name := entry.Name()
+		if entry.IsDir() || !strings.HasSuffix(name, segmentSuffix) {
+			continue
+		}
+		id, err := strconv.ParseUint(strings.TrimSuffix(name, segmentSuffix), 10, 64)
+		if err != nil {
+			continue
+		}
+		fi, err := entry.Info()
+		if err != nil {
+			return err
+		}
+		instance.segments = append(instance.segments, id)
+		instance.sizes[id] = fi.Size()
+	}
+	sort.Slice(instance.segments, func(i, j int) bool {
+		return instance.segments[i] < instance.segments[j]
+	})
+
+	if len(instance.segments) == 0 {
+		if err := instance.createSegment(1); err != nil {
+			return err
+		}
+	} else if err := instance.recoverLastSegment(); err != nil {
+		return err
+	}
+
+	instance.read = position{segment: instance.segments[0]}
+	if cp, err := instance.loadCheckpoint(); err != nil {
+		return err
+	} else if _, ok := instance.sizes[cp.segment]; ok && cp.offset <= instance.sizes[cp.segment] {
+		instance.read = cp
+	}
+	return nil
+}
+
+// recoverLastSegment truncates the last segment after the last valid record;
+// for example if the process crashed while writing it.
+func (instance *journal) recoverLastSegment() error {
+	id := instance.segments[len(instance.segments)-1]
+	f, err := os.OpenFile(instance.segmentFile(id), os.O_RDWR, 0600)
+	if err != nil {
+		return err
+	}
+	var offset int64
+	for {
+		_, next, err := readRecord(f, offset, instance.sizes[id])
+		if err != nil {
+			break
+		}
+		offset = next
+	}
+	if offset < instance.sizes[id] {
+		if err := f.Truncate(offset); err != nil {
+			_ = f.Close()
+			return err
+		}
+		instance.sizes[id] = offset
+	}
+	if _, err := f.Seek(offset, io.SeekStart); err != nil {
+		_ = f.Close()
+		return err
+	}
+	instance.writer = f
+	return nil
+}
+
+func (instance *journal) createSegment(id uint64) error {
+	f, err := os.OpenFile(instance.segmentFile(id), os.O_CREATE|os.O_EXCL|os.O_WRONLY, 0600)
+	if err != nil {
+		return err
+	}
+	instance.segments = append(instance.segments, id)
+	instance.sizes[id] = 0
+	instance.writer = f
+	return nil
+}
+
+// append appends the given payload as new record. It returns the amount of
+// bytes of records which were evicted to keep the journal below maxBytes.
+func (instance *journal) append(payload []byte) (evicted int64, err error) {
+	last := instance.segments[len(instance.segments)-1]
+	size := int64(recordHeader + len(payload))
+	if instance.sizes[last] > 0 && instance.sizes[last]+size > instance.segmentSize {
+		if err := instance.rotate(); err != nil {
+			return 0, err
+		}
+		last = instance.segments[len(instance.segments)-1]
+	}
+
+	record := make([]byte, size)
+	binary.BigEndian.PutUint32(record[0:4], uint32(len(payload)))
+	binary.BigEndian.PutUint32(record[4:8], crc32.Checksum(payload, crcTable))
+	copy(record[recordHeader:], payload)
+	if _, err := instance.writer.Write(record); err != nil {
+		return 0, err
+	}
+	instance.sizes[last] += size
+	if instance.sync {
+		if err := instance.writer.Sync(); err != nil {
+			return 0, err
+		}
+	}
+	return instance.evictIfRequired()
+}
+
+func (instance *journal) rotate() error {
+	if err := instance.writer.Sync(); err != nil {
+		return err
+	}
+	if err := instance.writer.Close(); err != nil {
+		return err
+	}
+	return instance.createSegment(instance.segments[len(instance.segments)-1] + 1)
+}
+
+// evictIfRequired removes the oldest segments (except the one which is
+// currently written) as long as the journal is bigger than maxBytes.
+func (instance *journal) evictIfRequired() (evicted int64, err error) {
+	if instance.maxBytes <= 0 {
+		return 0, nil
+	}
+	for len(instance.segments) > 1 && instance.totalBytes() > instance.maxBytes {
+		oldest := instance.segments[0]
+		evicted += instance.sizes[oldest]
+		if instance.read.segment == oldest {
+			evicted -= instance.read.offset
+			instance.read = position{segment: instance.segments[1]}
+		}
+		if err := instance.removeSegment(oldest); err != nil {
+			return evicted, err
+		}
+	}
+	return evicted, nil
+}
+
+func (instance *journal) totalBytes() (result int64) {
+	for _, size := range instance.sizes {
+		result += size
+	}
+	return result
+}
+
+func (instance *journal) removeSegment(id uint64) error {
+	if instance.reader != nil && filepath.Base(instance.reader.Name()) == filepath.Base(instance.segmentFile(id)) {
+		_ = instance.reader.Close()
+		instance.reader = nil
+	}
+	instance.segments = instance.segments[1:]
+	delete(instance.sizes, id)
+	return os.Remove(instance.segmentFile(id))
+}
+
+// peek returns the payload of the next record to read and the position after
+// it. It returns io.EOF if there is no record to read.
+func (instance *journal) peek() ([]byte, position, error) {
+	for {
+		if instance.read.offset < instance.sizes[instance.read.segment] {
+			if err := instance.openReader(); err != nil {
+				return nil, position{}, err
+			}
+			payload, next, err := readRecord(instance.reader, instance.read.offset, instance.sizes[instance.read.segment])
+			if err != nil {
+				// The rest of this segment can not be read; skip it.
+				instance.read.offset = instance.sizes[instance.read.segment]
+				return nil, position{}, fmt.Errorf("%w in %s at offset %d: %v", ErrCorrupt, instance.segmentFile(instance.read.segment), instance.read.offset, err)
+			}
+			return payload, position{instance.read.segment, next}, nil
+		}
+		if instance.read.segment == instance.segments[len(instance.segments)-1] {
+			return nil, position{}, io.EOF
+		}
+		// This segment was read completely and is not required anymore.
+		consumed := instance.read.segment
+		instance.read = position{segment: instance.segments[1]}
+		if err := instance.removeSegment(consumed); err != nil {
+			return nil, position{}, err
+		}
+	}
+}
+
+// advance moves the read position to the given one (as returned by peek).
+func (instance *journal) advance(to position) {
+	if _, ok := instance.sizes[to.segment]; ok {
+		instance.read = to
+	}
+}
+
+func (instance *journal) openReader() error {
+	name := instance.segmentFile(instance.read.segment)
+	if instance.reader != nil && instance.reader.Name() == name {
+		return nil
+	}
+	if instance.reader != nil {
+		_ = instance.reader.Close()
+	}
+	f, err := os.Open(name)
+	if err != nil {
+		return err
+	}
+	instance.reader = f
+	return nil
+}
+
+// readRecord reads the record at the given offset of a segment with the given
+// size.
+func readRecord(from io.ReaderAt, offset, size int64) ([]byte, int64, error) {
+	var header [recordHeader]byte
+	if _, err := from.ReadAt(header[:], offset); err != nil {
+		return nil, 0, err
+	}
+	// Records are never empty. A zero length is usually a zero-filled tail
+	// of a segment (after a crash), and a length beyond the segment is
+	// garbage; both must not be read (or even allocated).
+	length := int64(binary.BigEndian.Uint32(header[0:4]))
+	if length == 0 {
+		return nil, 0, errors.New("empty record")
+	}
+	if length > size-offset-recordHeader {
+		return nil, 0, fmt.Errorf("record length %d exceeds segment", length)
+	}
+	payload := make([]byte, length)
+	if _, err := from.ReadAt(payload, offset+recordHeader); err != nil {
+		return nil, 0, err
+	}
+	if crc32.Checksum(payload, crcTable) != binary.BigEndian.Uint32(header[4:8]) {
+		return nil, 0, errors.New("checksum mismatch")
+	}
+	return payload, offset + recordHeader + int64(len(payload)), nil
+}
+
+// checkpoint persists the current read position.
+func (instance *journal) checkpoint() error {
+	tmp := filepath.Join(instance.directory, checkpointName+".tmp")
+	content := fmt.Sprintf("%d %d\n", instance.read.segment, instance.read.offset)
+	if err := os.WriteFile(tmp, []byte(content), 0600); err != nil {
+		return err
+	}
+	return os.Rename(tmp, filepath.Join(instance.directory, checkpointName))
+}
+
+func (instance *journal) loadCheckpoint() (position, error) {
+	content, err := os.ReadFile(filepath.Join(instance.directory, checkpointName))
+	if os.IsNotExist(err) {
+		return position{}, nil
+	}
+	if err != nil {
+		return position{}, err
+	}
+	var result position
+	if _, err := fmt.Sscanf(string(content), "%d %d", &result.segment, &result.offset); err != nil {
+		// A broken checkpoint is not fatal; we just start from the beginning.
+		return position{}, nil
+	}
+	return result, nil
+}
+
+func (instance *journal) close() error {
+	var errs []error
+	if err := instance.checkpoint(); err != nil {
+		errs = append(errs, err)
+	}
+	if instance.reader != nil {
+		errs = append(errs, instance.reader.Close())
+		instance.reader = nil
+	}
+	if instance.writer != nil {
+		errs = append(errs, instance.writer.Sync(), instance.writer.Close())
+		instance.writer = nil
+	}
+	return errors.Join(errs...)
+}
+
+func (instance *journal) segmentFile(id uint64) string {
+	return filepath.Join(instance.directory, fmt.Sprintf("%020d%s", id, segmentSuffix))
+}
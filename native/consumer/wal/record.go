@@ -0,0 +1,94 @@
+package wal
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	log "github.com/echocat/slf4g"
+	"github.com/echocat/slf4g/fields"
+	"github.com/echocat/slf4g/level"
+	"github.com/echocat/slf4g/native/formatter/encoding"
+)
+
+// record is the persisted form of an event. All fields are stored as JSON;
+// this means that complex values are replayed in their JSON form (for
+// example structs as maps and numbers as json.Number). Only the timestamp is
+// restored as time.Time.
+type record struct {
+	Level     level.Level                `json:"level"`
+	Logger    string                     `json:"logger"`
+	Timestamp *time.Time                 `json:"timestamp,omitempty"`
+	Fields    map[string]json.RawMessage `json:"fields,omitempty"`
+}
+
+func encodeRecord(event log.Event, source log.CoreLogger) ([]byte, error) {
+	using := source.GetProvider()
+	timestampKey := using.GetFieldKeysSpec().GetTimestamp()
+
+	result := record{
+		Level:     event.GetLevel(),
+		Logger:    source.GetName(),
+		Timestamp: log.GetTimestampOf(event, using),
+		Fields:    map[string]json.RawMessage{},
+	}
+	if err := event.ForEach(func(k string, v interface{}) error {
+		if k == timestampKey {
+			return nil
+		}
+		if vl, ok := v.(fields.Filtered); ok {
+			fv, shouldBeRespected := vl.Filter(event)
+			if !shouldBeRespected {
+				return nil
+			}
+			v = fv
+		} else if vl, ok := v.(fields.Lazy); ok {
+			v = vl.Get()
+		}
+		if v == fields.Exclude {
+			return nil
+		}
+		enc := encoding.NewBufferedJsonEncoder()
+		if err := enc.WriteValue(v); err != nil {
+			return fmt.Errorf("cannot encode field %q: %w", k, err)
+		}
+		result.Fields[k] = enc.Bytes()
+		return nil
+	}); err != nil {
+		return nil, fmt.Errorf("cannot encode event (%v): %w", event, err)
+	}
+
+	return json.Marshal(result)
+}
+
+func decodeRecord(payload []byte) (*record, map[string]interface{}, error) {
+	var result record
+	if err := json.Unmarshal(payload, &result); err != nil {
+		return nil, nil, err
+	}
+	values := make(map[string]interface{}, len(result.Fields)+1)
+	for k, raw := range result.Fields {
+		decoder := json.NewDecoder(bytes.NewReader(raw))
+		decoder.UseNumber()
+		var v interface{}
+		if err := decoder.Decode(&v); err != nil {
+			return nil, nil, fmt.Errorf("cannot decode field %q: %w", k, err)
+		}
+		values[k] = v
+	}
+	return &result, values, nil
+}
+
+// newEvent creates the event described by the given record using the given
+// provider. It returns also the logger which should be used as source.
+func newEvent(r *record, values map[string]interface{}, using log.Provider) (log.Event, log.CoreLogger) {
+	source := using.GetRootLogger()
+	if r.Logger != source.GetName() {
+		source = using.GetLogger(r.Logger)
+	}
+	if r.Timestamp != nil {
+		values[using.GetFieldKeysSpec().GetTimestamp()] = *r.Timestamp
+	}
+	return source.NewEvent(r.Level, values), source
+}
@@ -32,6 +32,7 @@ type Writer struct {
 	// colorization is supported and demanded or any other stuff. If nothing was
 	// provided a default instance will be provided which provides:
 	// 1. hints.ColorsSupport
+	// 2. hints.ColorDepth
 	HintsProvider func(event log.Event, source log.CoreLogger) hints.Hints
 
 	// Synchronized defines if this instance can be used in concurrent
@@ -51,6 +52,7 @@ type Writer struct {
 
 	out            io.Writer
 	colorSupported *color.Supported
+	colorDepth     color.Depth
 	mutex          sync.Mutex
 }
 
@@ -119,6 +121,7 @@ func (instance *Writer) initIfRequired() {
 		}
 		instance.out = out
 		instance.colorSupported = &supported
+		instance.colorDepth = color.DetectDepth()
 	}
 }
 
@@ -176,3 +179,7 @@ type writingConsumerHints struct {
 func (instance *writingConsumerHints) IsColorSupported() color.Supported {
 	return *instance.colorSupported
 }
+
+func (instance *writingConsumerHints) ColorDepth() color.Depth {
+	return instance.colorDepth
+}
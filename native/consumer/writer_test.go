@@ -6,6 +6,7 @@ import (
 	"io"
 	"testing"
 
+	"github.com/echocat/slf4g/fields"
 	"github.com/echocat/slf4g/level"
 	"github.com/echocat/slf4g/testing/recording"
 
@@ -215,6 +216,55 @@ func Test_Writer_Consume_beforeLog_stops(t *testing.T) {
 	assert.ToBeEqual(t, "", givenOut.String())
 }
 
+func Test_Writer_Consume_beforeLog_stops_withoutFormattingMessage(t *testing.T) {
+	givenOut := new(bytes.Buffer)
+	givenLogger := recording.NewLogger()
+	givenArg := &countingStringer{value: "bar"}
+	givenEvent := givenLogger.NewEvent(level.Info, nil).
+		With("message", fields.LazyFormat("foo%v", givenArg))
+
+	instance := NewWriter(givenOut, func(writer *Writer) {
+		writer.Interceptor = interceptor.OnBeforeLogFunc(func(log.Event, log.Provider) (intercepted log.Event) {
+			return nil
+		})
+	})
+
+	instance.Consume(givenEvent, givenLogger)
+
+	assert.ToBeEqual(t, "", givenOut.String())
+	assert.ToBeEqual(t, 0, givenArg.calls)
+}
+
+func Test_Writer_Consume_formatsMessageOnlyOnce(t *testing.T) {
+	givenOut := new(bytes.Buffer)
+	givenLogger := recording.NewLogger()
+	givenArg := &countingStringer{value: "bar"}
+	givenEvent := givenLogger.NewEvent(level.Info, nil).
+		With("message", fields.LazyFormat("foo%v", givenArg))
+
+	instance := NewWriter(givenOut, func(writer *Writer) {
+		writer.Formatter = formatter.Func(func(event log.Event, provider log.Provider, _ hints.Hints) ([]byte, error) {
+			return []byte(*log.GetMessageOf(event, provider) + "|" + *log.GetMessageOf(event, provider) + "\n"), nil
+		})
+		writer.Interceptor = interceptor.Interceptors{}
+	})
+
+	instance.Consume(givenEvent, givenLogger)
+
+	assert.ToBeEqual(t, "foobar|foobar\n", givenOut.String())
+	assert.ToBeEqual(t, 1, givenArg.calls)
+}
+
+type countingStringer struct {
+	value string
+	calls int
+}
+
+func (instance *countingStringer) String() string {
+	instance.calls++
+	return instance.value
+}
+
 func Test_Writer_Consume_afterLog(t *testing.T) {
 	givenOut := new(bytes.Buffer)
 	givenLogger := recording.NewLogger()
@@ -458,6 +508,7 @@ func Test_Writer_provideHints_default(t *testing.T) {
 	givenSupport := color.Supported(66)
 	instance := NewWriter(givenOut, func(writer *Writer) {
 		writer.colorSupported = &givenSupport
+		writer.colorDepth = color.Depth256
 	})
 
 	actual := instance.provideHints(givenEvent, givenLogger)
@@ -465,4 +516,5 @@ func Test_Writer_provideHints_default(t *testing.T) {
 	assert.ToBeOfType(t, &writingConsumerHints{}, actual)
 	assert.ToBeSame(t, instance, actual.(*writingConsumerHints).Writer)
 	assert.ToBeEqual(t, givenSupport, actual.(*writingConsumerHints).IsColorSupported())
+	assert.ToBeEqual(t, color.Depth256, actual.(*writingConsumerHints).ColorDepth())
 }
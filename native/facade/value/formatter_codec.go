@@ -11,8 +11,11 @@ import (
 // DefaultFormatterCodec is the default instance of FormatterCodec which should cover the
 // most of the cases.
 var DefaultFormatterCodec FormatterCodec = MappingFormatterCodec{
-	"text": formatter.NewText(),
-	"json": formatter.NewJson(),
+	"text":    formatter.NewText(),
+	"json":    formatter.NewJson(),
+	"pretty":  formatter.NewPretty(),
+	"cbor":    formatter.NewCbor(),
+	"msgpack": formatter.NewMessagePack(),
 }
 
 // FormatterCodec transforms strings to formatter.Formatter and other way around.
@@ -20,6 +20,15 @@ func Test_DefaultFormatterCodec_Parse(t *testing.T) {
 	}, {
 		given:    "json",
 		expected: formatter.NewJson(),
+	}, {
+		given:    "pretty",
+		expected: formatter.NewPretty(),
+	}, {
+		given:    "cbor",
+		expected: formatter.NewCbor(),
+	}, {
+		given:    "msgpack",
+		expected: formatter.NewMessagePack(),
 	}}
 
 	for _, c := range cases {
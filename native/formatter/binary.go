@@ -0,0 +1,71 @@
+package formatter
+
+import (
+	"fmt"
+
+	log "github.com/echocat/slf4g"
+	"github.com/echocat/slf4g/fields"
+	"github.com/echocat/slf4g/native/formatter/encoding"
+)
+
+// binaryFormatting contains everything which is shared between the binary
+// formatters Cbor and MessagePack.
+type binaryFormatting struct {
+	levelKey        string
+	levelFormatter  Level
+	printRootLogger bool
+	keySorter       fields.KeySorter
+}
+
+func (instance binaryFormatting) format(event log.Event, using log.Provider, to encoding.BufferedBinaryEncoder) ([]byte, error) {
+	if event == nil {
+		return []byte{}, nil
+	}
+
+	lvl, err := instance.levelFormatter.FormatLevel(event.GetLevel(), using)
+	if err != nil {
+		return nil, fmt.Errorf("cannot format event (%v): %w", event, err)
+	}
+
+	// Binary maps require to know the amount of entries in front, so we have
+	// to collect the entries first.
+	var keys []string
+	var values []interface{}
+	loggerKey := using.GetFieldKeysSpec().GetLogger()
+	if err := fields.SortedForEach(event, instance.keySorter, func(k string, v interface{}) error {
+		if vl, ok := v.(fields.Filtered); ok {
+			fv, shouldBeRespected := vl.Filter(event)
+			if !shouldBeRespected {
+				return nil
+			}
+			v = fv
+		} else if vl, ok := v.(fields.Lazy); ok {
+			v = vl.Get()
+		}
+		if v == fields.Exclude {
+			return nil
+		}
+		if !instance.printRootLogger && k == loggerKey && v == "ROOT" {
+			return nil
+		}
+		keys = append(keys, k)
+		values = append(values, v)
+		return nil
+	}); err != nil {
+		return nil, fmt.Errorf("cannot format event (%v): %w", event, err)
+	}
+
+	if err := to.WriteMapHeader(len(keys) + 1); err != nil {
+		return nil, fmt.Errorf("cannot format event (%v): %w", event, err)
+	}
+	if err := to.WriteKeyValue(instance.levelKey, lvl); err != nil {
+		return nil, fmt.Errorf("cannot format event (%v): %w", event, err)
+	}
+	for i, k := range keys {
+		if err := to.WriteKeyValue(k, values[i]); err != nil {
+			return nil, fmt.Errorf("cannot format event (%v): %w", event, err)
+		}
+	}
+
+	return to.Bytes(), nil
+}
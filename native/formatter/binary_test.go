@@ -0,0 +1,116 @@
+package formatter
+
+import (
+	"encoding/hex"
+	"errors"
+	"testing"
+
+	log "github.com/echocat/slf4g"
+	"github.com/echocat/slf4g/fields"
+	"github.com/echocat/slf4g/internal/test/assert"
+	"github.com/echocat/slf4g/level"
+	"github.com/echocat/slf4g/native/formatter/encoding"
+	"github.com/echocat/slf4g/testing/recording"
+)
+
+func Test_binaryFormatting_format(t *testing.T) {
+	givenProvider := recording.NewProvider()
+	givenLogger := givenProvider.GetRootLogger()
+
+	cases := []struct {
+		name            string
+		given           log.Event
+		printRootLogger bool
+		expected        string
+	}{{
+		name: "withStringAndInteger",
+		given: givenLogger.NewEvent(level.Info, map[string]interface{}{
+			"foo": "foo",
+			"bar": 1,
+		}),
+		// {"level":"INFO","bar":1,"foo":"foo"}
+		expected: "a3" + "656c6576656c" + "64494e464f" + "63626172" + "01" + "63666f6f" + "63666f6f",
+	}, {
+		name: "withLazyFilteredAndExcluded",
+		given: givenLogger.NewEvent(level.Info, map[string]interface{}{
+			"a": aLazy("b"),
+			"c": fields.RequireMaximalLevel(level.Info, "d"),
+			"e": fields.RequireMaximalLevel(level.Debug, "f"),
+			"g": fields.Exclude,
+		}),
+		// {"level":"INFO","a":"b","c":"d"}
+		expected: "a3" + "656c6576656c" + "64494e464f" + "6161" + "6162" + "6163" + "6164",
+	}, {
+		name: "withHiddenRootLogger",
+		given: givenLogger.NewEvent(level.Info, map[string]interface{}{
+			"logger": "ROOT",
+		}),
+		// {"level":"INFO"}
+		expected: "a1" + "656c6576656c" + "64494e464f",
+	}, {
+		name: "withShownRootLogger",
+		given: givenLogger.NewEvent(level.Info, map[string]interface{}{
+			"logger": "ROOT",
+		}),
+		printRootLogger: true,
+		// {"level":"INFO","logger":"ROOT"}
+		expected: "a2" + "656c6576656c" + "64494e464f" + "666c6f67676572" + "64524f4f54",
+	}, {
+		name:     "nilEvent",
+		given:    nil,
+		expected: "",
+	}}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			instance := binaryFormatting{
+				levelKey:        DefaultKeyLevel,
+				levelFormatter:  DefaultLevel,
+				printRootLogger: c.printRootLogger,
+				keySorter:       fields.DefaultKeySorter,
+			}
+
+			actual, actualErr := instance.format(c.given, givenProvider, encoding.NewBufferedCborEncoder())
+
+			assert.ToBeNoError(t, actualErr)
+			assert.ToBeEqual(t, c.expected, hex.EncodeToString(actual))
+		})
+	}
+}
+
+func Test_binaryFormatting_format_failingOnLevel(t *testing.T) {
+	givenProvider := recording.NewProvider()
+	givenEvent := givenProvider.GetRootLogger().NewEvent(level.Info, nil)
+	givenErr := errors.New("expected")
+
+	instance := binaryFormatting{
+		levelKey: DefaultKeyLevel,
+		levelFormatter: LevelFunc(func(level.Level, log.Provider) (interface{}, error) {
+			return nil, givenErr
+		}),
+		keySorter: fields.DefaultKeySorter,
+	}
+
+	actual, actualErr := instance.format(givenEvent, givenProvider, encoding.NewBufferedCborEncoder())
+
+	assert.ToBeMatching(t, "cannot format event .*: expected", actualErr)
+	assert.ToBeNil(t, actual)
+}
+
+func Test_binaryFormatting_format_failingOnValue(t *testing.T) {
+	givenProvider := recording.NewProvider()
+	givenEvent := givenProvider.GetRootLogger().NewEvent(level.Info, map[string]interface{}{
+		"foo": func() {},
+	})
+
+	instance := binaryFormatting{
+		levelKey:       DefaultKeyLevel,
+		levelFormatter: DefaultLevel,
+		keySorter:      fields.DefaultKeySorter,
+	}
+
+	actual, actualErr := instance.format(givenEvent, givenProvider, encoding.NewBufferedCborEncoder())
+
+	assert.ToBeMatching(t, "cannot format event .*: json: unsupported type: func\\(\\)", actualErr)
+	assert.ToBeNil(t, actual)
+}
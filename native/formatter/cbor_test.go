@@ -0,0 +1,41 @@
+package formatter
+
+import (
+	"encoding/hex"
+	"testing"
+	"time"
+
+	"github.com/echocat/slf4g/internal/test/assert"
+	"github.com/echocat/slf4g/level"
+	"github.com/echocat/slf4g/testing/recording"
+)
+
+func Test_NewCbor(t *testing.T) {
+	instance := NewCbor(func(v *Cbor) {
+		v.KeyLevel = "foo"
+	})
+
+	assert.ToBeEqual(t, "foo", instance.KeyLevel)
+	assert.ToBeNil(t, instance.LevelFormatter)
+	assert.ToBeNil(t, instance.PrintRootLogger)
+	assert.ToBeNil(t, instance.KeySorter)
+}
+
+func Test_Cbor_Format(t *testing.T) {
+	givenProvider := recording.NewProvider()
+	givenEvent := givenProvider.GetRootLogger().NewEvent(level.Warn, map[string]interface{}{
+		"timestamp": time.Date(2013, 3, 21, 20, 4, 0, 0, time.UTC),
+		"data":      []byte{1, 2},
+	})
+
+	instance := NewCbor(func(v *Cbor) {
+		v.KeyLevel = "lvl"
+		v.LevelFormatter = NewOrdinalBasedLevel()
+	})
+
+	actual, actualErr := instance.Format(givenEvent, givenProvider, nil)
+
+	assert.ToBeNoError(t, actualErr)
+	// {"lvl":4000,"data":h'0102',"timestamp":1(1363896240)}
+	assert.ToBeEqual(t, "a3"+"636c766c"+"190fa0"+"6464617461"+"420102"+"6974696d657374616d70"+"c11a514b67b0", hex.EncodeToString(actual))
+}
@@ -0,0 +1,267 @@
+package encoding
+
+import (
+	"bytes"
+	gencoding "encoding"
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"sort"
+	"strings"
+	"time"
+	"unicode"
+)
+
+// BinaryEncoder encodes given elements to a binary format like CBOR or
+// MessagePack.
+//
+// Values are encoded using the native types of the format where possible:
+// nil, booleans, integers, floats, strings, []byte (as binary), time.Time (as
+// timestamp), slices, arrays and maps (with keys sorted to be deterministic).
+// Errors are encoded by their message. Every other value (like structs) is
+// encoded by its JSON representation (respecting json.Marshaler and
+// encoding.TextMarshaler) converted into the corresponding native types.
+type BinaryEncoder interface {
+	WriteMapHeader(length int) error
+	WriteMapHeaderChecked(length int) func() error
+
+	WriteArrayHeader(length int) error
+	WriteArrayHeaderChecked(length int) func() error
+
+	// WriteRaw writes the given already encoded element as it is.
+	WriteRaw(p []byte) error
+	WriteRawChecked(p []byte) func() error
+
+	WriteKeyValue(k string, v interface{}) error
+	WriteKeyValueChecked(k string, v interface{}) func() error
+
+	WriteValue(v interface{}) error
+	WriteValueChecked(v interface{}) func() error
+}
+
+type BufferedBinaryEncoder interface {
+	BinaryEncoder
+	Buffered
+}
+
+// binaryFormat is the actual format specific part of a bufferedBinaryEncoder.
+type binaryFormat interface {
+	writeNil(to *bytes.Buffer)
+	writeBool(to *bytes.Buffer, v bool)
+	writeInt(to *bytes.Buffer, v int64)
+	writeUint(to *bytes.Buffer, v uint64)
+	writeFloat32(to *bytes.Buffer, v float32)
+	writeFloat64(to *bytes.Buffer, v float64)
+	writeString(to *bytes.Buffer, v string)
+	writeBinary(to *bytes.Buffer, v []byte)
+	writeTime(to *bytes.Buffer, v time.Time)
+	writeArrayHeader(to *bytes.Buffer, length int)
+	writeMapHeader(to *bytes.Buffer, length int)
+}
+
+type bufferedBinaryEncoder struct {
+	buffer bytes.Buffer
+	format binaryFormat
+}
+
+func (instance *bufferedBinaryEncoder) WriteMapHeader(length int) error {
+	instance.format.writeMapHeader(&instance.buffer, length)
+	return nil
+}
+
+func (instance *bufferedBinaryEncoder) WriteMapHeaderChecked(length int) func() error {
+	return func() error {
+		return instance.WriteMapHeader(length)
+	}
+}
+
+func (instance *bufferedBinaryEncoder) WriteArrayHeader(length int) error {
+	instance.format.writeArrayHeader(&instance.buffer, length)
+	return nil
+}
+
+func (instance *bufferedBinaryEncoder) WriteArrayHeaderChecked(length int) func() error {
+	return func() error {
+		return instance.WriteArrayHeader(length)
+	}
+}
+
+func (instance *bufferedBinaryEncoder) WriteRaw(p []byte) error {
+	_, err := instance.buffer.Write(p)
+	return err
+}
+
+func (instance *bufferedBinaryEncoder) WriteRawChecked(p []byte) func() error {
+	return func() error {
+		return instance.WriteRaw(p)
+	}
+}
+
+func (instance *bufferedBinaryEncoder) WriteKeyValue(k string, v interface{}) error {
+	instance.format.writeString(&instance.buffer, k)
+	return instance.WriteValue(v)
+}
+
+func (instance *bufferedBinaryEncoder) WriteKeyValueChecked(k string, v interface{}) func() error {
+	return func() error {
+		return instance.WriteKeyValue(k, v)
+	}
+}
+
+func (instance *bufferedBinaryEncoder) WriteValue(v interface{}) error {
+	if ve, ok := v.(error); ok {
+		v = ve.Error()
+	}
+	if vs, ok := v.(*string); ok && vs != nil {
+		v = *vs
+	}
+	if vs, ok := v.(string); ok {
+		v = strings.TrimRightFunc(vs, unicode.IsSpace)
+	}
+	return instance.writeValue(v, 0)
+}
+
+func (instance *bufferedBinaryEncoder) WriteValueChecked(v interface{}) func() error {
+	return func() error {
+		return instance.WriteValue(v)
+	}
+}
+
+func (instance *bufferedBinaryEncoder) Bytes() []byte {
+	return instance.buffer.Bytes()
+}
+
+func (instance *bufferedBinaryEncoder) String() string {
+	return instance.buffer.String()
+}
+
+const maxBinaryDepth = 100
+
+func (instance *bufferedBinaryEncoder) writeValue(v interface{}, depth int) error {
+	if depth > maxBinaryDepth {
+		return fmt.Errorf("cannot encode value of type %T: maximum depth of %d exceeded", v, maxBinaryDepth)
+	}
+	to, f := &instance.buffer, instance.format
+
+	switch vv := v.(type) {
+	case nil:
+		f.writeNil(to)
+		return nil
+	case string:
+		f.writeString(to, vv)
+		return nil
+	case []byte:
+		f.writeBinary(to, vv)
+		return nil
+	case bool:
+		f.writeBool(to, vv)
+		return nil
+	case time.Time:
+		f.writeTime(to, vv)
+		return nil
+	case *time.Time:
+		if vv == nil {
+			f.writeNil(to)
+		} else {
+			f.writeTime(to, *vv)
+		}
+		return nil
+	case error:
+		f.writeString(to, vv.Error())
+		return nil
+	case json.Number:
+		return instance.writeJsonNumber(vv)
+	case json.Marshaler, gencoding.TextMarshaler:
+		return instance.writeByJson(v, depth)
+	}
+
+	rv := reflect.ValueOf(v)
+	switch rv.Kind() {
+	case reflect.Bool:
+		f.writeBool(to, rv.Bool())
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		f.writeInt(to, rv.Int())
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+		f.writeUint(to, rv.Uint())
+	case reflect.Float32:
+		f.writeFloat32(to, float32(rv.Float()))
+	case reflect.Float64:
+		f.writeFloat64(to, rv.Float())
+	case reflect.String:
+		f.writeString(to, rv.String())
+	case reflect.Ptr, reflect.Interface:
+		if rv.IsNil() {
+			f.writeNil(to)
+			return nil
+		}
+		return instance.writeValue(rv.Elem().Interface(), depth+1)
+	case reflect.Slice, reflect.Array:
+		if rv.Kind() == reflect.Slice && rv.IsNil() {
+			f.writeNil(to)
+			return nil
+		}
+		if rv.Type().Elem().Kind() == reflect.Uint8 {
+			b := make([]byte, rv.Len())
+			reflect.Copy(reflect.ValueOf(b), rv)
+			f.writeBinary(to, b)
+			return nil
+		}
+		f.writeArrayHeader(to, rv.Len())
+		for i := 0; i < rv.Len(); i++ {
+			if err := instance.writeValue(rv.Index(i).Interface(), depth+1); err != nil {
+				return err
+			}
+		}
+	case reflect.Map:
+		if rv.IsNil() {
+			f.writeNil(to)
+			return nil
+		}
+		if rv.Type().Key().Kind() != reflect.String {
+			return instance.writeByJson(v, depth)
+		}
+		keys := rv.MapKeys()
+		sort.Slice(keys, func(i, j int) bool {
+			return keys[i].String() < keys[j].String()
+		})
+		f.writeMapHeader(to, len(keys))
+		for _, key := range keys {
+			f.writeString(to, key.String())
+			if err := instance.writeValue(rv.MapIndex(key).Interface(), depth+1); err != nil {
+				return err
+			}
+		}
+	default:
+		return instance.writeByJson(v, depth)
+	}
+	return nil
+}
+
+// writeByJson encodes the given value by its JSON representation converted
+// into the corresponding native types.
+func (instance *bufferedBinaryEncoder) writeByJson(v interface{}, depth int) error {
+	b, err := json.Marshal(v)
+	if err != nil {
+		return err
+	}
+	decoder := json.NewDecoder(bytes.NewReader(b))
+	decoder.UseNumber()
+	var plain interface{}
+	if err := decoder.Decode(&plain); err != nil {
+		return err
+	}
+	return instance.writeValue(plain, depth+1)
+}
+
+func (instance *bufferedBinaryEncoder) writeJsonNumber(v json.Number) error {
+	if i, err := v.Int64(); err == nil {
+		instance.format.writeInt(&instance.buffer, i)
+		return nil
+	}
+	f, err := v.Float64()
+	if err != nil {
+		return err
+	}
+	instance.format.writeFloat64(&instance.buffer, f)
+	return nil
+}
@@ -0,0 +1,115 @@
+package encoding
+
+import (
+	"bytes"
+	"encoding/binary"
+	"math"
+	"time"
+)
+
+// NewBufferedCborEncoder creates a new instance of BufferedBinaryEncoder which
+// encodes to CBOR (https://www.rfc-editor.org/rfc/rfc8949). Instances of
+// time.Time are encoded as epoch-based date/time (tag 1); either as integer
+// (if it is a full second) or as float.
+func NewBufferedCborEncoder() BufferedBinaryEncoder {
+	return &bufferedBinaryEncoder{format: cborFormat{}}
+}
+
+const (
+	cborMajorUnsigned = 0 << 5
+	cborMajorNegative = 1 << 5
+	cborMajorBytes    = 2 << 5
+	cborMajorString   = 3 << 5
+	cborMajorArray    = 4 << 5
+	cborMajorMap      = 5 << 5
+	cborMajorTag      = 6 << 5
+
+	cborFalse   = 0xf4
+	cborTrue    = 0xf5
+	cborNull    = 0xf6
+	cborFloat32 = 0xfa
+	cborFloat64 = 0xfb
+
+	cborTagEpochTime = 1
+)
+
+type cborFormat struct{}
+
+func (instance cborFormat) writeHead(to *bytes.Buffer, major byte, v uint64) {
+	switch {
+	case v < 24:
+		to.WriteByte(major | byte(v))
+	case v <= math.MaxUint8:
+		to.Write([]byte{major | 24, byte(v)})
+	case v <= math.MaxUint16:
+		to.WriteByte(major | 25)
+		_ = binary.Write(to, binary.BigEndian, uint16(v))
+	case v <= math.MaxUint32:
+		to.WriteByte(major | 26)
+		_ = binary.Write(to, binary.BigEndian, uint32(v))
+	default:
+		to.WriteByte(major | 27)
+		_ = binary.Write(to, binary.BigEndian, v)
+	}
+}
+
+func (instance cborFormat) writeNil(to *bytes.Buffer) {
+	to.WriteByte(cborNull)
+}
+
+func (instance cborFormat) writeBool(to *bytes.Buffer, v bool) {
+	if v {
+		to.WriteByte(cborTrue)
+	} else {
+		to.WriteByte(cborFalse)
+	}
+}
+
+func (instance cborFormat) writeInt(to *bytes.Buffer, v int64) {
+	if v < 0 {
+		instance.writeHead(to, cborMajorNegative, uint64(^v))
+	} else {
+		instance.writeHead(to, cborMajorUnsigned, uint64(v))
+	}
+}
+
+func (instance cborFormat) writeUint(to *bytes.Buffer, v uint64) {
+	instance.writeHead(to, cborMajorUnsigned, v)
+}
+
+func (instance cborFormat) writeFloat32(to *bytes.Buffer, v float32) {
+	to.WriteByte(cborFloat32)
+	_ = binary.Write(to, binary.BigEndian, math.Float32bits(v))
+}
+
+func (instance cborFormat) writeFloat64(to *bytes.Buffer, v float64) {
+	to.WriteByte(cborFloat64)
+	_ = binary.Write(to, binary.BigEndian, math.Float64bits(v))
+}
+
+func (instance cborFormat) writeString(to *bytes.Buffer, v string) {
+	instance.writeHead(to, cborMajorString, uint64(len(v)))
+	to.WriteString(v)
+}
+
+func (instance cborFormat) writeBinary(to *bytes.Buffer, v []byte) {
+	instance.writeHead(to, cborMajorBytes, uint64(len(v)))
+	to.Write(v)
+}
+
+func (instance cborFormat) writeTime(to *bytes.Buffer, v time.Time) {
+	instance.writeHead(to, cborMajorTag, cborTagEpochTime)
+	if v.Nanosecond() == 0 {
+		instance.writeInt(to, v.Unix())
+	} else {
+		instance.writeFloat64(to, float64(v.UnixNano())/float64(time.Second))
+	}
+}
+
+func (instance cborFormat) writeArrayHeader(to *bytes.Buffer, length int) {
+	instance.writeHead(to, cborMajorArray, uint64(length))
+}
+
+func (instance cborFormat) writeMapHeader(to *bytes.Buffer, length int) {
+	instance.writeHead(to, cborMajorMap, uint64(length))
+}
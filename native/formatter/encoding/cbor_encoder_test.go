@@ -0,0 +1,83 @@
+package encoding
+
+import (
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"math"
+	"testing"
+	"time"
+
+	"github.com/echocat/slf4g/internal/test/assert"
+)
+
+func Test_bufferedCborEncoder_WriteValue(t *testing.T) {
+	givenTime := time.Date(2013, 3, 21, 20, 4, 0, 0, time.UTC)
+
+	cases := []struct {
+		given    interface{}
+		expected string
+	}{
+		{nil, "f6"},
+		{false, "f4"},
+		{true, "f5"},
+		{0, "00"},
+		{23, "17"},
+		{24, "1818"},
+		{1000, "1903e8"},
+		{1000000, "1a000f4240"},
+		{uint64(math.MaxUint64), "1bffffffffffffffff"},
+		{-1, "20"},
+		{-1000, "3903e7"},
+		{int64(math.MinInt64), "3b7fffffffffffffff"},
+		{float32(1.5), "fa3fc00000"},
+		{1.1, "fb3ff199999999999a"},
+		{"", "60"},
+		{"IETF", "6449455446"},
+		{"abc\n ", "63616263"},
+		{pstring("a"), "6161"},
+		{errors.New("a"), "6161"},
+		{[]byte{1, 2, 3, 4}, "4401020304"},
+		{[]int{1, 2, 3}, "83010203"},
+		{[2]string{"a", "b"}, "8261616162"},
+		{map[string]int{"b": 2, "a": 1}, "a2616101616202"},
+		{map[int]int{1: 2}, "a1613102"},
+		{struct {
+			A int `json:"a"`
+		}{1}, "a1616101"},
+		{givenTime, "c11a514b67b0"},
+		{givenTime.Add(500 * time.Millisecond), "c1fb41d452d9ec200000"},
+		{time.Second, "1a3b9aca00"},
+		{(*string)(nil), "f6"},
+		{[]string(nil), "f6"},
+	}
+
+	for _, c := range cases {
+		t.Run(fmt.Sprintf("%T(%v)", c.given, c.given), func(t *testing.T) {
+			instance := NewBufferedCborEncoder()
+
+			actualErr := instance.WriteValue(c.given)
+
+			assert.ToBeNoError(t, actualErr)
+			assert.ToBeEqual(t, c.expected, hex.EncodeToString(instance.Bytes()))
+		})
+	}
+}
+
+func Test_bufferedCborEncoder_WriteMapHeader(t *testing.T) {
+	instance := NewBufferedCborEncoder()
+
+	assert.ToBeNoError(t, instance.WriteMapHeader(2))
+	assert.ToBeNoError(t, instance.WriteKeyValueChecked("a", 1)())
+	assert.ToBeNoError(t, instance.WriteKeyValue("b", []string{"c"}))
+
+	assert.ToBeEqual(t, "a26161016162816163", hex.EncodeToString(instance.Bytes()))
+}
+
+func Test_bufferedCborEncoder_WriteValue_failing(t *testing.T) {
+	instance := NewBufferedCborEncoder()
+
+	actualErr := instance.WriteValue(func() {})
+
+	assert.ToBeNotNil(t, actualErr)
+}
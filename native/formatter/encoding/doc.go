@@ -1,2 +1,3 @@
-// Package encoding contains all elements to encode to basic formats like JSON and text.
+// Package encoding contains all elements to encode to basic formats like JSON,
+// text, CBOR and MessagePack.
 package encoding
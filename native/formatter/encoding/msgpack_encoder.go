@@ -0,0 +1,163 @@
+package encoding
+
+import (
+	"bytes"
+	"encoding/binary"
+	"math"
+	"time"
+)
+
+// NewBufferedMessagePackEncoder creates a new instance of BufferedBinaryEncoder
+// which encodes to MessagePack (https://msgpack.org). Instances of time.Time
+// are encoded using the timestamp extension type (-1).
+func NewBufferedMessagePackEncoder() BufferedBinaryEncoder {
+	return &bufferedBinaryEncoder{format: msgpackFormat{}}
+}
+
+const (
+	msgpackNil      = 0xc0
+	msgpackFalse    = 0xc2
+	msgpackTrue     = 0xc3
+	msgpackBin8     = 0xc4
+	msgpackBin16    = 0xc5
+	msgpackBin32    = 0xc6
+	msgpackExt8     = 0xc7
+	msgpackFloat32  = 0xca
+	msgpackFloat64  = 0xcb
+	msgpackUint8    = 0xcc
+	msgpackUint16   = 0xcd
+	msgpackUint32   = 0xce
+	msgpackUint64   = 0xcf
+	msgpackInt8     = 0xd0
+	msgpackInt16    = 0xd1
+	msgpackInt32    = 0xd2
+	msgpackInt64    = 0xd3
+	msgpackFixExt4  = 0xd6
+	msgpackFixExt8  = 0xd7
+	msgpackStr8     = 0xd9
+	msgpackStr16    = 0xda
+	msgpackStr32    = 0xdb
+	msgpackArray16  = 0xdc
+	msgpackArray32  = 0xdd
+	msgpackMap16    = 0xde
+	msgpackMap32    = 0xdf
+	msgpackFixStr   = 0xa0
+	msgpackFixArray = 0x90
+	msgpackFixMap   = 0x80
+
+	msgpackExtTimestamp = 0xff // -1
+)
+
+type msgpackFormat struct{}
+
+func (instance msgpackFormat) writeNil(to *bytes.Buffer) {
+	to.WriteByte(msgpackNil)
+}
+
+func (instance msgpackFormat) writeBool(to *bytes.Buffer, v bool) {
+	if v {
+		to.WriteByte(msgpackTrue)
+	} else {
+		to.WriteByte(msgpackFalse)
+	}
+}
+
+func (instance msgpackFormat) writeInt(to *bytes.Buffer, v int64) {
+	switch {
+	case v >= 0:
+		instance.writeUint(to, uint64(v))
+	case v >= -32:
+		to.WriteByte(byte(int8(v)))
+	case v >= math.MinInt8:
+		to.Write([]byte{msgpackInt8, byte(int8(v))})
+	case v >= math.MinInt16:
+		to.WriteByte(msgpackInt16)
+		_ = binary.Write(to, binary.BigEndian, int16(v))
+	case v >= math.MinInt32:
+		to.WriteByte(msgpackInt32)
+		_ = binary.Write(to, binary.BigEndian, int32(v))
+	default:
+		to.WriteByte(msgpackInt64)
+		_ = binary.Write(to, binary.BigEndian, v)
+	}
+}
+
+func (instance msgpackFormat) writeUint(to *bytes.Buffer, v uint64) {
+	switch {
+	case v <= math.MaxInt8:
+		to.WriteByte(byte(v))
+	case v <= math.MaxUint8:
+		to.Write([]byte{msgpackUint8, byte(v)})
+	case v <= math.MaxUint16:
+		to.WriteByte(msgpackUint16)
+		_ = binary.Write(to, binary.BigEndian, uint16(v))
+	case v <= math.MaxUint32:
+		to.WriteByte(msgpackUint32)
+		_ = binary.Write(to, binary.BigEndian, uint32(v))
+	default:
+		to.WriteByte(msgpackUint64)
+		_ = binary.Write(to, binary.BigEndian, v)
+	}
+}
+
+func (instance msgpackFormat) writeFloat32(to *bytes.Buffer, v float32) {
+	to.WriteByte(msgpackFloat32)
+	_ = binary.Write(to, binary.BigEndian, math.Float32bits(v))
+}
+
+func (instance msgpackFormat) writeFloat64(to *bytes.Buffer, v float64) {
+	to.WriteByte(msgpackFloat64)
+	_ = binary.Write(to, binary.BigEndian, math.Float64bits(v))
+}
+
+func (instance msgpackFormat) writeString(to *bytes.Buffer, v string) {
+	instance.writeSized(to, len(v), msgpackFixStr, 32, msgpackStr8, msgpackStr16, msgpackStr32)
+	to.WriteString(v)
+}
+
+func (instance msgpackFormat) writeBinary(to *bytes.Buffer, v []byte) {
+	instance.writeSized(to, len(v), 0, 0, msgpackBin8, msgpackBin16, msgpackBin32)
+	to.Write(v)
+}
+
+func (instance msgpackFormat) writeTime(to *bytes.Buffer, v time.Time) {
+	sec, nsec := v.Unix(), uint32(v.Nanosecond())
+	switch {
+	case sec >= 0 && sec <= math.MaxUint32 && nsec == 0:
+		to.Write([]byte{msgpackFixExt4, msgpackExtTimestamp})
+		_ = binary.Write(to, binary.BigEndian, uint32(sec))
+	case sec >= 0 && sec>>34 == 0:
+		to.Write([]byte{msgpackFixExt8, msgpackExtTimestamp})
+		_ = binary.Write(to, binary.BigEndian, uint64(nsec)<<34|uint64(sec))
+	default:
+		to.Write([]byte{msgpackExt8, 12, msgpackExtTimestamp})
+		_ = binary.Write(to, binary.BigEndian, nsec)
+		_ = binary.Write(to, binary.BigEndian, sec)
+	}
+}
+
+func (instance msgpackFormat) writeArrayHeader(to *bytes.Buffer, length int) {
+	instance.writeSized(to, length, msgpackFixArray, 16, 0, msgpackArray16, msgpackArray32)
+}
+
+func (instance msgpackFormat) writeMapHeader(to *bytes.Buffer, length int) {
+	instance.writeSized(to, length, msgpackFixMap, 16, 0, msgpackMap16, msgpackMap32)
+}
+
+// writeSized writes the header of an element with the given length. If
+// fixLimit is bigger than 0, lengths below it are encoded with fix. If size8
+// is 0 there is no 8-bit variant of this header.
+func (instance msgpackFormat) writeSized(to *bytes.Buffer, length int, fix byte, fixLimit int, size8, size16, size32 byte) {
+	switch {
+	case length < fixLimit:
+		to.WriteByte(fix | byte(length))
+	case size8 != 0 && length <= math.MaxUint8:
+		to.Write([]byte{size8, byte(length)})
+	case length <= math.MaxUint16:
+		to.WriteByte(size16)
+		_ = binary.Write(to, binary.BigEndian, uint16(length))
+	default:
+		to.WriteByte(size32)
+		_ = binary.Write(to, binary.BigEndian, uint32(length))
+	}
+}
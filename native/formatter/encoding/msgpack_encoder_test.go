@@ -0,0 +1,122 @@
+package encoding
+
+import (
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"math"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/echocat/slf4g/internal/test/assert"
+)
+
+func Test_bufferedMessagePackEncoder_WriteValue(t *testing.T) {
+	givenTime := time.Date(2013, 3, 21, 20, 4, 0, 0, time.UTC)
+
+	cases := []struct {
+		given    interface{}
+		expected string
+	}{
+		{nil, "c0"},
+		{false, "c2"},
+		{true, "c3"},
+		{0, "00"},
+		{127, "7f"},
+		{128, "cc80"},
+		{1000, "cd03e8"},
+		{1000000, "ce000f4240"},
+		{uint64(math.MaxUint64), "cfffffffffffffffff"},
+		{-1, "ff"},
+		{-32, "e0"},
+		{-33, "d0df"},
+		{-1000, "d1fc18"},
+		{-100000, "d2fffe7960"},
+		{int64(math.MinInt64), "d38000000000000000"},
+		{float32(1.5), "ca3fc00000"},
+		{1.1, "cb3ff199999999999a"},
+		{"", "a0"},
+		{"abc\n ", "a3616263"},
+		{strings.Repeat("a", 32), "d920" + strings.Repeat("61", 32)},
+		{pstring("a"), "a161"},
+		{errors.New("a"), "a161"},
+		{[]byte{1, 2, 3, 4}, "c40401020304"},
+		{[]int{1, 2, 3}, "93010203"},
+		{map[string]int{"b": 2, "a": 1}, "82a16101a16202"},
+		{struct {
+			A int `json:"a"`
+		}{1}, "81a16101"},
+		{givenTime, "d6ff514b67b0"},
+		{givenTime.Add(500 * time.Millisecond), "d7ff77359400514b67b0"},
+		{time.Unix(-1, 0), "c70cff00000000ffffffffffffffff"},
+		{(*string)(nil), "c0"},
+	}
+
+	for _, c := range cases {
+		t.Run(fmt.Sprintf("%T(%v)", c.given, c.given), func(t *testing.T) {
+			instance := NewBufferedMessagePackEncoder()
+
+			actualErr := instance.WriteValue(c.given)
+
+			assert.ToBeNoError(t, actualErr)
+			assert.ToBeEqual(t, c.expected, hex.EncodeToString(instance.Bytes()))
+		})
+	}
+}
+
+func Test_bufferedMessagePackEncoder_WriteMapHeader(t *testing.T) {
+	cases := []struct {
+		given    int
+		expected string
+	}{
+		{0, "80"},
+		{15, "8f"},
+		{16, "de0010"},
+		{70000, "df00011170"},
+	}
+
+	for _, c := range cases {
+		t.Run(fmt.Sprint(c.given), func(t *testing.T) {
+			instance := NewBufferedMessagePackEncoder()
+
+			actualErr := instance.WriteMapHeaderChecked(c.given)()
+
+			assert.ToBeNoError(t, actualErr)
+			assert.ToBeEqual(t, c.expected, hex.EncodeToString(instance.Bytes()))
+		})
+	}
+}
+
+func Test_bufferedMessagePackEncoder_WriteArrayHeader(t *testing.T) {
+	cases := []struct {
+		given    int
+		expected string
+	}{
+		{0, "90"},
+		{15, "9f"},
+		{16, "dc0010"},
+		{70000, "dd00011170"},
+	}
+
+	for _, c := range cases {
+		t.Run(fmt.Sprint(c.given), func(t *testing.T) {
+			instance := NewBufferedMessagePackEncoder()
+
+			actualErr := instance.WriteArrayHeaderChecked(c.given)()
+
+			assert.ToBeNoError(t, actualErr)
+			assert.ToBeEqual(t, c.expected, hex.EncodeToString(instance.Bytes()))
+		})
+	}
+}
+
+func Test_bufferedMessagePackEncoder_WriteRaw(t *testing.T) {
+	instance := NewBufferedMessagePackEncoder()
+
+	assert.ToBeNoError(t, instance.WriteArrayHeader(2))
+	assert.ToBeNoError(t, instance.WriteRawChecked([]byte{0x01})())
+	assert.ToBeNoError(t, instance.WriteRaw([]byte{0xc0}))
+
+	assert.ToBeEqual(t, "9201c0", hex.EncodeToString(instance.Bytes()))
+}
@@ -0,0 +1,74 @@
+package functions
+
+import (
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"unicode"
+
+	"github.com/echocat/slf4g/fields"
+)
+
+// Json encodes the given value `v` as JSON. Values of type fields.Lazy are
+// resolved before. Example (inside a template):
+//
+//	{{ .Field "user" | json }} -> {"name":"foo","id":1}
+func Json(v interface{}) (string, error) {
+	if vl, ok := v.(fields.Lazy); ok {
+		v = vl.Get()
+	}
+	if ve, ok := v.(error); ok {
+		v = ve.Error()
+	}
+	b, err := json.Marshal(v)
+	if err != nil {
+		return "", err
+	}
+	return string(b), nil
+}
+
+// Logfmt encodes the given value `v` the way it would appear as a value of
+// the logfmt format. It will only be quoted if required. Values of type
+// fields.Lazy are resolved before. Example (inside a template):
+//
+//	{{ .Field "user" | logfmt }} -> foo
+//	{{ .Message | logfmt }}      -> "hello world"
+func Logfmt(v interface{}) (string, error) {
+	if vl, ok := v.(fields.Lazy); ok {
+		v = vl.Get()
+	}
+	switch vv := v.(type) {
+	case nil:
+		return "null", nil
+	case string:
+		return logfmtQuoteIfRequired(vv), nil
+	case *string:
+		if vv == nil {
+			return "null", nil
+		}
+		return logfmtQuoteIfRequired(*vv), nil
+	case error:
+		return logfmtQuoteIfRequired(vv.Error()), nil
+	case fmt.Stringer:
+		return logfmtQuoteIfRequired(vv.String()), nil
+	case bool, int, int8, int16, int32, int64, uint, uint8, uint16, uint32, uint64, float32, float64:
+		return fmt.Sprint(vv), nil
+	}
+	b, err := json.Marshal(v)
+	if err != nil {
+		return "", err
+	}
+	return logfmtQuoteIfRequired(string(b)), nil
+}
+
+func logfmtQuoteIfRequired(what string) string {
+	if what == "" {
+		return `""`
+	}
+	for _, r := range what {
+		if r <= ' ' || r == '=' || r == '"' || r == unicode.ReplacementChar || !unicode.IsPrint(r) {
+			return strconv.Quote(what)
+		}
+	}
+	return what
+}
@@ -0,0 +1,102 @@
+package functions
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/echocat/slf4g/fields"
+
+	"github.com/echocat/slf4g/internal/test/assert"
+)
+
+func ExampleJson() {
+	fmt.Println(Json(map[string]interface{}{"name": "foo", "id": 1}))
+
+	// Output:
+	// {"id":1,"name":"foo"} <nil>
+}
+
+func ExampleLogfmt() {
+	fmt.Println(Logfmt("foo"))
+	fmt.Println(Logfmt("hello world"))
+
+	// Output:
+	// foo <nil>
+	// "hello world" <nil>
+}
+
+func Test_Json(t *testing.T) {
+	cases := []struct {
+		given    interface{}
+		expected string
+	}{
+		{nil, `null`},
+		{"foo", `"foo"`},
+		{1, `1`},
+		{[]string{"a", "b"}, `["a","b"]`},
+		{errors.New("expected"), `"expected"`},
+		{fields.LazyFormat("foo%d", 1), `"foo1"`},
+	}
+
+	for _, c := range cases {
+		t.Run(c.expected, func(t *testing.T) {
+			actual, actualErr := Json(c.given)
+
+			assert.ToBeNoError(t, actualErr)
+			assert.ToBeEqual(t, c.expected, actual)
+		})
+	}
+}
+
+func Test_Json_failing(t *testing.T) {
+	actual, actualErr := Json(func() {})
+
+	assert.ToBeNotNil(t, actualErr)
+	assert.ToBeEqual(t, "", actual)
+}
+
+func Test_Logfmt(t *testing.T) {
+	givenNilString := (*string)(nil)
+	givenString := "foo"
+
+	cases := []struct {
+		given    interface{}
+		expected string
+	}{
+		{nil, `null`},
+		{"", `""`},
+		{"foo", `foo`},
+		{"foo bar", `"foo bar"`},
+		{"a=b", `"a=b"`},
+		{`a"b`, `"a\"b"`},
+		{"a\nb", `"a\nb"`},
+		{givenNilString, `null`},
+		{&givenString, `foo`},
+		{errors.New("expected error"), `"expected error"`},
+		{time.Second, `1s`},
+		{true, `true`},
+		{12, `12`},
+		{1.5, `1.5`},
+		{[]string{"a", "b"}, `"[\"a\",\"b\"]"`},
+		{map[string]string{"a": "b c"}, `"{\"a\":\"b c\"}"`},
+		{fields.LazyFormat("foo%d", 1), `foo1`},
+	}
+
+	for _, c := range cases {
+		t.Run(c.expected, func(t *testing.T) {
+			actual, actualErr := Logfmt(c.given)
+
+			assert.ToBeNoError(t, actualErr)
+			assert.ToBeEqual(t, c.expected, actual)
+		})
+	}
+}
+
+func Test_Logfmt_failing(t *testing.T) {
+	actual, actualErr := Logfmt(func() {})
+
+	assert.ToBeNotNil(t, actualErr)
+	assert.ToBeEqual(t, "", actual)
+}
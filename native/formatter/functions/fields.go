@@ -0,0 +1,31 @@
+package functions
+
+// Without returns a copy of the given fields `of` without the provided keys.
+// In combination with range it is possible to print all remaining fields,
+// which were not printed explicitly before. Example (inside a template):
+//
+//	{{ .Field "user" }}{{ range $k, $v := without .Fields "user" }} {{ $k }}={{ logfmt $v }}{{ end }}
+func Without(of map[string]interface{}, keys ...string) map[string]interface{} {
+	result := make(map[string]interface{}, len(of))
+	for k, v := range of {
+		result[k] = v
+	}
+	for _, key := range keys {
+		delete(result, key)
+	}
+	return result
+}
+
+// Only returns a copy of the given fields `of` which only contains the
+// provided keys. Example (inside a template):
+//
+//	{{ range $k, $v := only .Fields "user" "tenant" }} {{ $k }}={{ logfmt $v }}{{ end }}
+func Only(of map[string]interface{}, keys ...string) map[string]interface{} {
+	result := make(map[string]interface{}, len(keys))
+	for _, key := range keys {
+		if v, ok := of[key]; ok {
+			result[key] = v
+		}
+	}
+	return result
+}
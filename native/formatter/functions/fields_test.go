@@ -0,0 +1,33 @@
+package functions
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/echocat/slf4g/internal/test/assert"
+)
+
+func ExampleWithout() {
+	fmt.Println(Without(map[string]interface{}{"user": "foo", "tenant": "bar", "id": 1}, "user"))
+
+	// Output:
+	// map[id:1 tenant:bar]
+}
+
+func Test_Without(t *testing.T) {
+	given := map[string]interface{}{"a": 1, "b": 2, "c": 3}
+
+	actual := Without(given, "a", "c", "d")
+
+	assert.ToBeEqual(t, map[string]interface{}{"b": 2}, actual)
+	assert.ToBeEqual(t, map[string]interface{}{"a": 1, "b": 2, "c": 3}, given)
+}
+
+func Test_Only(t *testing.T) {
+	given := map[string]interface{}{"a": 1, "b": 2, "c": 3}
+
+	actual := Only(given, "a", "c", "d")
+
+	assert.ToBeEqual(t, map[string]interface{}{"a": 1, "c": 3}, actual)
+	assert.ToBeEqual(t, map[string]interface{}{"a": 1, "b": 2, "c": 3}, given)
+}
@@ -0,0 +1,119 @@
+package functions
+
+import (
+	"fmt"
+	"math"
+	"strings"
+	"time"
+
+	"github.com/echocat/slf4g/fields"
+)
+
+var (
+	// nowFunc is used by HumanizeTime to determine the current time.
+	nowFunc = time.Now
+
+	byteSizeUnits = []string{"B", "KiB", "MiB", "GiB", "TiB", "PiB", "EiB"}
+)
+
+// HumanizeDuration formats the given duration `d` in a short and human
+// readable way. It is rounded to a reasonable precision depending on its
+// magnitude. Examples (inside a template):
+//
+//	{{ .Field "took" | humanizeDuration }} -> 1.23s   (for 1.234567s)
+//	{{ .Field "took" | humanizeDuration }} -> 1h2m    (for 1h2m0.4s)
+func HumanizeDuration(d time.Duration) string {
+	abs := d
+	if abs < 0 {
+		abs = -abs
+	}
+	switch {
+	case abs >= time.Minute:
+		d = d.Round(time.Second)
+	case abs >= time.Second:
+		d = d.Round(10 * time.Millisecond)
+	case abs >= time.Millisecond:
+		d = d.Round(10 * time.Microsecond)
+	case abs >= time.Microsecond:
+		d = d.Round(10 * time.Nanosecond)
+	}
+	result := d.String()
+	if strings.HasSuffix(result, "m0s") {
+		result = result[:len(result)-2]
+	}
+	if strings.HasSuffix(result, "h0m") {
+		result = result[:len(result)-2]
+	}
+	return result
+}
+
+// HumanizeTime formats the given time `t` relative to now. Examples (inside a
+// template):
+//
+//	{{ .Field "lastLogin" | humanizeTime }} -> 3m ago
+//	{{ .Field "expiresAt" | humanizeTime }} -> in 2h
+//	{{ .Field "changedAt" | humanizeTime }} -> now
+func HumanizeTime(t time.Time) string {
+	d := nowFunc().Sub(t).Truncate(time.Second)
+	switch {
+	case d == 0:
+		return "now"
+	case d > 0:
+		return HumanizeDuration(d) + " ago"
+	default:
+		return "in " + HumanizeDuration(-d)
+	}
+}
+
+// HumanizeBytes formats the given amount of bytes `v` using binary units
+// (KiB, MiB, ...). It accepts every integer and float type; all other values
+// are returned formatted using fmt.Sprint(). Values of type fields.Lazy are
+// resolved before. Examples (inside a template):
+//
+//	{{ .Field "size" | humanizeBytes }} -> 512 B
+//	{{ .Field "size" | humanizeBytes }} -> 1.5 KiB (for 1536)
+func HumanizeBytes(v interface{}) string {
+	if vl, ok := v.(fields.Lazy); ok {
+		v = vl.Get()
+	}
+	var amount float64
+	switch vv := v.(type) {
+	case int:
+		amount = float64(vv)
+	case int8:
+		amount = float64(vv)
+	case int16:
+		amount = float64(vv)
+	case int32:
+		amount = float64(vv)
+	case int64:
+		amount = float64(vv)
+	case uint:
+		amount = float64(vv)
+	case uint8:
+		amount = float64(vv)
+	case uint16:
+		amount = float64(vv)
+	case uint32:
+		amount = float64(vv)
+	case uint64:
+		amount = float64(vv)
+	case float32:
+		amount = float64(vv)
+	case float64:
+		amount = vv
+	default:
+		return fmt.Sprint(v)
+	}
+
+	unit := 0
+	for math.Abs(amount) >= 1024 && unit < len(byteSizeUnits)-1 {
+		amount /= 1024
+		unit++
+	}
+	if unit == 0 {
+		return fmt.Sprintf("%.0f %s", amount, byteSizeUnits[unit])
+	}
+	result := strings.TrimSuffix(fmt.Sprintf("%.1f", amount), ".0")
+	return result + " " + byteSizeUnits[unit]
+}
@@ -0,0 +1,115 @@
+package functions
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/echocat/slf4g/fields"
+
+	"github.com/echocat/slf4g/internal/test/assert"
+)
+
+func ExampleHumanizeDuration() {
+	fmt.Println(HumanizeDuration(1234567 * time.Microsecond))
+	fmt.Println(HumanizeDuration(time.Hour + 2*time.Minute + 400*time.Millisecond))
+
+	// Output:
+	// 1.23s
+	// 1h2m
+}
+
+func ExampleHumanizeBytes() {
+	fmt.Println(HumanizeBytes(512))
+	fmt.Println(HumanizeBytes(1536))
+	fmt.Println(HumanizeBytes(uint64(5) << 30))
+
+	// Output:
+	// 512 B
+	// 1.5 KiB
+	// 5 GiB
+}
+
+func Test_HumanizeDuration(t *testing.T) {
+	cases := []struct {
+		given    time.Duration
+		expected string
+	}{
+		{0, "0s"},
+		{123 * time.Nanosecond, "123ns"},
+		{1234 * time.Nanosecond, "1.23µs"},
+		{1234567 * time.Nanosecond, "1.23ms"},
+		{1234567 * time.Microsecond, "1.23s"},
+		{-1234567 * time.Microsecond, "-1.23s"},
+		{90*time.Second + 400*time.Millisecond, "1m30s"},
+		{2 * time.Minute, "2m"},
+		{time.Hour + 2*time.Minute + 400*time.Millisecond, "1h2m"},
+		{3 * time.Hour, "3h"},
+	}
+
+	for _, c := range cases {
+		t.Run(c.expected, func(t *testing.T) {
+			actual := HumanizeDuration(c.given)
+
+			assert.ToBeEqual(t, c.expected, actual)
+		})
+	}
+}
+
+func Test_HumanizeTime(t *testing.T) {
+	givenNow := time.Date(2021, 1, 2, 13, 14, 15, 0, time.UTC)
+	old := nowFunc
+	defer func() {
+		nowFunc = old
+	}()
+	nowFunc = func() time.Time { return givenNow }
+
+	cases := []struct {
+		given    time.Time
+		expected string
+	}{
+		{givenNow, "now"},
+		{givenNow.Add(-500 * time.Millisecond), "now"},
+		{givenNow.Add(-3 * time.Minute), "3m ago"},
+		{givenNow.Add(2*time.Hour + 100*time.Millisecond), "in 2h"},
+	}
+
+	for _, c := range cases {
+		t.Run(c.expected, func(t *testing.T) {
+			actual := HumanizeTime(c.given)
+
+			assert.ToBeEqual(t, c.expected, actual)
+		})
+	}
+}
+
+func Test_HumanizeBytes(t *testing.T) {
+	cases := []struct {
+		given    interface{}
+		expected string
+	}{
+		{0, "0 B"},
+		{int8(12), "12 B"},
+		{int16(1023), "1023 B"},
+		{int32(1024), "1 KiB"},
+		{int64(1536), "1.5 KiB"},
+		{uint(1 << 20), "1 MiB"},
+		{uint8(255), "255 B"},
+		{uint16(2048), "2 KiB"},
+		{uint32(3 << 20), "3 MiB"},
+		{uint64(5) << 30, "5 GiB"},
+		{uint64(1) << 62, "4 EiB"},
+		{float32(1024), "1 KiB"},
+		{-1536.0, "-1.5 KiB"},
+		{fields.LazyFunc(func() interface{} { return 2048 }), "2 KiB"},
+		{"foo", "foo"},
+	}
+
+	for _, c := range cases {
+		t.Run(c.expected, func(t *testing.T) {
+			actual := HumanizeBytes(c.given)
+
+			assert.ToBeEqual(t, c.expected, actual)
+		})
+	}
+}
@@ -0,0 +1,23 @@
+package functions
+
+import (
+	"github.com/echocat/slf4g/native/naming"
+)
+
+// AbbreviateName abbreviates the given name `of` the way logback does to fit
+// into `width`; see naming.Abbreviate for details. Example (inside a
+// template):
+//
+//	{{ .Logger | abbreviateName 20 }} -> g.c.e.slf4g.native
+func AbbreviateName(width int, of string) string {
+	return naming.Abbreviate(width, of)
+}
+
+// StripNamePrefix removes the given `prefix` (usually a module path) from the
+// given name `of`; see naming.StripPrefix for details. Example (inside a
+// template):
+//
+//	{{ .Logger | stripNamePrefix "github.com/echocat" }} -> slf4g/native
+func StripNamePrefix(prefix string, of string) string {
+	return naming.StripPrefix(of, prefix)
+}
@@ -0,0 +1,23 @@
+package functions
+
+import (
+	"fmt"
+)
+
+func ExampleAbbreviateName() {
+	fmt.Println(AbbreviateName(20, "github.com/echocat/slf4g/native"))
+	fmt.Println(AbbreviateName(10, "github.com/echocat/slf4g/native"))
+
+	// Output:
+	// g.c.e.slf4g.native
+	// g.c.e.s.native
+}
+
+func ExampleStripNamePrefix() {
+	fmt.Println(StripNamePrefix("github.com/echocat", "github.com/echocat/slf4g/native"))
+	fmt.Println(StripNamePrefix("github.com/other", "github.com/echocat/slf4g/native"))
+
+	// Output:
+	// slf4g/native
+	// github.com/echocat/slf4g/native
+}
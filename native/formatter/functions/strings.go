@@ -0,0 +1,76 @@
+package functions
+
+import (
+	"reflect"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/echocat/slf4g/fields"
+)
+
+// Default returns `def` if the given value `v` is empty (nil, an empty string,
+// a nil pointer or an empty slice/map); otherwise `v` itself. Values of type
+// fields.Lazy are resolved before. Example (inside a template):
+//
+//	{{ .Field "user" | default "anonymous" }}
+func Default(def interface{}, v interface{}) interface{} {
+	if vl, ok := v.(fields.Lazy); ok {
+		v = vl.Get()
+	}
+	if v == nil {
+		return def
+	}
+	rv := reflect.ValueOf(v)
+	switch rv.Kind() {
+	case reflect.String, reflect.Slice, reflect.Map, reflect.Array:
+		if rv.Len() == 0 {
+			return def
+		}
+	case reflect.Ptr, reflect.Interface:
+		if rv.IsNil() {
+			return def
+		}
+	}
+	return v
+}
+
+// Truncate cuts the given string `of` after `length` characters (runes). If
+// the string was truncated, it will end with …. Example (inside a template):
+//
+//	{{ .Message | truncate 10 }} -> hello, wo…
+func Truncate(length int, of string) string {
+	if length <= 0 {
+		return ""
+	}
+	if utf8.RuneCountInString(of) <= length {
+		return of
+	}
+	runes := []rune(of)
+	return string(runes[:length-1]) + "…"
+}
+
+// Pad ensures that the given string `of` has at least the length of `width`.
+// If `width` is positive whitespaces will be suffixed; if it is negative they
+// will be prefixed. Contrary to EnsureWidth it never cuts off. Example (inside
+// a template):
+//
+//	[{{ .LevelName | pad -5 }}] -> [ INFO]
+func Pad(width int32, of string) string {
+	return EnsureWidth(width, false, of)
+}
+
+// Upper returns the given string `of` with all letters mapped to upper case.
+// Example (inside a template):
+//
+//	{{ .LevelName | upper }} -> INFO
+func Upper(of string) string {
+	return strings.ToUpper(of)
+}
+
+// Lower returns the given string `of` with all letters mapped to lower case.
+// Example (inside a template):
+//
+//	{{ .LevelName | lower }} -> info
+func Lower(of string) string {
+	return strings.ToLower(of)
+}
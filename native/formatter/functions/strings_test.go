@@ -0,0 +1,109 @@
+package functions
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/echocat/slf4g/fields"
+
+	"github.com/echocat/slf4g/internal/test/assert"
+)
+
+func ExampleDefault() {
+	fmt.Println(Default("anonymous", ""))
+	fmt.Println(Default("anonymous", "foo"))
+
+	// Output:
+	// anonymous
+	// foo
+}
+
+func ExampleTruncate() {
+	fmt.Println(Truncate(10, "hello, world"))
+
+	// Output:
+	// hello, wo…
+}
+
+func Test_Default(t *testing.T) {
+	givenString := "foo"
+
+	cases := []struct {
+		name     string
+		given    interface{}
+		expected interface{}
+	}{
+		{"nil", nil, "def"},
+		{"empty string", "", "def"},
+		{"nil pointer", (*string)(nil), "def"},
+		{"empty slice", []string{}, "def"},
+		{"empty map", map[string]string{}, "def"},
+		{"empty lazy", fields.LazyFunc(func() interface{} { return nil }), "def"},
+		{"string", "foo", "foo"},
+		{"pointer", &givenString, &givenString},
+		{"zero int", 0, 0},
+		{"slice", []string{"a"}, []string{"a"}},
+		{"lazy", fields.LazyFormat("foo%d", 1), "foo1"},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			actual := Default("def", c.given)
+
+			assert.ToBeEqual(t, c.expected, actual)
+		})
+	}
+}
+
+func Test_Truncate(t *testing.T) {
+	cases := []struct {
+		givenLength int
+		given       string
+		expected    string
+	}{
+		{5, "hello", "hello"},
+		{10, "hello", "hello"},
+		{4, "hello", "hel…"},
+		{2, "äöü", "ä…"},
+		{1, "hello", "…"},
+		{0, "hello", ""},
+		{-1, "hello", ""},
+	}
+
+	for _, c := range cases {
+		t.Run(fmt.Sprintf("%d-%s", c.givenLength, c.given), func(t *testing.T) {
+			actual := Truncate(c.givenLength, c.given)
+
+			assert.ToBeEqual(t, c.expected, actual)
+		})
+	}
+}
+
+func Test_Pad(t *testing.T) {
+	cases := []struct {
+		givenWidth int32
+		given      string
+		expected   string
+	}{
+		{5, "foo", "foo  "},
+		{-5, "foo", "  foo"},
+		{2, "foo", "foo"},
+		{0, "foo", "foo"},
+	}
+
+	for _, c := range cases {
+		t.Run(fmt.Sprintf("%d-%s", c.givenWidth, c.given), func(t *testing.T) {
+			actual := Pad(c.givenWidth, c.given)
+
+			assert.ToBeEqual(t, c.expected, actual)
+		})
+	}
+}
+
+func Test_Upper(t *testing.T) {
+	assert.ToBeEqual(t, "HELLO, WORLD", Upper("Hello, World"))
+}
+
+func Test_Lower(t *testing.T) {
+	assert.ToBeEqual(t, "hello, world", Lower("Hello, World"))
+}
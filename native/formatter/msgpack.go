@@ -0,0 +1,92 @@
+package formatter
+
+import (
+	log "github.com/echocat/slf4g"
+	"github.com/echocat/slf4g/fields"
+	"github.com/echocat/slf4g/level"
+	"github.com/echocat/slf4g/native/formatter/encoding"
+	"github.com/echocat/slf4g/native/hints"
+)
+
+// MessagePack is an implementation of Formatter which formats given log
+// entries as MessagePack (https://msgpack.org) maps. Every log.Event results in
+// exactly one map; so the output of several events is a stream of maps.
+//
+// Fields are handled the same way as Json does. Values are encoded using the
+// native types of the format (including times and binary data); see
+// encoding.BinaryEncoder for details.
+type MessagePack struct {
+	// KeyLevel is the key to write the level of log entries to the output with.
+	// If not set DefaultKeyLevel is used.
+	KeyLevel string
+
+	// LevelFormatter is used to format the level.Level of a given log.Entry.
+	// into the field with key of KeyLevel.
+	LevelFormatter Level
+
+	// PrintRootLogger will (if set to true) also print the field logger for the
+	// root logger. If set to false the logger field will be only printed for
+	// every logger but not for the root one. If not set
+	// DefaultPrintRootLogger will be used.
+	PrintRootLogger *bool
+
+	// KeySorter is used to sort the fields before they are printed. The field
+	// which contains the level.Level will be always the first, regardless of
+	// the result of the KeySorter. If not set fields.DefaultKeySorter will be
+	// used; which ensures a deterministic order.
+	KeySorter fields.KeySorter
+}
+
+// NewMessagePack creates a new instance of MessagePack which is ready to use.
+func NewMessagePack(customizer ...func(*MessagePack)) *MessagePack {
+	result := &MessagePack{}
+	for _, c := range customizer {
+		c(result)
+	}
+	return result
+}
+
+// Format implements Formatter.Format()
+func (instance *MessagePack) Format(event log.Event, using log.Provider, _ hints.Hints) ([]byte, error) {
+	return binaryFormatting{
+		levelKey:        instance.getLevelKey(),
+		levelFormatter:  instance.getLevelFormatter(using),
+		printRootLogger: instance.getPrintRootLogger(),
+		keySorter:       instance.getKeySorter(),
+	}.format(event, using, encoding.NewBufferedMessagePackEncoder())
+}
+
+func (instance *MessagePack) getLevelKey() string {
+	if v := instance.KeyLevel; v != "" {
+		return v
+	}
+	return DefaultKeyLevel
+}
+
+func (instance *MessagePack) getLevelFormatter(using log.Provider) Level {
+	if v := instance.LevelFormatter; v != nil {
+		return v
+	}
+	if v, ok := using.(level.NamesAware); ok {
+		return NewNamesBasedLevel(v.GetLevelNames())
+	}
+	return DefaultLevel
+}
+
+func (instance *MessagePack) getPrintRootLogger() bool {
+	if v := instance.PrintRootLogger; v != nil {
+		return *v
+	}
+	//goland:noinspection GoBoolExpressions
+	return DefaultPrintRootLogger
+}
+
+func (instance *MessagePack) getKeySorter() fields.KeySorter {
+	if v := instance.KeySorter; v != nil {
+		return v
+	}
+	if v := fields.DefaultKeySorter; v != nil {
+		return v
+	}
+	return fields.NoopKeySorter()
+}
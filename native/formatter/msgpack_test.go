@@ -0,0 +1,41 @@
+package formatter
+
+import (
+	"encoding/hex"
+	"testing"
+	"time"
+
+	"github.com/echocat/slf4g/internal/test/assert"
+	"github.com/echocat/slf4g/level"
+	"github.com/echocat/slf4g/testing/recording"
+)
+
+func Test_NewMessagePack(t *testing.T) {
+	instance := NewMessagePack(func(v *MessagePack) {
+		v.KeyLevel = "foo"
+	})
+
+	assert.ToBeEqual(t, "foo", instance.KeyLevel)
+	assert.ToBeNil(t, instance.LevelFormatter)
+	assert.ToBeNil(t, instance.PrintRootLogger)
+	assert.ToBeNil(t, instance.KeySorter)
+}
+
+func Test_MessagePack_Format(t *testing.T) {
+	givenProvider := recording.NewProvider()
+	givenEvent := givenProvider.GetRootLogger().NewEvent(level.Warn, map[string]interface{}{
+		"timestamp": time.Date(2013, 3, 21, 20, 4, 0, 0, time.UTC),
+		"data":      []byte{1, 2},
+	})
+
+	instance := NewMessagePack(func(v *MessagePack) {
+		v.KeyLevel = "lvl"
+		v.LevelFormatter = NewOrdinalBasedLevel()
+	})
+
+	actual, actualErr := instance.Format(givenEvent, givenProvider, nil)
+
+	assert.ToBeNoError(t, actualErr)
+	// {"lvl":4000,"data":bin(0102),"timestamp":ext(-1,1363896240)}
+	assert.ToBeEqual(t, "83"+"a36c766c"+"cd0fa0"+"a464617461"+"c4020102"+"a974696d657374616d70"+"d6ff514b67b0", hex.EncodeToString(actual))
+}
@@ -0,0 +1,514 @@
+package formatter
+
+import (
+	"fmt"
+	"strings"
+	"sync"
+	"time"
+	"unicode"
+
+	log "github.com/echocat/slf4g"
+	"github.com/echocat/slf4g/fields"
+	"github.com/echocat/slf4g/level"
+	"github.com/echocat/slf4g/native/color"
+	"github.com/echocat/slf4g/native/formatter/encoding"
+	"github.com/echocat/slf4g/native/formatter/functions"
+	"github.com/echocat/slf4g/native/hints"
+	nlevel "github.com/echocat/slf4g/native/level"
+	"github.com/echocat/slf4g/native/naming"
+)
+
+var (
+	// DefaultPrettyRelativeTimestamps is the default setting if timestamps
+	// should be printed relative to the previous event. See
+	// Pretty.RelativeTimestamps for more information.
+	DefaultPrettyRelativeTimestamps = true
+
+	// DefaultPrettyTimestampWidth is the default width of timestamps. See
+	// Pretty.TimestampWidth for more information.
+	DefaultPrettyTimestampWidth = int8(-8)
+
+	// DefaultPrettyLoggerWidth is the default width of logger names. See
+	// Pretty.LoggerWidth for more information.
+	DefaultPrettyLoggerWidth = int16(20)
+)
+
+// Pretty is an implementation of Formatter which formats given log entries in a
+// developer-friendly format for local development. In contrast to Text it
+// aligns columns, prints relative timestamps, abbreviates logger names, prints
+// multiline field values in blocks and highlights errors with their stack
+// traces. Example:
+//
+//	+1.23ms  INFO g.c.e.slf4g.native   Hello, world!                                      foo=1
+//	  +20µs ERROR g.c.e.slf4g.native   Something went wrong.                              bar=2
+//	 error: expected
+//	   main.main
+//	   	/app/main.go:12
+type Pretty struct {
+	// ColorMode defines when the output should be colorized. If not configured
+	// color.ModeAuto will be used by default.
+	ColorMode color.Mode
+
+	// LevelColorizer is used to colorize output based on the level.Level of an
+	// log.Event to be logged. If not set nlevel.DefaultColorizer will be used.
+	LevelColorizer nlevel.Colorizer
+
+	// Theme defines the colors of the output. The colors of levels are only
+	// used if no LevelColorizer is configured. The colors are downgraded to
+	// the color.Depth provided by hints.ColorDepth (or color.Depth16 if not
+	// provided). If not set color.DefaultTheme will be used.
+	Theme *color.Theme
+
+	// RelativeTimestamps defines if the timestamps are printed relative to
+	// the timestamp of the event which was formatted before (like "+12ms"). If
+	// set to false the absolute timestamp using TimeLayout will be printed.
+	// If not set DefaultPrettyRelativeTimestamps will be used.
+	RelativeTimestamps *bool
+
+	// TimeLayout defines how the time of log events should be formatted if
+	// RelativeTimestamps is false. Please see time.Time#Format() for more
+	// details. If not set DefaultTimeLayout will be used.
+	TimeLayout string
+
+	// TimestampWidth defines the width of the timestamp column. See
+	// Text.LevelWidth for how the values are interpreted. If not set
+	// DefaultPrettyTimestampWidth will be used.
+	TimestampWidth *int8
+
+	// LevelWidth defines the width of the level column. See Text.LevelWidth
+	// for how the values are interpreted. If not set DefaultLevelWidth will be
+	// used.
+	LevelWidth *int8
+
+	// LoggerWidth defines the width of the logger column. Longer logger names
+	// will be abbreviated the way logback does; github.com/echocat/slf4g/native
+	// becomes g.c.e.slf4g.native (see naming.Abbreviate). If set to 0 the
+	// logger names will neither be abbreviated nor aligned. If not set
+	// DefaultPrettyLoggerWidth will be used.
+	LoggerWidth *int16
+
+	// LoggerNameShortener is used to shorten the logger names (for example by
+	// stripping module prefixes or by applying aliases; see naming.Strategy)
+	// before they are abbreviated to LoggerWidth. If not set
+	// naming.DefaultShortener will be used.
+	LoggerNameShortener naming.Shortener
+
+	// MinMessageWidth defines the width of the message column. See
+	// Text.MinMessageWidth for how the values are interpreted. If not set
+	// DefaultMinMessageWidth will be used.
+	MinMessageWidth *int16
+
+	// PrintRootLogger will (if set to true) also print the name of the root
+	// logger. If not set DefaultPrintRootLogger will be used.
+	PrintRootLogger *bool
+
+	// ValueFormatter is used to format the field values (not the message). If
+	// not set formatter.DefaultTextValue will be used.
+	ValueFormatter TextValue
+
+	// KeySorter is used to sort the field when they are printed. If not set
+	// fields.DefaultKeySorter will be used.
+	KeySorter fields.KeySorter
+
+	prettyAsHints *prettyAsHints
+	previous      time.Time
+	mutex         sync.Mutex
+}
+
+// NewPretty creates a new instance of Pretty which is ready to use.
+func NewPretty(customizer ...func(*Pretty)) *Pretty {
+	result := &Pretty{}
+	result.prettyAsHints = &prettyAsHints{
+		Pretty: result,
+	}
+	for _, c := range customizer {
+		c(result)
+	}
+	return result
+}
+
+// Format implements Formatter.Format()
+func (instance *Pretty) Format(event log.Event, using log.Provider, h hints.Hints) ([]byte, error) {
+	to := encoding.NewBufferedTextEncoder()
+	h = instance.wrapHints(h)
+	theme := instance.getTheme()
+	l := event.GetLevel()
+
+	timestamp := instance.formatTimestamp(event, using)
+	levelName, err := instance.formatLevel(l, using)
+	if err != nil {
+		return nil, err
+	}
+	messageLines := instance.getMessageLines(event, using)
+
+	line := instance.colorize(theme.Timestamp, h, timestamp) +
+		` ` + instance.colorizeLevel(l, h, levelName) +
+		` ` + instance.colorize(theme.Logger, h, instance.formatLogger(event, using))
+	if len(messageLines) > 0 {
+		line += ` ` + functions.EnsureWidth(int32(instance.getMinMessageWidth()), false, messageLines[0])
+	}
+
+	var blocks []string
+	if len(messageLines) > 1 {
+		for _, ml := range messageLines[1:] {
+			blocks = append(blocks, "    "+ml)
+		}
+	}
+
+	if err := fields.SortedForEach(event, instance.getKeySorter(), func(k string, v interface{}) error {
+		printed, block, err := instance.formatField(event, k, v, h, using)
+		if err != nil {
+			return err
+		}
+		if block != nil {
+			blocks = append(blocks, block...)
+		} else if printed != "" {
+			line += ` ` + printed
+		}
+		return nil
+	}); err != nil {
+		return nil, err
+	}
+
+	if err := log.GetErrorOf(event, using); err != nil {
+		blocks = append(blocks, instance.formatError(err, h)...)
+	}
+
+	if err := to.WriteString(strings.TrimRightFunc(line, unicode.IsSpace)); err != nil {
+		return nil, err
+	}
+	for _, block := range blocks {
+		if err := to.WriteString("\n" + block); err != nil {
+			return nil, err
+		}
+	}
+
+	if err := to.WriteByte('\n'); err != nil {
+		return nil, err
+	}
+	return to.Bytes(), nil
+}
+
+func (instance *Pretty) formatTimestamp(event log.Event, using log.Provider) string {
+	width := int32(instance.getTimestampWidth())
+	v := log.GetTimestampOf(event, using)
+	if v == nil {
+		return functions.EnsureWidth(width, false, "")
+	}
+	if !instance.getRelativeTimestamps() {
+		return functions.EnsureWidth(width, false, v.Format(instance.getTimeLayout()))
+	}
+
+	instance.mutex.Lock()
+	previous := instance.previous
+	instance.previous = *v
+	instance.mutex.Unlock()
+
+	var d time.Duration
+	if !previous.IsZero() {
+		d = v.Sub(previous)
+	}
+	result := functions.HumanizeDuration(d)
+	if d >= 0 {
+		result = "+" + result
+	}
+	return functions.EnsureWidth(width, false, result)
+}
+
+func (instance *Pretty) formatLevel(l level.Level, using log.Provider) (string, error) {
+	v, err := instance.getLevelNames(using).ToName(l)
+	if err != nil {
+		return "", err
+	}
+	return functions.EnsureWidth(int32(instance.getLevelWidth()), true, v), nil
+}
+
+func (instance *Pretty) formatLogger(event log.Event, using log.Provider) string {
+	width := instance.getLoggerWidth()
+	var name string
+	if v := log.GetLoggerOf(event, using); v != nil && (*v != "ROOT" || instance.getPrintRootLogger()) {
+		name = instance.getLoggerNameShortener().Shorten(*v)
+	}
+	if width == 0 {
+		return name
+	}
+	return functions.EnsureWidth(int32(width), false, naming.Abbreviate(int(width), name))
+}
+
+func (instance *Pretty) getMessageLines(event log.Event, using log.Provider) []string {
+	v := log.GetMessageOf(event, using)
+	if v == nil {
+		return nil
+	}
+	message := strings.ReplaceAll(*v, "\r", "")
+	message = strings.TrimLeft(message, "\n")
+	message = strings.TrimRightFunc(message, unicode.IsSpace)
+	if message == "" {
+		return nil
+	}
+	return strings.Split(message, "\n")
+}
+
+func (instance *Pretty) formatField(ctx fields.FilterContext, k string, v interface{}, h hints.Hints, using log.Provider) (string, []string, error) {
+	if vl, ok := v.(fields.Filtered); ok {
+		fv, shouldBeRespected := vl.Filter(ctx)
+		if !shouldBeRespected {
+			return "", nil, nil
+		}
+		v = fv
+	} else if vl, ok := v.(fields.Lazy); ok {
+		v = vl.Get()
+	}
+	if v == fields.Exclude {
+		return "", nil, nil
+	}
+
+	keysSpec := using.GetFieldKeysSpec()
+	switch k {
+	case keysSpec.GetMessage(), keysSpec.GetTimestamp(), keysSpec.GetLogger(), keysSpec.GetError():
+		return "", nil, nil
+	}
+
+	theme := instance.getTheme()
+	valueColor := theme.FieldColor(k)
+	key := instance.colorize(theme.Key, h, k)
+
+	if s, ok := multiLineStringOf(v); ok {
+		block := []string{"  " + key + ":"}
+		for _, line := range strings.Split(s, "\n") {
+			block = append(block, "    "+instance.colorize(valueColor, h, line))
+		}
+		return "", block, nil
+	}
+
+	b, err := instance.getValueFormatter().FormatTextValue(v, using)
+	if err != nil {
+		return "", nil, err
+	}
+	return key + `=` + instance.colorize(valueColor, h, string(b)), nil, nil
+}
+
+func (instance *Pretty) formatError(err error, h hints.Hints) []string {
+	theme := instance.getTheme()
+	message := strings.ReplaceAll(err.Error(), "\r", "")
+	var result []string
+	for i, line := range strings.Split(message, "\n") {
+		if i == 0 {
+			line = "error: " + line
+		}
+		result = append(result, "  "+instance.colorize(theme.Error, h, line))
+	}
+
+	detailed := strings.ReplaceAll(fmt.Sprintf("%+v", err), "\r", "")
+	if stack := strings.TrimPrefix(detailed, err.Error()); stack != detailed {
+		stack = strings.TrimRightFunc(strings.TrimLeft(stack, "\n"), unicode.IsSpace)
+		if stack != "" {
+			for _, line := range strings.Split(stack, "\n") {
+				result = append(result, "    "+instance.colorize(theme.Stack, h, line))
+			}
+		}
+	}
+	return result
+}
+
+func multiLineStringOf(v interface{}) (string, bool) {
+	var s string
+	switch vv := v.(type) {
+	case string:
+		s = vv
+	case *string:
+		if vv == nil {
+			return "", false
+		}
+		s = *vv
+	case error:
+		s = vv.Error()
+	case fmt.Stringer:
+		s = vv.String()
+	default:
+		return "", false
+	}
+	s = strings.TrimRightFunc(strings.ReplaceAll(s, "\r", ""), unicode.IsSpace)
+	return s, strings.ContainsRune(s, '\n')
+}
+
+func (instance *Pretty) colorize(c color.Color, h hints.Hints, what string) string {
+	if what == "" || !functions.ShouldColorize(h) {
+		return what
+	}
+	return c.Colorize(instance.getDepth(h), what)
+}
+
+func (instance *Pretty) colorizeLevel(l level.Level, h hints.Hints, what string) string {
+	if !functions.ShouldColorize(h) {
+		return what
+	}
+	if vh, ok := h.(hints.LevelColorizer); ok {
+		if v := vh.LevelColorizer(); v != nil {
+			return v.ColorizeByLevel(l, what)
+		}
+	}
+	return instance.getTheme().LevelColor(l).Colorize(instance.getDepth(h), what)
+}
+
+func (instance *Pretty) getDepth(h hints.Hints) color.Depth {
+	if v, ok := h.(hints.ColorDepth); ok {
+		return v.ColorDepth()
+	}
+	return color.Depth16
+}
+
+func (instance *Pretty) GetColorMode() color.Mode {
+	return instance.ColorMode
+}
+
+func (instance *Pretty) SetColorMode(v color.Mode) {
+	instance.ColorMode = v
+}
+
+func (instance *Pretty) wrapHints(h hints.Hints) hints.Hints {
+	return prettyHintsCombined{h, instance.prettyAsHints}
+}
+
+func (instance *Pretty) getLevelNames(using log.Provider) level.Names {
+	if v, ok := using.(level.NamesAware); ok {
+		return v.GetLevelNames()
+	}
+	if v := nlevel.DefaultNames; v != nil {
+		return v
+	}
+	return nlevel.NewNames()
+}
+
+func (instance *Pretty) getTheme() *color.Theme {
+	if v := instance.Theme; v != nil {
+		return v
+	}
+	if v := color.DefaultTheme; v != nil {
+		return v
+	}
+	return &color.Theme{}
+}
+
+func (instance *Pretty) getRelativeTimestamps() bool {
+	if v := instance.RelativeTimestamps; v != nil {
+		return *v
+	}
+	//goland:noinspection GoBoolExpressions
+	return DefaultPrettyRelativeTimestamps
+}
+
+func (instance *Pretty) getTimeLayout() string {
+	if v := instance.TimeLayout; v != "" {
+		return v
+	}
+	return DefaultTimeLayout
+}
+
+func (instance *Pretty) getTimestampWidth() int8 {
+	if v := instance.TimestampWidth; v != nil {
+		return *v
+	}
+	return DefaultPrettyTimestampWidth
+}
+
+func (instance *Pretty) getLevelWidth() int8 {
+	if v := instance.LevelWidth; v != nil {
+		return *v
+	}
+	return DefaultLevelWidth
+}
+
+func (instance *Pretty) getLoggerWidth() int16 {
+	if v := instance.LoggerWidth; v != nil {
+		return *v
+	}
+	return DefaultPrettyLoggerWidth
+}
+
+func (instance *Pretty) getLoggerNameShortener() naming.Shortener {
+	if v := instance.LoggerNameShortener; v != nil {
+		return v
+	}
+	if v := naming.DefaultShortener; v != nil {
+		return v
+	}
+	return naming.NoopShortener()
+}
+
+func (instance *Pretty) getMinMessageWidth() int16 {
+	if v := instance.MinMessageWidth; v != nil {
+		return *v
+	}
+	return DefaultMinMessageWidth
+}
+
+func (instance *Pretty) getPrintRootLogger() bool {
+	if v := instance.PrintRootLogger; v != nil {
+		return *v
+	}
+	//goland:noinspection GoBoolExpressions
+	return DefaultPrintRootLogger
+}
+
+func (instance *Pretty) getValueFormatter() TextValue {
+	if v := instance.ValueFormatter; v != nil {
+		return v
+	}
+	if v := DefaultTextValue; v != nil {
+		return v
+	}
+	return NoopTextValue()
+}
+
+func (instance *Pretty) getKeySorter() fields.KeySorter {
+	if v := instance.KeySorter; v != nil {
+		return v
+	}
+	return fields.DefaultKeySorter
+}
+
+type prettyAsHints struct {
+	*Pretty
+}
+
+func (instance *prettyAsHints) ColorMode() color.Mode {
+	return instance.Pretty.ColorMode
+}
+
+func (instance *prettyAsHints) LevelColorizer() nlevel.Colorizer {
+	return instance.Pretty.LevelColorizer
+}
+
+type prettyHintsCombined struct {
+	hints.Hints
+	*prettyAsHints
+}
+
+func (instance prettyHintsCombined) ColorMode() color.Mode {
+	if v, ok := instance.Hints.(hints.ColorMode); ok {
+		return v.ColorMode()
+	}
+	return instance.prettyAsHints.ColorMode()
+}
+
+func (instance prettyHintsCombined) LevelColorizer() nlevel.Colorizer {
+	if v, ok := instance.Hints.(hints.LevelColorizer); ok {
+		return v.LevelColorizer()
+	}
+	return instance.prettyAsHints.LevelColorizer()
+}
+
+func (instance prettyHintsCombined) IsColorSupported() color.Supported {
+	if v, ok := instance.Hints.(hints.ColorsSupport); ok {
+		return v.IsColorSupported()
+	}
+	return color.SupportedNone
+}
+
+func (instance prettyHintsCombined) ColorDepth() color.Depth {
+	if v, ok := instance.Hints.(hints.ColorDepth); ok {
+		return v.ColorDepth()
+	}
+	return color.Depth16
+}
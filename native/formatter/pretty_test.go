@@ -0,0 +1,232 @@
+package formatter
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+	"time"
+
+	log "github.com/echocat/slf4g"
+	"github.com/echocat/slf4g/fields"
+	"github.com/echocat/slf4g/internal/test/assert"
+	"github.com/echocat/slf4g/level"
+	"github.com/echocat/slf4g/native/color"
+	"github.com/echocat/slf4g/native/naming"
+	"github.com/echocat/slf4g/testing/recording"
+)
+
+func Test_NewPretty(t *testing.T) {
+	actual := NewPretty()
+
+	assert.ToBeEqual(t, color.ModeAuto, actual.ColorMode)
+	assert.ToBeNil(t, actual.LevelColorizer)
+	assert.ToBeNil(t, actual.Theme)
+	assert.ToBeNil(t, actual.RelativeTimestamps)
+	assert.ToBeEqual(t, "", actual.TimeLayout)
+	assert.ToBeNil(t, actual.TimestampWidth)
+	assert.ToBeNil(t, actual.LevelWidth)
+	assert.ToBeNil(t, actual.LoggerWidth)
+	assert.ToBeNil(t, actual.LoggerNameShortener)
+	assert.ToBeNil(t, actual.MinMessageWidth)
+	assert.ToBeNil(t, actual.PrintRootLogger)
+	assert.ToBeNil(t, actual.ValueFormatter)
+	assert.ToBeNil(t, actual.KeySorter)
+}
+
+func Test_Pretty_Format(t *testing.T) {
+	givenProvider := recording.NewProvider()
+	givenLogger := givenProvider.GetLogger("github.com/echocat/slf4g/native")
+	givenTimestamp := mustParseTime("2021-01-02T13:14:15.1234")
+	givenMinMessageWidth := int16(10)
+
+	instance := NewPretty(func(v *Pretty) {
+		v.MinMessageWidth = &givenMinMessageWidth
+	})
+
+	cases := []struct {
+		name     string
+		given    log.Event
+		expected string
+	}{{
+		name: "simple",
+		given: givenLogger.NewEvent(level.Info, nil).
+			With("logger", givenLogger).
+			With("timestamp", givenTimestamp).
+			With("message", "hello").
+			With("foo", 1).
+			With("bar", "a b"),
+		expected: "     +0s  INFO g.c.e.slf4g.native   hello      bar=\"a b\" foo=1\n",
+	}, {
+		name: "relative",
+		given: givenLogger.NewEvent(level.Warn, nil).
+			With("logger", givenLogger).
+			With("timestamp", givenTimestamp.Add(12*time.Millisecond)).
+			With("message", "world"),
+		expected: "   +12ms  WARN g.c.e.slf4g.native   world\n",
+	}, {
+		name: "root logger without message",
+		given: givenLogger.NewEvent(level.Debug, nil).
+			With("logger", "ROOT").
+			With("timestamp", givenTimestamp.Add(1012*time.Millisecond)).
+			With("foo", fields.LazyFormat("%d", 1)),
+		expected: "     +1s DEBUG                      foo=1\n",
+	}, {
+		name: "multiline",
+		given: givenLogger.NewEvent(level.Info, nil).
+			With("logger", "foo").
+			With("message", "line1\nline2\n").
+			With("body", "a\nb").
+			With("foo", 1),
+		expected: "          INFO foo                  line1      foo=1\n" +
+			"    line2\n" +
+			"  body:\n" +
+			"    a\n" +
+			"    b\n",
+	}, {
+		name: "error",
+		given: givenLogger.NewEvent(level.Error, nil).
+			With("logger", "foo").
+			With("message", "failed").
+			With("error", &stackedError{"expected", "main.main\n\t/app/main.go:12"}),
+		expected: "         ERROR foo                  failed\n" +
+			"  error: expected\n" +
+			"    main.main\n" +
+			"    \t/app/main.go:12\n",
+	}}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			actual, actualErr := instance.Format(c.given, givenProvider, nil)
+
+			assert.ToBeNoError(t, actualErr)
+			assert.ToBeEqual(t, c.expected, string(actual))
+		})
+	}
+}
+
+func Test_Pretty_Format_colorized(t *testing.T) {
+	givenProvider := recording.NewProvider()
+	givenLogger := givenProvider.GetLogger("foo")
+	givenLoggerWidth := int16(0)
+	givenEvent := givenLogger.NewEvent(level.Info, nil).
+		With("logger", givenLogger).
+		With("message", "hello").
+		With("user", "bar").
+		With("id", 1).
+		With("error", errors.New("doh"))
+
+	instance := NewPretty(func(v *Pretty) {
+		v.ColorMode = color.ModeAlways
+		v.LoggerWidth = &givenLoggerWidth
+		v.Theme = &color.Theme{
+			Levels: map[level.Level]color.Color{level.Info: color.Blue.Bold()},
+			Logger: color.Cyan,
+			Key:    color.Blue,
+			Fields: map[string]color.Color{"user": color.Magenta},
+			Error:  color.Red,
+		}
+	})
+
+	actual, actualErr := instance.Format(givenEvent, givenProvider, nil)
+
+	assert.ToBeNoError(t, actualErr)
+	assert.ToBeEqual(t, "         \x1b[34;1m INFO\x1b[0m \x1b[36mfoo\x1b[0m hello                                              \x1b[34mid\x1b[0m=1 \x1b[34muser\x1b[0m=\x1b[35mbar\x1b[0m\n"+
+		"  \x1b[31merror: doh\x1b[0m\n", string(actual))
+}
+
+func Test_Pretty_Format_colorizedWithDepth(t *testing.T) {
+	givenProvider := recording.NewProvider()
+	givenLogger := givenProvider.GetLogger("foo")
+	givenLoggerWidth := int16(0)
+	givenEvent := givenLogger.NewEvent(level.Info, nil).
+		With("logger", givenLogger).
+		With("message", "hello")
+
+	instance := NewPretty(func(v *Pretty) {
+		v.ColorMode = color.ModeAlways
+		v.LoggerWidth = &givenLoggerWidth
+		v.Theme = &color.Theme{
+			Levels: map[level.Level]color.Color{level.Info: color.Rgb(255, 135, 0)},
+			Logger: color.Ansi256(208),
+		}
+	})
+
+	cases := []struct {
+		given    color.Depth
+		expected string
+	}{
+		{color.DepthTrueColor, "         \x1b[38;2;255;135;0m INFO\x1b[0m \x1b[38;5;208mfoo\x1b[0m hello\n"},
+		{color.Depth256, "         \x1b[38;5;208m INFO\x1b[0m \x1b[38;5;208mfoo\x1b[0m hello\n"},
+		{color.Depth16, "         \x1b[33m INFO\x1b[0m \x1b[33mfoo\x1b[0m hello\n"},
+	}
+
+	for _, c := range cases {
+		t.Run(c.given.String(), func(t *testing.T) {
+			actual, actualErr := instance.Format(givenEvent, givenProvider, mockColorDepthHints(c.given))
+
+			assert.ToBeNoError(t, actualErr)
+			assert.ToBeEqual(t, c.expected, string(actual))
+		})
+	}
+}
+
+func Test_Pretty_Format_withLoggerNameShortener(t *testing.T) {
+	givenProvider := recording.NewProvider()
+	givenLogger := givenProvider.GetLogger("github.com/acme/platform/internal/storage/postgres")
+	givenEvent := givenLogger.NewEvent(level.Info, nil).
+		With("logger", givenLogger).
+		With("message", "hello")
+
+	instance := NewPretty(func(v *Pretty) {
+		v.LoggerNameShortener = naming.NewStrategy(func(v *naming.Strategy) {
+			v.StripPrefixes = []string{"github.com/acme/platform"}
+		})
+	})
+
+	actual, actualErr := instance.Format(givenEvent, givenProvider, nil)
+
+	assert.ToBeNoError(t, actualErr)
+	assert.ToBeEqual(t, "          INFO i.storage.postgres   hello\n", string(actual))
+}
+
+func Test_Pretty_Format_absoluteTimestamps(t *testing.T) {
+	givenProvider := recording.NewProvider()
+	givenLogger := givenProvider.GetLogger("foo")
+	givenRelativeTimestamps := false
+	givenEvent := givenLogger.NewEvent(level.Info, nil).
+		With("logger", givenLogger).
+		With("timestamp", mustParseTime("2021-01-02T13:14:15.1234")).
+		With("message", "hello")
+
+	instance := NewPretty(func(v *Pretty) {
+		v.RelativeTimestamps = &givenRelativeTimestamps
+	})
+
+	actual, actualErr := instance.Format(givenEvent, givenProvider, nil)
+
+	assert.ToBeNoError(t, actualErr)
+	assert.ToBeEqual(t, "13:14:15.123  INFO foo                  hello\n", string(actual))
+}
+
+type stackedError struct {
+	message string
+	stack   string
+}
+
+func (instance *stackedError) Error() string {
+	return instance.message
+}
+
+func (instance *stackedError) Format(s fmt.State, verb rune) {
+	if verb == 'v' && s.Flag('+') {
+		_, _ = fmt.Fprintf(s, "%s\n%s", instance.message, instance.stack)
+		return
+	}
+	_, _ = fmt.Fprint(s, instance.message)
+}
+
+type mockColorDepthHints color.Depth
+
+func (instance mockColorDepthHints) ColorDepth() color.Depth {
+	return color.Depth(instance)
+}
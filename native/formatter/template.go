@@ -2,6 +2,8 @@ package formatter
 
 import (
 	"bytes"
+	"fmt"
+	"runtime"
 	"text/template"
 	"time"
 
@@ -12,10 +14,28 @@ import (
 	"github.com/echocat/slf4g/native/formatter/functions"
 	"github.com/echocat/slf4g/native/hints"
 	nlevel "github.com/echocat/slf4g/native/level"
+	"github.com/echocat/slf4g/native/location"
+	"github.com/echocat/slf4g/native/naming"
 )
 
 // Template is an implementation of Formatter which formats given log entries in a
 // human readable format, formatted by a template it was created with.
+//
+// The template is executed with a TemplateRenderingContext and can use
+// (besides the text/template builtins) the following functions; see the
+// corresponding functions of package functions for details and examples:
+//
+//	colorizeByLevel, colorize, shouldColorize, levelColorizer: coloring
+//	indentMultiline, ensureWidth, pad, truncate, upper, lower: strings
+//	shortenName, abbreviateName, stripNamePrefix:              shortening of logger names
+//	default:                                                   fallback values
+//	json, logfmt:                                              encoding of single values
+//	humanizeDuration, humanizeTime, humanizeBytes:             humanization
+//	without, only:                                             selection of fields
+//
+// Example which prints some explicit fields followed by all remaining ones:
+//
+//	{{.LevelName | pad -5}} {{.Message}} user={{.Field "user" | default "-"}}{{range $k, $v := without .Fields "user"}} {{$k}}={{logfmt $v}}{{end}}
 type Template struct {
 	// ColorMode defines when the output should be colorized. If not configured
 	// color.ModeAuto will be used by default.
@@ -25,6 +45,11 @@ type Template struct {
 	// log.Event to be logged. If not set nlevel.DefaultColorizer will be used.
 	LevelColorizer nlevel.Colorizer
 
+	// LoggerNameShortener is used by the template function shortenName to
+	// shorten logger names (for example {{.Logger | shortenName}}). If not
+	// set naming.DefaultShortener will be used.
+	LoggerNameShortener naming.Shortener
+
 	template *template.Template
 }
 
@@ -125,9 +150,42 @@ func (instance *Template) toFuncMap() template.FuncMap {
 		"indentMultiline": functions.IndentMultiline,
 
 		"ensureWidth": functions.EnsureWidth,
+		"pad":         functions.Pad,
+		"truncate":    functions.Truncate,
+		"upper":       functions.Upper,
+		"lower":       functions.Lower,
+		"default":     functions.Default,
+
+		"shortenName":     instance.shortenName,
+		"abbreviateName":  functions.AbbreviateName,
+		"stripNamePrefix": functions.StripNamePrefix,
+
+		"json":   functions.Json,
+		"logfmt": functions.Logfmt,
+
+		"humanizeDuration": functions.HumanizeDuration,
+		"humanizeTime":     functions.HumanizeTime,
+		"humanizeBytes":    functions.HumanizeBytes,
+
+		"without": functions.Without,
+		"only":    functions.Only,
 	}
 }
 
+func (instance *Template) shortenName(of string) string {
+	return instance.getLoggerNameShortener().Shorten(of)
+}
+
+func (instance *Template) getLoggerNameShortener() naming.Shortener {
+	if v := instance.LoggerNameShortener; v != nil {
+		return v
+	}
+	if v := naming.DefaultShortener; v != nil {
+		return v
+	}
+	return naming.NoopShortener()
+}
+
 // TemplateFactory will create for the given rootFuncMap a new instance
 // of template.Template.
 type TemplateFactory func(rootFuncMap template.FuncMap) (*template.Template, error)
@@ -206,13 +264,81 @@ func (instance TemplateRenderingContext) Logger() *string {
 	return log.GetLoggerOf(instance.Event, instance.Provider)
 }
 
+// Location is a convenience method to easy return the current location (for
+// example the calling method) of the corresponding log.Event, can be nil.
+func (instance TemplateRenderingContext) Location() *string {
+	v, _ := instance.Get(instance.locationKey())
+	if vl, ok := v.(fields.Lazy); ok {
+		v = vl.Get()
+	}
+	switch vv := v.(type) {
+	case nil:
+		return nil
+	case string:
+		return &vv
+	default:
+		result := fmt.Sprint(vv)
+		return &result
+	}
+}
+
+// Frame is a convenience method to easy return the runtime.Frame of the
+// current location of the corresponding log.Event, can be nil. It is only
+// available if the location was discovered by a location.Caller.
+func (instance TemplateRenderingContext) Frame() *runtime.Frame {
+	v, _ := instance.Get(instance.locationKey())
+	if vc, ok := v.(location.Caller); ok {
+		result := vc.GetFrame()
+		return &result
+	}
+	return nil
+}
+
+func (instance TemplateRenderingContext) locationKey() string {
+	if v, ok := instance.FieldKeysSpec().(interface {
+		GetLocation() string
+	}); ok {
+		return v.GetLocation()
+	}
+	return "location"
+}
+
+// Field is a convenience method to easy return the value of the given key of
+// the corresponding log.Event, can be nil. Values of type fields.Filtered and
+// fields.Lazy are already resolved.
+func (instance TemplateRenderingContext) Field(key string) interface{} {
+	v, ok := instance.Get(key)
+	if !ok {
+		return nil
+	}
+	v, _ = instance.resolve(v)
+	return v
+}
+
+func (instance TemplateRenderingContext) resolve(v interface{}) (interface{}, bool) {
+	if vl, ok := v.(fields.Filtered); ok {
+		fv, shouldBeRespected := vl.Filter(instance.Event)
+		if !shouldBeRespected {
+			return nil, false
+		}
+		v = fv
+	} else if vl, ok := v.(fields.Lazy); ok {
+		v = vl.Get()
+	}
+	if v == fields.Exclude {
+		return nil, false
+	}
+	return v, true
+}
+
 // FieldKeysSpec is a convenience method to easy return the current fields.KeysSpec
 // of the corresponding log.Event.
 func (instance TemplateRenderingContext) FieldKeysSpec() fields.KeysSpec {
 	return instance.Provider.GetFieldKeysSpec()
 }
 
-// Fields returns all fields of the log.Event (except the default ones).
+// Fields returns all fields of the log.Event (except the default ones). Values
+// of type fields.Filtered and fields.Lazy are already resolved.
 func (instance TemplateRenderingContext) Fields() (result map[string]interface{}, err error) {
 	result = make(map[string]interface{})
 	err = instance.ForEach(func(key string, value interface{}) error {
@@ -220,10 +346,11 @@ func (instance TemplateRenderingContext) Fields() (result map[string]interface{}
 		switch key {
 		case keysSpec.GetLogger(), keysSpec.GetMessage(), keysSpec.GetTimestamp(), keysSpec.GetError():
 			return nil
-		default:
-			result[key] = value
-			return nil
 		}
+		if v, ok := instance.resolve(value); ok {
+			result[key] = v
+		}
+		return nil
 	})
 	return
 }
@@ -6,15 +6,19 @@ import (
 	"fmt"
 	"testing"
 	"text/template"
+	"time"
 
 	"github.com/echocat/slf4g/native/hints"
 
+	"github.com/echocat/slf4g/fields"
 	"github.com/echocat/slf4g/level"
 	"github.com/echocat/slf4g/testing/recording"
 
 	"github.com/echocat/slf4g/native/formatter/functions"
 
 	nlevel "github.com/echocat/slf4g/native/level"
+	"github.com/echocat/slf4g/native/location"
+	"github.com/echocat/slf4g/native/naming"
 
 	"github.com/echocat/slf4g/native/color"
 
@@ -142,13 +146,25 @@ func Test_Template_toFuncMap(t *testing.T) {
 	actual := (&Template{}).toFuncMap()
 
 	assert.ToBeNotNil(t, actual)
-	assert.ToBeEqual(t, 6, len(actual))
+	assert.ToBeEqual(t, 21, len(actual))
 	assert.ToBeSame(t, functions.ColorizeByLevel, actual["colorizeByLevel"])
 	assert.ToBeSame(t, functions.Colorize, actual["colorize"])
 	assert.ToBeSame(t, functions.ShouldColorize, actual["shouldColorize"])
 	assert.ToBeSame(t, functions.LevelColorizer, actual["levelColorizer"])
 	assert.ToBeSame(t, functions.IndentMultiline, actual["indentMultiline"])
 	assert.ToBeSame(t, functions.EnsureWidth, actual["ensureWidth"])
+	assert.ToBeSame(t, functions.Pad, actual["pad"])
+	assert.ToBeSame(t, functions.Truncate, actual["truncate"])
+	assert.ToBeSame(t, functions.Upper, actual["upper"])
+	assert.ToBeSame(t, functions.Lower, actual["lower"])
+	assert.ToBeSame(t, functions.Default, actual["default"])
+	assert.ToBeSame(t, functions.Json, actual["json"])
+	assert.ToBeSame(t, functions.Logfmt, actual["logfmt"])
+	assert.ToBeSame(t, functions.HumanizeDuration, actual["humanizeDuration"])
+	assert.ToBeSame(t, functions.HumanizeTime, actual["humanizeTime"])
+	assert.ToBeSame(t, functions.HumanizeBytes, actual["humanizeBytes"])
+	assert.ToBeSame(t, functions.Without, actual["without"])
+	assert.ToBeSame(t, functions.Only, actual["only"])
 }
 
 func Test_Template_Format(t *testing.T) {
@@ -169,6 +185,44 @@ func Test_Template_Format(t *testing.T) {
 	assert.ToBeEqual(t, "2021-01-02T13:14:15,aLogger,WARN,aMess,anError,map[foo:bar]", string(actual))
 }
 
+func Test_Template_Format_withFunctions(t *testing.T) {
+	givenProvider := recording.NewProvider()
+	givenLogger := givenProvider.GetLogger("aLogger")
+	givenEvent := givenLogger.NewEvent(level.Warn, nil).
+		With("message", "aMessage").
+		With("user", "foo bar").
+		With("took", 1234567*time.Microsecond).
+		With("size", 1536).
+		With("tags", []string{"a", "b"})
+
+	instance := MustNewTemplate("{{.LevelName | lower | pad -5}}|{{.Message | truncate 4 | upper}}|{{.Field `user` | logfmt}}|{{.Field `tenant` | default `none`}}|" +
+		"{{.Field `took` | humanizeDuration}}|{{.Field `size` | humanizeBytes}}|{{.Field `tags` | json}}|" +
+		"{{range $k, $v := without .Fields `user` `tags`}} {{$k}}={{logfmt $v}}{{end}}")
+
+	actual, actualErr := instance.Format(givenEvent, givenProvider, nil)
+
+	assert.ToBeNoError(t, actualErr)
+	assert.ToBeEqual(t, ` warn|AME…|"foo bar"|none|1.23s|1.5 KiB|["a","b"]| size=1536 took=1.234567s`, string(actual))
+}
+
+func Test_Template_Format_withNameFunctions(t *testing.T) {
+	givenProvider := recording.NewProvider()
+	givenLogger := givenProvider.GetLogger("github.com/acme/platform/internal/storage/postgres")
+	givenEvent := givenLogger.NewEvent(level.Warn, nil).
+		With("logger", givenLogger)
+
+	instance := MustNewTemplate("{{.Logger | shortenName}}|{{.Logger | abbreviateName 20}}|{{.Logger | stripNamePrefix `github.com/acme`}}", func(v *Template) {
+		v.LoggerNameShortener = naming.NewStrategy(func(v *naming.Strategy) {
+			v.Aliases = map[string]string{"github.com/acme/platform/internal/storage": "storage"}
+		})
+	})
+
+	actual, actualErr := instance.Format(givenEvent, givenProvider, nil)
+
+	assert.ToBeNoError(t, actualErr)
+	assert.ToBeEqual(t, `storage/postgres|g.c.a.p.i.s.postgres|platform/internal/storage/postgres`, string(actual))
+}
+
 func Test_Template_Format_failing(t *testing.T) {
 	givenProvider := recording.NewProvider()
 	givenLogger := givenProvider.GetLogger("aLogger")
@@ -323,6 +377,45 @@ func Test_TemplateRenderingContext_Logger(t *testing.T) {
 	assert.ToBeEqual(t, "aLogger", *actual)
 }
 
+func Test_TemplateRenderingContext_Field(t *testing.T) {
+	instance := newTestTemplateRenderingContext(nil)
+	instance.Event = instance.Event.
+		Withf("lazy", "foo%d", 1).
+		With("filteredOut", fields.RequireMaximalLevel(level.Info, "invisible"))
+
+	assert.ToBeEqual(t, "bar", instance.Field("foo"))
+	assert.ToBeEqual(t, "foo1", instance.Field("lazy"))
+	assert.ToBeNil(t, instance.Field("filteredOut"))
+	assert.ToBeNil(t, instance.Field("absent"))
+}
+
+func Test_TemplateRenderingContext_Location(t *testing.T) {
+	instance := newTestTemplateRenderingContext(nil)
+	assert.ToBeNil(t, instance.Location())
+	assert.ToBeNil(t, instance.Frame())
+
+	instance.Event = instance.Event.With("location", location.NewCallerDiscovery().DiscoverLocation(instance.Event, 0))
+
+	actualLocation := instance.Location()
+	actualFrame := instance.Frame()
+
+	assert.ToBeNotNil(t, actualLocation)
+	assert.ToBeMatching(t, `^formatter\.Test_TemplateRenderingContext_Location:\d+$`, *actualLocation)
+	assert.ToBeNotNil(t, actualFrame)
+	assert.ToBeMatching(t, `template_test\.go$`, actualFrame.File)
+}
+
+func Test_TemplateRenderingContext_Location_plain(t *testing.T) {
+	instance := newTestTemplateRenderingContext(nil)
+	instance.Event = instance.Event.With("location", location.DepthOnly(3))
+
+	actualLocation := instance.Location()
+
+	assert.ToBeNotNil(t, actualLocation)
+	assert.ToBeEqual(t, "3", *actualLocation)
+	assert.ToBeNil(t, instance.Frame())
+}
+
 func Test_TemplateRenderingContext_Fields(t *testing.T) {
 	instance := newTestTemplateRenderingContext(nil)
 
@@ -333,6 +426,24 @@ func Test_TemplateRenderingContext_Fields(t *testing.T) {
 	assert.ToBeEqual(t, map[string]interface{}{"foo": "bar"}, actual)
 }
 
+func Test_TemplateRenderingContext_Fields_resolvesLazyAndFiltered(t *testing.T) {
+	instance := newTestTemplateRenderingContext(nil)
+	instance.Event = instance.Event.
+		Withf("lazy", "foo%d", 1).
+		With("excluded", fields.Exclude).
+		With("filtered", fields.RequireMaximalLevel(level.Error, "visible")).
+		With("filteredOut", fields.RequireMaximalLevel(level.Info, "invisible"))
+
+	actual, actualErr := instance.Fields()
+
+	assert.ToBeNoError(t, actualErr)
+	assert.ToBeEqual(t, map[string]interface{}{
+		"foo":      "bar",
+		"lazy":     "foo1",
+		"filtered": "visible",
+	}, actual)
+}
+
 func newTestTemplateRenderingContext(h hints.Hints) TemplateRenderingContext {
 	givenProvider := recording.NewProvider()
 	givenLogger := givenProvider.GetLogger("aLogger")
@@ -16,6 +16,7 @@ import (
 	"github.com/echocat/slf4g/native/formatter/functions"
 	"github.com/echocat/slf4g/native/hints"
 	nlevel "github.com/echocat/slf4g/native/level"
+	"github.com/echocat/slf4g/native/naming"
 )
 
 var (
@@ -100,6 +101,11 @@ type Text struct {
 	// DefaultPrintRootLogger will be used.
 	PrintRootLogger *bool
 
+	// LoggerNameShortener is used to shorten the logger names (for example by
+	// abbreviating them, stripping module prefixes or by applying aliases; see
+	// naming.Strategy). If not set naming.DefaultShortener will be used.
+	LoggerNameShortener naming.Shortener
+
 	// ValueFormatter is used to format the field values (not the message). If
 	// not set formatter.DefaultTextValue will be used.
 	ValueFormatter TextValue
@@ -236,6 +242,9 @@ func (instance *Text) printField(ctx fields.FilterContext, k string, v interface
 	if k == keysSpec.GetMessage() || k == keysSpec.GetTimestamp() {
 		return false, nil
 	}
+	if k == keysSpec.GetLogger() {
+		v = instance.shortenLoggerName(v)
+	}
 	b, err := instance.getValueFormatter().FormatTextValue(v, using)
 	if err != nil {
 		return false, err
@@ -267,6 +276,17 @@ func (instance *Text) printMessageAsMultiLineIfRequiredChecked(message *string,
 	return nil
 }
 
+func (instance *Text) shortenLoggerName(v interface{}) interface{} {
+	switch vv := v.(type) {
+	case string:
+		return instance.getLoggerNameShortener().Shorten(vv)
+	case interface{ GetName() string }:
+		return instance.getLoggerNameShortener().Shorten(vv.GetName())
+	default:
+		return v
+	}
+}
+
 func (instance *Text) colorize(l level.Level, message string, h hints.Hints) string {
 	return functions.ColorizeByLevel(
 		l,
@@ -368,6 +388,16 @@ func (instance *Text) getPrintRootLogger() bool {
 	return DefaultPrintRootLogger
 }
 
+func (instance *Text) getLoggerNameShortener() naming.Shortener {
+	if v := instance.LoggerNameShortener; v != nil {
+		return v
+	}
+	if v := naming.DefaultShortener; v != nil {
+		return v
+	}
+	return naming.NoopShortener()
+}
+
 func (instance *Text) getValueFormatter() TextValue {
 	if v := instance.ValueFormatter; v != nil {
 		return v
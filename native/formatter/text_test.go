@@ -15,6 +15,7 @@ import (
 	"github.com/echocat/slf4g/native/formatter/encoding"
 	"github.com/echocat/slf4g/native/hints"
 	nlevel "github.com/echocat/slf4g/native/level"
+	"github.com/echocat/slf4g/native/naming"
 	"github.com/echocat/slf4g/testing/recording"
 )
 
@@ -29,6 +30,7 @@ func Test_NewText(t *testing.T) {
 	assert.ToBeNil(t, actual.MultiLineMessageAfterFields)
 	assert.ToBeNil(t, actual.AllowMultiLineMessage)
 	assert.ToBeNil(t, actual.PrintRootLogger)
+	assert.ToBeNil(t, actual.LoggerNameShortener)
 	assert.ToBeNil(t, actual.ValueFormatter)
 	assert.ToBeNil(t, actual.KeySorter)
 }
@@ -482,6 +484,38 @@ func Test_Text_printField(t *testing.T) {
 		})
 	}
 }
+func Test_Text_printField_withLoggerNameShortener(t *testing.T) {
+	instance := NewText(func(text *Text) {
+		text.ColorMode = color.ModeNever
+		text.LoggerNameShortener = naming.NewStrategy(func(v *naming.Strategy) {
+			v.Aliases = map[string]string{"github.com/acme/platform/internal/storage": "storage"}
+			v.StripPrefixes = []string{"github.com/acme/platform"}
+		})
+	})
+	provider := recording.NewProvider()
+
+	cases := []struct {
+		givenKey   string
+		givenValue interface{}
+		expected   string
+	}{
+		{"logger", "github.com/acme/platform/internal/storage/postgres", " logger=storage/postgres"},
+		{"logger", "github.com/acme/platform/internal/http", " logger=internal/http"},
+		{"logger", provider.GetLogger("github.com/acme/platform/cmd"), " logger=cmd"},
+		{"foo", "github.com/acme/platform/cmd", " foo=github.com/acme/platform/cmd"},
+	}
+
+	for _, c := range cases {
+		t.Run(c.expected, func(t *testing.T) {
+			givenEncoder := encoding.NewBufferedTextEncoder()
+			_, actualErr := instance.printField(simpleFilterContext{level.Info}, c.givenKey, c.givenValue, nil, provider, givenEncoder)
+
+			assert.ToBeNoError(t, actualErr)
+			assert.ToBeEqual(t, c.expected, givenEncoder.String())
+		})
+	}
+}
+
 func Test_Text_printField_failsWithValueFormatter(t *testing.T) {
 	expectedErr := errors.New("expected")
 	instance := NewText(func(text *Text) {
@@ -13,6 +13,15 @@ type ColorsSupport interface {
 	IsColorSupported() color.Supported
 }
 
+// ColorDepth are Hints that provide the information how many colors are
+// supported.
+type ColorDepth interface {
+	Hints
+
+	// ColorDepth returns the supported color.Depth.
+	ColorDepth() color.Depth
+}
+
 // ColorMode are Hints that provide the information how something should be colorized.
 type ColorMode interface {
 	Hints
@@ -48,10 +48,13 @@ func (instance *Interceptors) Add(v Interceptor) *Interceptors {
 }
 
 // OnBeforeLog implements Interceptor.OnBeforeLog()
+//
+// Audit events (see log.IsAudit()) are never passed to the contained
+// interceptors; they can neither be modified nor dropped.
 func (instance Interceptors) OnBeforeLog(event log.Event, provider log.Provider) (intercepted log.Event) {
 	intercepted = event
 
-	if intercepted == nil {
+	if intercepted == nil || log.IsAudit(intercepted) {
 		return
 	}
 
@@ -79,6 +79,22 @@ func Test_Interceptors_OnBeforeLog_withNilEvent(t *testing.T) {
 	}
 }
 
+func Test_Interceptors_OnBeforeLog_withAuditEvent(t *testing.T) {
+	givenLogger := recording.NewLogger()
+	givenEvent := log.MarkAsAudit(givenLogger.NewEventWithFields(level.Warn, fields.With("foo", "bar")))
+
+	instance := Interceptors{
+		OnBeforeLogFunc(func(log.Event, log.Provider) log.Event {
+			t.Error("should not be called")
+			return nil
+		}),
+	}
+
+	actual := instance.OnBeforeLog(givenEvent, givenLogger.Provider)
+
+	assert.ToBeSame(t, givenEvent, actual)
+}
+
 func Test_Interceptors_OnBeforeLog_oneReturnsNil(t *testing.T) {
 	givenLogger := recording.NewLogger()
 	givenEvent := givenLogger.NewEventWithFields(level.Warn, fields.With("foo", "bar"))
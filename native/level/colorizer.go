@@ -2,6 +2,7 @@ package level
 
 import (
 	"github.com/echocat/slf4g/level"
+	"github.com/echocat/slf4g/native/color"
 )
 
 // DefaultColorizer is the default instance of Colorizer which should cover the
@@ -25,18 +26,56 @@ type Colorizer interface {
 
 // ColorizerMap is an implementation of Colorizer which simply holds for
 // configured level.Level an ANSI escape code for colorizing. If there is no
-// level.Level configured it defaults to a simple grey.
+// level.Level configured it uses the level.Definition#Color of a custom
+// level.Level (see level.Register()) and defaults to a simple grey.
 type ColorizerMap map[level.Level]string
 
 // ColorizeByLevel implements Colorizer.ColorizeByLevel()
 func (l ColorizerMap) ColorizeByLevel(lvl level.Level, what string) string {
 	prefix := l[lvl]
+	if prefix == "" {
+		if d, ok := level.GetDefinition(lvl); ok {
+			prefix = d.Color
+		}
+	}
 	if prefix == "" {
 		prefix = `[37;1m`
 	}
 	return prefix + what + `[0m`
 }
 
+// ThemeColorizer is an implementation of Colorizer which uses the level colors
+// of a color.Theme, printed with the given color.Depth.
+type ThemeColorizer struct {
+	// Theme to take the colors from. If not set color.DefaultTheme will be
+	// used.
+	Theme *color.Theme
+
+	// Depth the colors are printed with; they will be downgraded if required.
+	Depth color.Depth
+}
+
+// NewThemeColorizer creates a new instance of ThemeColorizer for the given
+// color.Theme and color.Depth.
+func NewThemeColorizer(theme *color.Theme, depth color.Depth) *ThemeColorizer {
+	return &ThemeColorizer{
+		Theme: theme,
+		Depth: depth,
+	}
+}
+
+// ColorizeByLevel implements Colorizer.ColorizeByLevel()
+func (instance *ThemeColorizer) ColorizeByLevel(lvl level.Level, what string) string {
+	theme := instance.Theme
+	if theme == nil {
+		theme = color.DefaultTheme
+	}
+	if theme == nil {
+		return what
+	}
+	return theme.LevelColor(lvl).Colorize(instance.Depth, what)
+}
+
 // NewColorizerFacade creates a facade of Colorizer using the given provider.
 func NewColorizerFacade(provider func() Colorizer) Colorizer {
 	return colorizerFacade(provider)
@@ -5,6 +5,7 @@ import (
 
 	"github.com/echocat/slf4g/internal/test/assert"
 	"github.com/echocat/slf4g/level"
+	"github.com/echocat/slf4g/native/color"
 )
 
 func Test_ColorizerMap_ColorizeByLevel(t *testing.T) {
@@ -34,6 +35,26 @@ func Test_ColorizerMap_ColorizeByLevel(t *testing.T) {
 	}
 }
 
+func Test_ColorizerMap_ColorizeByLevel_ofDefinition(t *testing.T) {
+	givenLevel := level.Register(level.Definition{Level: 3500, Name: "NOTE", Color: "\x1b[32;1m"})
+	defer level.Unregister(givenLevel)
+
+	actual := ColorizerMap{}.ColorizeByLevel(givenLevel, "foo")
+
+	assert.ToBeEqual(t, "\x1b[32;1mfoo\x1b[0m", actual)
+}
+
+func Test_ThemeColorizer_ColorizeByLevel(t *testing.T) {
+	givenTheme := &color.Theme{Levels: map[level.Level]color.Color{
+		level.Info: color.Rgb(255, 135, 0),
+	}}
+
+	assert.ToBeEqual(t, "\x1b[38;2;255;135;0mfoo\x1b[0m", NewThemeColorizer(givenTheme, color.DepthTrueColor).ColorizeByLevel(level.Info, "foo"))
+	assert.ToBeEqual(t, "\x1b[38;5;208mfoo\x1b[0m", NewThemeColorizer(givenTheme, color.Depth256).ColorizeByLevel(level.Info, "foo"))
+	assert.ToBeEqual(t, "\x1b[33mfoo\x1b[0m", NewThemeColorizer(givenTheme, color.Depth16).ColorizeByLevel(level.Info, "foo"))
+	assert.ToBeEqual(t, "\x1b[34;1mfoo\x1b[0m", NewThemeColorizer(nil, color.Depth16).ColorizeByLevel(level.Info, "foo"))
+}
+
 func Test_NewColorizerFacade(t *testing.T) {
 	givenColorizer := ColorizerMap{level.Level(666): "foo"}
 
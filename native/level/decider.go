@@ -0,0 +1,61 @@
+package level
+
+import (
+	log "github.com/echocat/slf4g"
+	"github.com/echocat/slf4g/fields"
+	"github.com/echocat/slf4g/level"
+)
+
+// Decider decides dynamically for each call whether a level.Level is enabled
+// for a logger. This allows, for example, to enable level.Debug only for
+// events of requests carrying a debug header or of a specific tenant.
+//
+// There is no context available in this framework besides the fields of an
+// event. Everything which should be respected by a Decider needs to be added
+// as field to the event (for example using log.Logger#With()).
+type Decider interface {
+	// IsLevelEnabled returns true if the given level.Level is enabled for the
+	// logger with the given name. current is the level.Level the logger is
+	// configured with (see log.CoreLogger#GetLevel()).
+	//
+	// event is nil if this is called without any concrete event; like by
+	// log.CoreLogger#IsLevelEnabled(). In this case it should return true if
+	// the given level.Level could be enabled for at least one event. The final
+	// decision will be made again with the concrete event once it is logged.
+	IsLevelEnabled(loggerName string, l level.Level, current level.Level, event log.Event) bool
+}
+
+// DeciderFunc is wrapping a given function into a Decider.
+type DeciderFunc func(loggerName string, l level.Level, current level.Level, event log.Event) bool
+
+// IsLevelEnabled implements Decider.IsLevelEnabled()
+func (instance DeciderFunc) IsLevelEnabled(loggerName string, l level.Level, current level.Level, event log.Event) bool {
+	return instance(loggerName, l, current, event)
+}
+
+// NewFieldDecider creates a Decider which enables every level.Level starting
+// from the given enabledFrom for each event which carries a field with the
+// given key and value. All other events are decided by the level.Level the
+// logger is configured with.
+func NewFieldDecider(key string, value interface{}, enabledFrom level.Level) Decider {
+	return DeciderFunc(func(_ string, l level.Level, current level.Level, event log.Event) bool {
+		if current.CompareTo(l) <= 0 {
+			return true
+		}
+		if enabledFrom.CompareTo(l) > 0 {
+			return false
+		}
+		if event == nil {
+			return true
+		}
+		v, exists := event.Get(key)
+		if !exists {
+			return false
+		}
+		if lv, ok := v.(fields.Lazy); ok {
+			v = lv.Get()
+		}
+		equal, err := fields.DefaultValueEquality.AreValuesEqual(key, value, v)
+		return err == nil && equal
+	})
+}
@@ -0,0 +1,65 @@
+package level
+
+import (
+	"testing"
+
+	log "github.com/echocat/slf4g"
+	"github.com/echocat/slf4g/fields"
+	"github.com/echocat/slf4g/level"
+	"github.com/echocat/slf4g/testing/recording"
+
+	"github.com/echocat/slf4g/internal/test/assert"
+)
+
+func Test_DeciderFunc_IsLevelEnabled(t *testing.T) {
+	givenEvent := recording.NewCoreLogger().NewEvent(level.Info, nil)
+	var actualLoggerName string
+	var actualLevel, actualCurrent level.Level
+	var actualEvent log.Event
+	instance := DeciderFunc(func(loggerName string, l level.Level, current level.Level, event log.Event) bool {
+		actualLoggerName, actualLevel, actualCurrent, actualEvent = loggerName, l, current, event
+		return true
+	})
+
+	actual := instance.IsLevelEnabled("foo", level.Debug, level.Warn, givenEvent)
+
+	assert.ToBeEqual(t, true, actual)
+	assert.ToBeEqual(t, "foo", actualLoggerName)
+	assert.ToBeEqual(t, level.Debug, actualLevel)
+	assert.ToBeEqual(t, level.Warn, actualCurrent)
+	assert.ToBeSame(t, givenEvent, actualEvent)
+}
+
+func Test_NewFieldDecider(t *testing.T) {
+	newEvent := func(values map[string]interface{}) log.Event {
+		return recording.NewCoreLogger().NewEvent(level.Debug, values)
+	}
+	instance := NewFieldDecider("debug", true, level.Debug)
+
+	cases := []struct {
+		name     string
+		l        level.Level
+		event    log.Event
+		expected bool
+	}{
+		{"enabledByCurrent", level.Info, newEvent(nil), true},
+		{"enabledByCurrentWithoutEvent", level.Info, nil, true},
+		{"belowEnabledFrom", level.Trace, newEvent(map[string]interface{}{"debug": true}), false},
+		{"belowEnabledFromWithoutEvent", level.Trace, nil, false},
+		{"withoutEvent", level.Debug, nil, true},
+		{"withoutField", level.Debug, newEvent(nil), false},
+		{"withOtherValue", level.Debug, newEvent(map[string]interface{}{"debug": false}), false},
+		{"withValue", level.Debug, newEvent(map[string]interface{}{"debug": true}), true},
+		{"withLazyValue", level.Debug, newEvent(map[string]interface{}{"debug": fields.LazyFunc(func() interface{} {
+			return true
+		})}), true},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			actual := instance.IsLevelEnabled("foo", c.l, level.Info, c.event)
+
+			assert.ToBeEqual(t, c.expected, actual)
+		})
+	}
+}
@@ -34,6 +34,9 @@ func (instance *defaultNames) ToName(lvl level.Level) (string, error) {
 	case level.Fatal:
 		return "FATAL", nil
 	default:
+		if d, ok := level.GetDefinition(lvl); ok {
+			return d.Name, nil
+		}
 		return fmt.Sprintf("%d", lvl), nil
 	}
 }
@@ -53,6 +56,9 @@ func (instance *defaultNames) ToLevel(name string) (level.Level, error) {
 	case "FATAL":
 		return level.Fatal, nil
 	default:
+		if d, ok := level.GetDefinitionByName(name); ok {
+			return d.Level, nil
+		}
 		if result, err := strconv.ParseUint(name, 10, 16); err != nil {
 			return 0, fmt.Errorf("%w: %s", level.ErrIllegalLevel, name)
 		} else {
@@ -17,6 +17,8 @@ func Test_NewNames(t *testing.T) {
 
 func Test_defaultNames_ToName(t *testing.T) {
 	instance := &defaultNames{}
+	level.Register(level.Definition{Level: 3500, Name: "NOTICE", Aliases: []string{"NOTE"}})
+	defer level.Unregister(3500)
 
 	cases := []struct {
 		given    level.Level
@@ -28,6 +30,7 @@ func Test_defaultNames_ToName(t *testing.T) {
 		{level.Warn, "WARN"},
 		{level.Error, "ERROR"},
 		{level.Fatal, "FATAL"},
+		{level.Level(3500), "NOTICE"},
 		{level.Level(666), "666"},
 	}
 
@@ -43,6 +46,8 @@ func Test_defaultNames_ToName(t *testing.T) {
 
 func Test_defaultNames_ToLevel(t *testing.T) {
 	instance := &defaultNames{}
+	level.Register(level.Definition{Level: 3500, Name: "NOTICE", Aliases: []string{"NOTE"}})
+	defer level.Unregister(3500)
 
 	cases := []struct {
 		expected level.Level
@@ -54,6 +59,8 @@ func Test_defaultNames_ToLevel(t *testing.T) {
 		{level.Warn, "WARN"},
 		{level.Error, "ERROR"},
 		{level.Fatal, "FATAL"},
+		{level.Level(3500), "notice"},
+		{level.Level(3500), "NOTE"},
 		{level.Level(666), "666"},
 	}
 
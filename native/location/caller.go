@@ -7,6 +7,7 @@ import (
 	"strings"
 
 	log "github.com/echocat/slf4g"
+	"github.com/echocat/slf4g/native/naming"
 )
 
 // Caller describes the Location of the caller which leads to the initial
@@ -55,6 +56,14 @@ const (
 type CallerDiscovery struct {
 	ReportingType   CallerReportingType
 	ReportingDetail CallerReportingDetail
+
+	// PackageShortener is used to shorten the package of the reported
+	// location if the type is reported (see CallerReportingTypePrefersType).
+	// This is mostly useful in combination with
+	// CallerReportingDetailDetailed; for example to abbreviate or strip module
+	// prefixes of the package (see naming.Strategy). If not set
+	// naming.DefaultShortener will be used.
+	PackageShortener naming.Shortener
 }
 
 // NewCallerDiscovery create a new instance of CallerDiscovery which is ready to
@@ -91,6 +100,16 @@ func (instance *CallerDiscovery) DiscoverLocation(_ log.Event, skipFrames uint16
 	}
 }
 
+func (instance *CallerDiscovery) getPackageShortener() naming.Shortener {
+	if v := instance.PackageShortener; v != nil {
+		return v
+	}
+	if v := naming.DefaultShortener; v != nil {
+		return v
+	}
+	return naming.NoopShortener()
+}
+
 type callerImpl struct {
 	discovery *CallerDiscovery
 	frame     *runtime.Frame
@@ -136,6 +155,7 @@ func (instance callerImpl) formatType() string {
 		aPackage[len(aPackage)-1] = lastSubParts[0]
 		p = strings.Join(aPackage, "/")
 	}
+	p = instance.discovery.getPackageShortener().Shorten(p)
 
 	result := p + "." + strings.Join(lastSubParts[1:], ".")
 	if instance.frame.Line > 0 {
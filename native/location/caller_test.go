@@ -5,6 +5,7 @@ import (
 	"testing"
 
 	"github.com/echocat/slf4g/internal/test/assert"
+	"github.com/echocat/slf4g/native/naming"
 )
 
 func someFuncForCallerDiscoveryDiscoverTest(instance *CallerDiscovery, skipFrames uint16) Location {
@@ -20,11 +21,11 @@ func Test_CallerDiscovery_Discover(t *testing.T) {
 	assert.ToBeOfType(t, &callerImpl{}, actual1)
 	assert.ToBeSame(t, instance, actual1.(*callerImpl).discovery)
 	assert.ToBeEqual(t, "github.com/echocat/slf4g/native/location.someFuncForCallerDiscoveryDiscoverTest", actual1.(*callerImpl).frame.Function)
-	assert.ToBeEqual(t, 12, actual1.(*callerImpl).frame.Line)
+	assert.ToBeEqual(t, 13, actual1.(*callerImpl).frame.Line)
 
 	actual2 := someFuncForCallerDiscoveryDiscoverTest(instance, 1)
 	assert.ToBeEqual(t, "github.com/echocat/slf4g/native/location.Test_CallerDiscovery_Discover", actual2.(*callerImpl).frame.Function)
-	assert.ToBeEqual(t, 25, actual2.(*callerImpl).frame.Line)
+	assert.ToBeEqual(t, 26, actual2.(*callerImpl).frame.Line)
 
 	actual3 := someFuncForCallerDiscoveryDiscoverTest(instance, 255)
 	assert.ToBeEqual(t, "???", actual3.(*callerImpl).frame.Function)
@@ -120,6 +121,24 @@ func Test_callerImpl_formatType_withType_detailed(t *testing.T) {
 	assert.ToBeEqual(t, "github.com/foo/bar/aPackage.aType.aFunc:123", actual1)
 }
 
+func Test_callerImpl_formatType_withType_detailed_withPackageShortener(t *testing.T) {
+	instance := callerImpl{
+		discovery: NewCallerDiscovery(func(discovery *CallerDiscovery) {
+			discovery.ReportingDetail = CallerReportingDetailDetailed
+			discovery.PackageShortener = naming.NewStrategy(func(v *naming.Strategy) {
+				v.StripPrefixes = []string{"github.com/foo"}
+			})
+		}),
+		frame: &runtime.Frame{
+			Function: "github.com/foo/bar/aPackage.aType.aFunc",
+			Line:     123,
+		},
+	}
+
+	actual1 := instance.formatType()
+	assert.ToBeEqual(t, "bar/aPackage.aType.aFunc:123", actual1)
+}
+
 func Test_callerImpl_formatType_withType_detailed_withoutLine(t *testing.T) {
 	instance := callerImpl{
 		discovery: NewCallerDiscovery(func(discovery *CallerDiscovery) {
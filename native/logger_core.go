@@ -11,6 +11,7 @@ import (
 
 	log "github.com/echocat/slf4g"
 	"github.com/echocat/slf4g/native/consumer"
+	nlevel "github.com/echocat/slf4g/native/level"
 	"github.com/echocat/slf4g/native/location"
 )
 
@@ -28,6 +29,7 @@ type CoreLogger struct {
 	Level             level.Level
 	Consumer          consumer.Consumer
 	LocationDiscovery location.Discovery
+	LevelDecider      nlevel.Decider
 
 	provider *Provider
 	name     string
@@ -38,7 +40,7 @@ func (instance *CoreLogger) Log(event log.Event, skipFrames uint16) {
 	if event == nil {
 		return
 	}
-	if !instance.IsLevelEnabled(event.GetLevel()) {
+	if !instance.isLevelEnabledFor(event.GetLevel(), event) {
 		return
 	}
 	provider := instance.getProvider()
@@ -58,8 +60,19 @@ func (instance *CoreLogger) Log(event log.Event, skipFrames uint16) {
 }
 
 // IsLevelEnabled implements log.CoreLogger#IsLevelEnabled()
+//
+// If a nlevel.Decider is configured (see LevelDecider and
+// Provider.LevelDecider) it will decide this without any concrete event. The
+// final decision will be made by Log() with the concrete event.
 func (instance *CoreLogger) IsLevelEnabled(level level.Level) bool {
-	return instance.GetLevel().CompareTo(level) <= 0
+	return instance.isLevelEnabledFor(level, nil)
+}
+
+func (instance *CoreLogger) isLevelEnabledFor(l level.Level, event log.Event) bool {
+	if d := instance.getLevelDecider(); d != nil {
+		return d.IsLevelEnabled(instance.name, l, instance.GetLevel(), event)
+	}
+	return instance.GetLevel().CompareTo(l) <= 0
 }
 
 // SetLevel changes the current level.Level of this log.CoreLogger. If set to
@@ -127,6 +140,13 @@ func (instance *CoreLogger) getLocationDiscovery() location.Discovery {
 	return instance.getProvider().getLocationDiscovery()
 }
 
+func (instance *CoreLogger) getLevelDecider() nlevel.Decider {
+	if v := instance.LevelDecider; v != nil {
+		return v
+	}
+	return instance.getProvider().LevelDecider
+}
+
 func (instance *CoreLogger) getProvider() *Provider {
 	if v := instance.provider; v != nil {
 		return v
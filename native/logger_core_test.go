@@ -7,6 +7,7 @@ import (
 
 	"github.com/echocat/slf4g/fields"
 	"github.com/echocat/slf4g/native/consumer"
+	nlevel "github.com/echocat/slf4g/native/level"
 
 	"github.com/echocat/slf4g/level"
 
@@ -265,6 +266,40 @@ func Test_CoreLogger_IsLevelEnabled(t *testing.T) {
 	}
 }
 
+func Test_CoreLogger_IsLevelEnabled_withLevelDecider(t *testing.T) {
+	var actualLoggerName string
+	var actualCurrent level.Level
+	var actualEvent log.Event = &event{}
+	instance, _ := newCoreLogger(func(v *CoreLogger) {
+		v.Level = level.Warn
+		v.LevelDecider = nlevel.DeciderFunc(func(loggerName string, l level.Level, current level.Level, e log.Event) bool {
+			actualLoggerName, actualCurrent, actualEvent = loggerName, current, e
+			return l == level.Debug
+		})
+	})
+
+	assert.ToBeEqual(t, true, instance.IsLevelEnabled(level.Debug))
+	assert.ToBeEqual(t, false, instance.IsLevelEnabled(level.Warn))
+	assert.ToBeEqual(t, "test", actualLoggerName)
+	assert.ToBeEqual(t, level.Warn, actualCurrent)
+	assert.ToBeNil(t, actualEvent)
+}
+
+func Test_CoreLogger_Log_withLevelDecider(t *testing.T) {
+	instance, recorder := newCoreLogger(func(v *CoreLogger) {
+		v.LevelDecider = nlevel.NewFieldDecider("tenant", "foo", level.Debug)
+	})
+	givenEvent := newEvent(instance.provider, level.Debug)
+
+	instance.Log(givenEvent.With("tenant", "bar"), 0)
+	assert.ToBeEqual(t, 0, recorder.Len())
+
+	instance.Log(givenEvent.With("tenant", "foo"), 0)
+	assert.ToBeEqual(t, 1, recorder.Len())
+	actualTenant, _ := recorder.Get(0).Get("tenant")
+	assert.ToBeEqual(t, "foo", actualTenant)
+}
+
 func Test_CoreLogger_GetName_specified(t *testing.T) {
 	instance, _ := newCoreLogger()
 	instance.name = "foo"
@@ -430,3 +465,23 @@ func newCoreLoggerWith(provider *Provider, customizer ...func(*CoreLogger)) *Cor
 	}
 	return result
 }
+
+func Test_CoreLogger_getLevelDecider_specified(t *testing.T) {
+	givenLevelDecider := nlevel.NewFieldDecider("foo", "bar", level.Debug)
+	instance, _ := newCoreLogger()
+	instance.LevelDecider = givenLevelDecider
+
+	actual := instance.getLevelDecider()
+
+	assert.ToBeSame(t, givenLevelDecider, actual)
+}
+
+func Test_CoreLogger_getLevelDecider_fromProvider(t *testing.T) {
+	givenLevelDecider := nlevel.NewFieldDecider("foo", "bar", level.Debug)
+	instance, _ := newCoreLogger()
+	instance.provider.LevelDecider = givenLevelDecider
+
+	actual := instance.getLevelDecider()
+
+	assert.ToBeSame(t, givenLevelDecider, actual)
+}
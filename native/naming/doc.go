@@ -0,0 +1,5 @@
+// Package naming provides strategies to shorten names (usually of loggers or
+// packages, which are in Go typically long import paths like
+// github.com/acme/platform/internal/storage/postgres) for a more compact
+// output.
+package naming
@@ -0,0 +1,29 @@
+package naming
+
+// DefaultShortener is the default instance of Shortener which should be used
+// if nothing else was configured. By default, names are not shortened.
+var DefaultShortener = NoopShortener()
+
+// Shortener shortens a given name for a more compact output.
+type Shortener interface {
+	// Shorten returns the shortened version of the given name.
+	Shorten(name string) string
+}
+
+// ShortenerFunc is wrapping a given function into a Shortener.
+type ShortenerFunc func(name string) string
+
+// Shorten implements Shortener.Shorten()
+func (instance ShortenerFunc) Shorten(name string) string {
+	return instance(name)
+}
+
+var noopV = ShortenerFunc(func(name string) string {
+	return name
+})
+
+// NoopShortener provides a noop implementation of Shortener which returns
+// every name as it is.
+func NoopShortener() Shortener {
+	return noopV
+}
@@ -0,0 +1,23 @@
+package naming
+
+import (
+	"testing"
+
+	"github.com/echocat/slf4g/internal/test/assert"
+)
+
+func Test_ShortenerFunc_Shorten(t *testing.T) {
+	instance := ShortenerFunc(func(name string) string {
+		return "<" + name + ">"
+	})
+
+	actual := instance.Shorten("foo")
+
+	assert.ToBeEqual(t, "<foo>", actual)
+}
+
+func Test_NoopShortener(t *testing.T) {
+	actual := NoopShortener().Shorten("github.com/echocat/slf4g")
+
+	assert.ToBeEqual(t, "github.com/echocat/slf4g", actual)
+}
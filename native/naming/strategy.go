@@ -0,0 +1,119 @@
+package naming
+
+import (
+	"strings"
+)
+
+// Strategy is an implementation of Shortener which shortens a name in the
+// following order:
+//
+//  1. If the name (or one of its parents) is contained in Aliases, the
+//     corresponding part is replaced by its alias.
+//  2. Otherwise, the first matching prefix of StripPrefixes is removed.
+//  3. The result is abbreviated to Width (see Abbreviate).
+type Strategy struct {
+	// Aliases maps names to user-provided aliases. A key matches either the
+	// name itself or one of its parents, for example the key
+	// github.com/acme/platform/internal/storage with the alias storage will
+	// turn github.com/acme/platform/internal/storage/postgres into
+	// storage/postgres. The longest matching key wins.
+	Aliases map[string]string
+
+	// StripPrefixes contains module prefixes (like github.com/acme/platform)
+	// which will be removed from the beginning of names. The first matching
+	// prefix wins. A name which is equal to a prefix is kept as it is.
+	StripPrefixes []string
+
+	// Width is the target length of names; see Abbreviate for more details.
+	// If 0 or smaller names will not be abbreviated.
+	Width int16
+}
+
+// NewStrategy creates a new instance of Strategy which is ready to use.
+func NewStrategy(customizer ...func(*Strategy)) *Strategy {
+	result := &Strategy{}
+	for _, c := range customizer {
+		c(result)
+	}
+	return result
+}
+
+// Shorten implements Shortener.Shorten()
+func (instance *Strategy) Shorten(name string) string {
+	if v, ok := ApplyAlias(name, instance.Aliases); ok {
+		name = v
+	} else {
+		name = StripPrefix(name, instance.StripPrefixes...)
+	}
+	if instance.Width > 0 {
+		name = Abbreviate(int(instance.Width), name)
+	}
+	return name
+}
+
+// Abbreviate abbreviates the given name the way logback does: All segments
+// (separated by / or .) except the last one are shortened to their first
+// character - from the left to the right - until the name fits into the given
+// width. If the name is already short enough, it is returned as it is.
+//
+// Example: github.com/echocat/slf4g/native with a width of 20 results in
+// g.c.e.slf4g.native.
+func Abbreviate(width int, name string) string {
+	if len(name) <= width {
+		return name
+	}
+	segments := strings.FieldsFunc(name, isSeparator)
+	length := len(segments) - 1
+	for _, segment := range segments {
+		length += len(segment)
+	}
+	for i := 0; i < len(segments)-1 && length > width; i++ {
+		if len(segments[i]) > 1 {
+			length -= len(segments[i]) - 1
+			segments[i] = segments[i][:1]
+		}
+	}
+	return strings.Join(segments, ".")
+}
+
+// StripPrefix removes the first of the given prefixes which matches the given
+// name on a segment boundary (separated by / or .). A name which is equal to
+// a prefix is returned as it is.
+func StripPrefix(name string, prefixes ...string) string {
+	for _, prefix := range prefixes {
+		prefix = strings.TrimRightFunc(prefix, isSeparator)
+		if prefix == "" || len(name) <= len(prefix) || !strings.HasPrefix(name, prefix) {
+			continue
+		}
+		if rest := name[len(prefix):]; isSeparator(rune(rest[0])) {
+			if rest = strings.TrimLeftFunc(rest, isSeparator); rest != "" {
+				return rest
+			}
+		}
+	}
+	return name
+}
+
+// ApplyAlias replaces the longest key of the given aliases which matches the
+// given name (or one of its parents on a segment boundary, separated by / or
+// .) by its alias. It returns false if no alias matches.
+func ApplyAlias(name string, aliases map[string]string) (string, bool) {
+	if len(aliases) == 0 {
+		return name, false
+	}
+	for candidate := name; candidate != ""; {
+		if alias, ok := aliases[candidate]; ok {
+			return alias + name[len(candidate):], true
+		}
+		i := strings.LastIndexFunc(candidate, isSeparator)
+		if i < 0 {
+			break
+		}
+		candidate = candidate[:i]
+	}
+	return name, false
+}
+
+func isSeparator(r rune) bool {
+	return r == '/' || r == '.'
+}
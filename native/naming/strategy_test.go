@@ -0,0 +1,143 @@
+package naming
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/echocat/slf4g/internal/test/assert"
+)
+
+func Test_NewStrategy(t *testing.T) {
+	actual := NewStrategy(func(v *Strategy) {
+		v.Width = 10
+	})
+
+	assert.ToBeNil(t, actual.Aliases)
+	assert.ToBeNil(t, actual.StripPrefixes)
+	assert.ToBeEqual(t, int16(10), actual.Width)
+}
+
+func Test_Strategy_Shorten(t *testing.T) {
+	instance := NewStrategy(func(v *Strategy) {
+		v.Aliases = map[string]string{
+			"github.com/acme/platform/internal/storage": "storage",
+		}
+		v.StripPrefixes = []string{"github.com/acme/platform"}
+		v.Width = 20
+	})
+
+	cases := []struct {
+		given    string
+		expected string
+	}{
+		{"github.com/acme/platform/internal/storage/postgres", "storage/postgres"},
+		{"github.com/acme/platform/internal/storage", "storage"},
+		{"github.com/acme/platform/internal/http", "internal/http"},
+		{"github.com/acme/platform/internal/http/middleware", "i.http.middleware"},
+		{"github.com/acme/platform", "g.com.acme.platform"},
+		{"github.com/acme/other", "g.com.acme.other"},
+		{"main", "main"},
+		{"", ""},
+	}
+
+	for _, c := range cases {
+		t.Run(c.given, func(t *testing.T) {
+			actual := instance.Shorten(c.given)
+
+			assert.ToBeEqual(t, c.expected, actual)
+		})
+	}
+}
+
+func Test_Abbreviate(t *testing.T) {
+	cases := []struct {
+		givenWidth int
+		given      string
+		expected   string
+	}{
+		{20, "github.com/echocat/slf4g/native", "g.c.e.slf4g.native"},
+		{10, "github.com/echocat/slf4g/native", "g.c.e.s.native"},
+		{5, "github.com/echocat/slf4g/native", "g.c.e.s.native"},
+		{40, "github.com/echocat/slf4g/native", "github.com/echocat/slf4g/native"},
+		{3, "foobar", "foobar"},
+		{3, "", ""},
+	}
+
+	for _, c := range cases {
+		t.Run(fmt.Sprintf("%d-%s", c.givenWidth, c.given), func(t *testing.T) {
+			actual := Abbreviate(c.givenWidth, c.given)
+
+			assert.ToBeEqual(t, c.expected, actual)
+		})
+	}
+}
+
+func Test_StripPrefix(t *testing.T) {
+	cases := []struct {
+		given         string
+		givenPrefixes []string
+		expected      string
+	}{
+		{"github.com/acme/platform/foo", []string{"github.com/acme/platform"}, "foo"},
+		{"github.com/acme/platform/foo", []string{"github.com/acme/platform/"}, "foo"},
+		{"github.com/acme/platform/foo", []string{"github.com/other", "github.com/acme"}, "platform/foo"},
+		{"github.com/acme/platformx/foo", []string{"github.com/acme/platform"}, "github.com/acme/platformx/foo"},
+		{"github.com/acme/platform", []string{"github.com/acme/platform"}, "github.com/acme/platform"},
+		{"github.com/acme/platform/foo", []string{""}, "github.com/acme/platform/foo"},
+		{"github.com/acme/platform/foo", nil, "github.com/acme/platform/foo"},
+	}
+
+	for _, c := range cases {
+		t.Run(fmt.Sprintf("%s-%v", c.given, c.givenPrefixes), func(t *testing.T) {
+			actual := StripPrefix(c.given, c.givenPrefixes...)
+
+			assert.ToBeEqual(t, c.expected, actual)
+		})
+	}
+}
+
+func Test_ApplyAlias(t *testing.T) {
+	givenAliases := map[string]string{
+		"github.com/acme/platform":                  "platform",
+		"github.com/acme/platform/internal/storage": "storage",
+	}
+
+	cases := []struct {
+		given           string
+		expected        string
+		expectedApplied bool
+	}{
+		{"github.com/acme/platform/internal/storage/postgres", "storage/postgres", true},
+		{"github.com/acme/platform/internal/storage", "storage", true},
+		{"github.com/acme/platform/internal/http", "platform/internal/http", true},
+		{"github.com/acme/platformx", "github.com/acme/platformx", false},
+		{"main", "main", false},
+		{"", "", false},
+	}
+
+	for _, c := range cases {
+		t.Run(c.given, func(t *testing.T) {
+			actual, actualApplied := ApplyAlias(c.given, givenAliases)
+
+			assert.ToBeEqual(t, c.expected, actual)
+			assert.ToBeEqual(t, c.expectedApplied, actualApplied)
+		})
+	}
+}
+
+func ExampleStrategy_Shorten() {
+	strategy := NewStrategy(func(v *Strategy) {
+		v.Aliases = map[string]string{"github.com/acme/platform/internal/storage": "storage"}
+		v.StripPrefixes = []string{"github.com/acme/platform"}
+		v.Width = 16
+	})
+
+	fmt.Println(strategy.Shorten("github.com/acme/platform/internal/storage/postgres"))
+	fmt.Println(strategy.Shorten("github.com/acme/platform/internal/http/middleware"))
+	fmt.Println(strategy.Shorten("github.com/other/lib"))
+
+	// Output:
+	// storage/postgres
+	// i.h.middleware
+	// g.com.other.lib
+}
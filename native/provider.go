@@ -57,6 +57,12 @@ type Provider struct {
 	// DefaultFieldKeysSpec by default.
 	FieldKeysSpec FieldKeysSpec
 
+	// LevelDecider is used to decide dynamically whether a level.Level is
+	// enabled for the loggers managed by this Provider. This can be
+	// overwritten by individual loggers. If this is not set every logger will
+	// simply compare with its configured level.Level.
+	LevelDecider nlevel.Decider
+
 	// CoreLoggerCustomizer will be called in every moment a logger instance
 	// needs to be created (if configured).
 	CoreLoggerCustomizer CoreLoggerCustomizer
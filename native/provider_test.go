@@ -1,6 +1,9 @@
 package native
 
 import (
+	"bytes"
+	"fmt"
+	"runtime"
 	"testing"
 
 	log "github.com/echocat/slf4g"
@@ -12,7 +15,9 @@ import (
 	"github.com/echocat/slf4g/fields"
 	"github.com/echocat/slf4g/internal/test/assert"
 	"github.com/echocat/slf4g/level"
+	"github.com/echocat/slf4g/native/color"
 	"github.com/echocat/slf4g/native/consumer"
+	"github.com/echocat/slf4g/native/formatter"
 )
 
 func Test_Provider_GetName_specified(t *testing.T) {
@@ -318,6 +323,35 @@ func Test_Provider_levelAwareLogger(t *testing.T) {
 	assert.ToBeEqual(t, true, actualOk2)
 }
 
+func Test_Provider_withCustomLevel(t *testing.T) {
+	notice := level.Register(level.Definition{Level: 3500, Name: "NOTE"})
+	defer level.Unregister(notice)
+
+	buf := new(bytes.Buffer)
+	minMessageWidth := int16(0)
+	instance := &Provider{
+		Level:             notice,
+		LocationDiscovery: location.NoopDiscovery(),
+		Consumer: consumer.NewWriter(buf, func(v *consumer.Writer) {
+			v.Formatter = formatter.NewText(func(v *formatter.Text) {
+				v.ColorMode = color.ModeNever
+				v.TimeLayout = " "
+				v.MinMessageWidth = &minMessageWidth
+			})
+		}),
+	}
+	logger := instance.GetLogger("foo")
+
+	assert.ToBeEqual(t, level.Levels{level.Trace, level.Debug, level.Info, notice, level.Warn, level.Error, level.Fatal}, instance.GetAllLevels())
+	assert.ToBeEqual(t, false, logger.IsLevelEnabled(level.Info))
+	assert.ToBeEqual(t, true, logger.IsLevelEnabled(notice))
+
+	logger.Info("not logged")
+	log.NewEventBuilder(logger, notice).Msg("hello")
+
+	assert.ToBeEqual(t, " [ NOTE] hello logger=foo\n", buf.String())
+}
+
 func Test_init_providerWasRegistered(t *testing.T) {
 	for _, candidate := range log.GetAllProviders() {
 		if candidate == DefaultProvider {
@@ -327,6 +361,28 @@ func Test_init_providerWasRegistered(t *testing.T) {
 	assert.Fail(t, "Expected all providers to contain contain <%+v>; but got: <%+v>", DefaultProvider, log.GetAllProviders())
 }
 
+func Test_Provider_GetLogger_location(t *testing.T) {
+	instance, recorder := newProvider(func(v *Provider) {
+		v.LocationDiscovery = location.NewCallerDiscovery(func(d *location.CallerDiscovery) {
+			d.ReportingType = location.CallerReportingTypePrefersFile
+		})
+	})
+	logger := instance.GetLogger("foo")
+
+	_, _, line, _ := runtime.Caller(0)
+	logger.Info("foo")
+	logger.Infof("foo")
+	log.NewEventBuilder(logger, level.Info).Msg("foo")
+	log.NewEventBuilder(logger, level.Info).Msgf("foo")
+	log.NewEventBuilder(logger, level.Info).Send()
+
+	assert.ToBeEqual(t, 5, recorder.Len())
+	for i, event := range recorder.GetAll() {
+		actual, _ := event.Get(instance.getFieldKeysSpec().GetLocation())
+		assert.ToBeEqual(t, fmt.Sprintf("provider_test.go:%d", line+1+i), actual.(fields.Lazy).Get())
+	}
+}
+
 func newProvider(customizer ...func(*Provider)) (*Provider, *consumer.Recorder) {
 	recorder := consumer.NewRecorder()
 	result := &Provider{
@@ -0,0 +1,24 @@
+// Package golden provides golden-file snapshot testing for log output.
+//
+// It takes all events recorded by either a
+// github.com/echocat/slf4g/testing/recording.Provider (or CoreLogger) or a
+// github.com/echocat/slf4g/native/consumer.Recorder, formats them using a
+// github.com/echocat/slf4g/native/formatter.Formatter and compares the result
+// against a golden file. Volatile fields like timestamps, locations and
+// durations are normalized before formatting.
+//
+// # Usage
+//
+//	func TestSomething(t *testing.T) {
+//		provider := recording.NewProvider()
+//		defer provider.HookGlobally()()
+//
+//		doSomething()
+//
+//		golden.Assert(t, "testdata/something.golden", provider)
+//	}
+//
+// If the test is executed with the -golden.update flag (go test ./...
+// -golden.update) the golden files will be (re)written instead of compared.
+// Snapshot.Update can be used to control this programmatically.
+package golden
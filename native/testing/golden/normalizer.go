@@ -0,0 +1,91 @@
+package golden
+
+import (
+	"time"
+
+	log "github.com/echocat/slf4g"
+	"github.com/echocat/slf4g/fields"
+
+	"github.com/echocat/slf4g/native"
+)
+
+// DefaultTimestamp is the timestamp every timestamp of an event will be
+// replaced with by NormalizeTimestamp.
+var DefaultTimestamp = time.Date(2000, 1, 1, 0, 0, 0, 0, time.UTC)
+
+// DefaultDuration is the value every time.Duration of an event will be
+// replaced with by NormalizeDurations.
+var DefaultDuration = time.Duration(0)
+
+// LocationPlaceholder is the value every location of an event will be
+// replaced with by NormalizeLocation.
+const LocationPlaceholder = "[location]"
+
+// Normalizer modifies a log.Event before it will be formatted to remove
+// volatile content which would otherwise lead to different outputs on each
+// run.
+type Normalizer func(event log.Event, using log.Provider) log.Event
+
+// DefaultNormalizers are the Normalizer which will be used if
+// Snapshot.Normalizers is nil.
+var DefaultNormalizers = []Normalizer{
+	NormalizeLogger,
+	NormalizeTimestamp,
+	NormalizeLocation,
+	NormalizeDurations,
+}
+
+// NormalizeLogger replaces the logger of the given event (if present) with
+// its plain name. This is required because some implementations (like
+// recording.CoreLogger) are storing the logger instance itself.
+func NormalizeLogger(event log.Event, using log.Provider) log.Event {
+	v := log.GetLoggerOf(event, using)
+	if v == nil {
+		return event
+	}
+	return event.With(using.GetFieldKeysSpec().GetLogger(), *v)
+}
+
+// NormalizeTimestamp replaces the timestamp of the given event (if present)
+// with DefaultTimestamp.
+func NormalizeTimestamp(event log.Event, using log.Provider) log.Event {
+	key := using.GetFieldKeysSpec().GetTimestamp()
+	if _, ok := event.Get(key); !ok {
+		return event
+	}
+	return event.With(key, DefaultTimestamp)
+}
+
+// NormalizeLocation replaces the location of the given event (if present)
+// with LocationPlaceholder.
+func NormalizeLocation(event log.Event, using log.Provider) log.Event {
+	key := native.DefaultFieldKeysSpec.GetLocation()
+	if spec, ok := using.GetFieldKeysSpec().(native.FieldKeysSpec); ok {
+		key = spec.GetLocation()
+	}
+	if _, ok := event.Get(key); !ok {
+		return event
+	}
+	return event.With(key, LocationPlaceholder)
+}
+
+// NormalizeDurations replaces every value of the given event which is a
+// time.Duration with DefaultDuration.
+func NormalizeDurations(event log.Event, _ log.Provider) log.Event {
+	var keys []string
+	_ = event.ForEach(func(key string, value interface{}) error {
+		if lv, ok := value.(fields.Lazy); ok {
+			value = lv.Get()
+		}
+		switch value.(type) {
+		case time.Duration, *time.Duration:
+			keys = append(keys, key)
+		}
+		return nil
+	})
+
+	for _, key := range keys {
+		event = event.With(key, DefaultDuration)
+	}
+	return event
+}
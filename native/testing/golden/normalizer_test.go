@@ -0,0 +1,80 @@
+package golden
+
+import (
+	"testing"
+	"time"
+
+	"github.com/echocat/slf4g/fields"
+	"github.com/echocat/slf4g/level"
+	"github.com/echocat/slf4g/testing/recording"
+
+	"github.com/echocat/slf4g/native"
+
+	"github.com/echocat/slf4g/internal/test/assert"
+)
+
+func Test_Normalizers(t *testing.T) {
+	provider := recording.NewProvider()
+	logger := provider.GetLogger("foo")
+	givenDuration := time.Second
+	givenEvent := logger.NewEvent(level.Info, map[string]interface{}{
+		"message":   "hello",
+		"timestamp": time.Now(),
+		"logger":    logger,
+		"location":  "foo.go:123",
+		"took":      time.Minute,
+		"tookRef":   &givenDuration,
+		"tookLazy":  fields.LazyFunc(func() interface{} { return time.Hour }),
+		"other":     1,
+	})
+
+	cases := []struct {
+		name       string
+		normalizer Normalizer
+		key        string
+		expected   interface{}
+	}{
+		{"logger", NormalizeLogger, "logger", "foo"},
+		{"timestamp", NormalizeTimestamp, "timestamp", DefaultTimestamp},
+		{"location", NormalizeLocation, "location", LocationPlaceholder},
+		{"duration", NormalizeDurations, "took", DefaultDuration},
+		{"durationReference", NormalizeDurations, "tookRef", DefaultDuration},
+		{"durationLazy", NormalizeDurations, "tookLazy", DefaultDuration},
+		{"otherUntouched", NormalizeDurations, "other", 1},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			actual := c.normalizer(givenEvent, provider)
+
+			actualValue, _ := actual.Get(c.key)
+			assert.ToBeEqual(t, c.expected, actualValue)
+		})
+	}
+}
+
+func Test_Normalizers_absent(t *testing.T) {
+	provider := recording.NewProvider()
+	givenEvent := provider.GetRootLogger().NewEvent(level.Info, map[string]interface{}{
+		"message": "hello",
+	})
+
+	for _, n := range DefaultNormalizers {
+		actual := n(givenEvent, provider)
+
+		assert.ToBeSame(t, givenEvent, actual)
+	}
+}
+
+func Test_NormalizeLocation_usingNativeFieldKeysSpec(t *testing.T) {
+	provider := recording.NewProvider()
+	provider.FieldKeysSpec = &native.FieldKeysSpecImpl{Location: "where"}
+	givenEvent := provider.GetRootLogger().NewEvent(level.Info, map[string]interface{}{
+		"where": "foo.go:123",
+	})
+
+	actual := NormalizeLocation(givenEvent, provider)
+
+	actualValue, _ := actual.Get("where")
+	assert.ToBeEqual(t, LocationPlaceholder, actualValue)
+}
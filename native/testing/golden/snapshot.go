@@ -0,0 +1,219 @@
+package golden
+
+import (
+	"bytes"
+	"errors"
+	"flag"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	log "github.com/echocat/slf4g"
+
+	"github.com/echocat/slf4g/native/color"
+	"github.com/echocat/slf4g/native/formatter"
+)
+
+// UpdateFlagName is the name of the command line flag which forces all
+// golden files to be (re)written instead of being compared. It is namespaced
+// to not collide with flags (like -update) of the tests themselves.
+const UpdateFlagName = "golden.update"
+
+func init() {
+	if flag.Lookup(UpdateFlagName) == nil {
+		flag.Bool(UpdateFlagName, false, "Rewrite golden files instead of comparing against them.")
+	}
+}
+
+// Source provides all recorded events which should be formatted. This is
+// implemented by recording.Provider, recording.CoreLogger and
+// consumer.Recorder.
+type Source interface {
+	GetAll() []log.Event
+}
+
+// Assert is like Snapshot.Assert() of a Snapshot with its default settings.
+func Assert(t testing.TB, goldenFile string, source Source) bool {
+	t.Helper()
+	return NewSnapshot().Assert(t, goldenFile, source)
+}
+
+// NewSnapshot creates a new instance of Snapshot which can be customized
+// using customizer and is ready to use.
+func NewSnapshot(customizer ...func(*Snapshot)) *Snapshot {
+	result := &Snapshot{}
+	for _, c := range customizer {
+		c(result)
+	}
+	return result
+}
+
+// Snapshot formats recorded events and compares them against golden files.
+type Snapshot struct {
+	// Formatter is used to format each of the recorded events. If nil, a
+	// formatter.Text without any colors will be used. Ensure that a custom
+	// Formatter prints the fields in a stable order (like formatter.Json with
+	// fields.DefaultKeySorter); otherwise the output will change between runs.
+	Formatter formatter.Formatter
+
+	// Normalizers are applied to each event before it will be formatted. If
+	// nil DefaultNormalizers will be used.
+	Normalizers []Normalizer
+
+	// Provider is passed to the Normalizers and the Formatter. If nil the
+	// Source itself will be used if it is a log.Provider (like
+	// recording.Provider) or its provider if it is a log.CoreLogger (like
+	// recording.CoreLogger), otherwise log.GetProvider().
+	Provider log.Provider
+
+	// Update forces (if set to true) that the golden files will be (re)written
+	// instead of compared. If nil the command line flag UpdateFlagName will
+	// be used.
+	Update *bool
+}
+
+// Assert formats all events of the given Source and compares them against the
+// content of the given goldenFile. In case of mismatches these will be
+// reported using testing.TB.Errorf() and false will be returned.
+//
+// If updates are requested (see Snapshot.Update) the golden file will be
+// (re)written instead.
+func (instance *Snapshot) Assert(t testing.TB, goldenFile string, source Source) bool {
+	t.Helper()
+
+	actual, err := instance.Format(source)
+	if err != nil {
+		t.Errorf("Cannot format recorded events: %v", err)
+		return false
+	}
+
+	if instance.isUpdate() {
+		if err := instance.write(goldenFile, actual); err != nil {
+			t.Errorf("Cannot write golden file %s: %v", goldenFile, err)
+			return false
+		}
+		return true
+	}
+
+	expected, err := os.ReadFile(goldenFile)
+	if errors.Is(err, os.ErrNotExist) {
+		t.Errorf("Golden file %s does not exist; run the test with -%s to create it.", goldenFile, UpdateFlagName)
+		return false
+	}
+	if err != nil {
+		t.Errorf("Cannot read golden file %s: %v", goldenFile, err)
+		return false
+	}
+
+	if bytes.Equal(expected, actual) {
+		return true
+	}
+
+	t.Errorf("Log output does not match golden file %s (run the test with -%s to update it):%s",
+		goldenFile, UpdateFlagName, describeDiff(string(expected), string(actual)))
+	return false
+}
+
+// Format returns the normalized and formatted content of all events of the
+// given Source.
+func (instance *Snapshot) Format(source Source) ([]byte, error) {
+	provider := instance.getProvider(source)
+	f := instance.getFormatter()
+	normalizers := instance.getNormalizers()
+
+	var buf bytes.Buffer
+	for i, event := range source.GetAll() {
+		for _, n := range normalizers {
+			event = n(event, provider)
+		}
+		b, err := f.Format(event, provider, nil)
+		if err != nil {
+			return nil, fmt.Errorf("cannot format event #%d: %w", i, err)
+		}
+		buf.Write(b)
+	}
+	return buf.Bytes(), nil
+}
+
+func (instance *Snapshot) write(goldenFile string, content []byte) error {
+	if err := os.MkdirAll(filepath.Dir(goldenFile), 0755); err != nil {
+		return err
+	}
+	return os.WriteFile(goldenFile, content, 0644)
+}
+
+func (instance *Snapshot) isUpdate() bool {
+	if v := instance.Update; v != nil {
+		return *v
+	}
+	if f := flag.Lookup(UpdateFlagName); f != nil {
+		if g, ok := f.Value.(flag.Getter); ok {
+			v, _ := g.Get().(bool)
+			return v
+		}
+	}
+	return false
+}
+
+func (instance *Snapshot) getFormatter() formatter.Formatter {
+	if v := instance.Formatter; v != nil {
+		return v
+	}
+	return formatter.NewText(func(v *formatter.Text) {
+		v.ColorMode = color.ModeNever
+	})
+}
+
+func (instance *Snapshot) getNormalizers() []Normalizer {
+	if v := instance.Normalizers; v != nil {
+		return v
+	}
+	return DefaultNormalizers
+}
+
+func (instance *Snapshot) getProvider(source Source) log.Provider {
+	if v := instance.Provider; v != nil {
+		return v
+	}
+	if v, ok := source.(log.Provider); ok {
+		return v
+	}
+	if v, ok := source.(log.CoreLogger); ok {
+		return v.GetProvider()
+	}
+	return log.GetProvider()
+}
+
+func describeDiff(expected, actual string) string {
+	expectedLines := strings.Split(expected, "\n")
+	actualLines := strings.Split(actual, "\n")
+
+	n := len(expectedLines)
+	if len(actualLines) > n {
+		n = len(actualLines)
+	}
+
+	var buf strings.Builder
+	for i := 0; i < n; i++ {
+		var e, a *string
+		if i < len(expectedLines) {
+			e = &expectedLines[i]
+		}
+		if i < len(actualLines) {
+			a = &actualLines[i]
+		}
+		if e != nil && a != nil && *e == *a {
+			continue
+		}
+		buf.WriteString(fmt.Sprintf("\n\tline %d:", i+1))
+		if e != nil {
+			buf.WriteString(fmt.Sprintf("\n\t\t- %s", *e))
+		}
+		if a != nil {
+			buf.WriteString(fmt.Sprintf("\n\t\t+ %s", *a))
+		}
+	}
+	return buf.String()
+}
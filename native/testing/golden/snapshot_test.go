@@ -0,0 +1,166 @@
+package golden
+
+import (
+	"errors"
+	"flag"
+	"fmt"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	log "github.com/echocat/slf4g"
+	"github.com/echocat/slf4g/fields"
+	"github.com/echocat/slf4g/level"
+	"github.com/echocat/slf4g/testing/recording"
+
+	"github.com/echocat/slf4g/native"
+	"github.com/echocat/slf4g/native/consumer"
+	"github.com/echocat/slf4g/native/formatter"
+	"github.com/echocat/slf4g/native/hints"
+
+	"github.com/echocat/slf4g/internal/test/assert"
+)
+
+// givenUpdateFlagOfTest ensures that tests can still declare their own
+// -update flag; otherwise this package would panic while initialization.
+var givenUpdateFlagOfTest = flag.Bool("update", false, "Flag of the test itself.")
+
+func Test_UpdateFlagName_isRegistered(t *testing.T) {
+	actual := flag.Lookup(UpdateFlagName)
+
+	assert.ToBeNotNil(t, actual)
+	assert.ToBeEqual(t, "false", actual.DefValue)
+	assert.ToBeEqual(t, false, *givenUpdateFlagOfTest)
+}
+
+func Test_Assert_usingRecording(t *testing.T) {
+	provider := recording.NewProvider()
+	provider.GetRootLogger().Info("hello")
+	provider.GetLogger("foo").
+		With("a", 1).
+		With("took", 123*time.Millisecond).
+		WithError(errors.New("expected")).
+		Warn("world")
+
+	actual := Assert(t, "testdata/recording.golden", provider)
+
+	assert.ToBeEqual(t, true, actual)
+}
+
+func Test_Assert_usingRecorder(t *testing.T) {
+	recorder := consumer.NewRecorder()
+	provider := &native.Provider{Consumer: recorder}
+	provider.GetRootLogger().Info("hello")
+	provider.GetLogger("foo").With("a", 1).Error("world")
+
+	actual := NewSnapshot(func(v *Snapshot) {
+		v.Provider = provider
+		v.Formatter = formatter.NewJson(func(v *formatter.Json) {
+			v.KeySorter = fields.DefaultKeySorter
+		})
+	}).Assert(t, "testdata/recorder.golden", recorder)
+
+	assert.ToBeEqual(t, true, actual)
+}
+
+func Test_Snapshot_Assert_mismatch(t *testing.T) {
+	provider := recording.NewProvider()
+	provider.GetRootLogger().Info("hello")
+	provider.GetRootLogger().Info("changed")
+	tb := &recordingTB{TB: t}
+
+	actual := NewSnapshot(func(v *Snapshot) {
+		v.Formatter = formatter.Func(func(event log.Event, using log.Provider, _ hints.Hints) ([]byte, error) {
+			return []byte(*log.GetMessageOf(event, using) + "\n"), nil
+		})
+	}).Assert(tb, "testdata/mismatch.golden", provider)
+
+	assert.ToBeEqual(t, false, actual)
+	assert.ToBeEqual(t, "Log output does not match golden file testdata/mismatch.golden (run the test with -golden.update to update it):\n"+
+		"\tline 2:\n"+
+		"\t\t- world\n"+
+		"\t\t+ changed\n"+
+		"\tline 3:\n"+
+		"\t\t- more\n"+
+		"\t\t+ \n"+
+		"\tline 4:\n"+
+		"\t\t- ",
+		tb.String())
+}
+
+func Test_Snapshot_Assert_missingFile(t *testing.T) {
+	provider := recording.NewProvider()
+	tb := &recordingTB{TB: t}
+
+	actual := Assert(tb, "testdata/missing.golden", provider)
+
+	assert.ToBeEqual(t, false, actual)
+	assert.ToBeEqual(t, "Golden file testdata/missing.golden does not exist; run the test with -golden.update to create it.", tb.String())
+}
+
+func Test_Snapshot_Assert_update(t *testing.T) {
+	goldenFile := filepath.Join(t.TempDir(), "sub", "update.golden")
+	provider := recording.NewProvider()
+	provider.GetRootLogger().Info("hello")
+
+	actual1 := NewSnapshot(func(v *Snapshot) {
+		v.Update = func(v bool) *bool { return &v }(true)
+	}).Assert(t, goldenFile, provider)
+	actual2 := Assert(t, goldenFile, provider)
+
+	assert.ToBeEqual(t, true, actual1)
+	assert.ToBeEqual(t, true, actual2)
+	content, err := os.ReadFile(goldenFile)
+	assert.ToBeNoError(t, err)
+	assert.ToBeEqual(t, "00:00:00.000[ INFO] hello                                             \n", string(content))
+}
+
+func Test_Snapshot_Assert_formatError(t *testing.T) {
+	provider := recording.NewProvider()
+	provider.GetRootLogger().Info("hello")
+	tb := &recordingTB{TB: t}
+
+	actual := NewSnapshot(func(v *Snapshot) {
+		v.Formatter = formatter.Func(func(log.Event, log.Provider, hints.Hints) ([]byte, error) {
+			return nil, errors.New("expected")
+		})
+	}).Assert(tb, "testdata/recording.golden", provider)
+
+	assert.ToBeEqual(t, false, actual)
+	assert.ToBeEqual(t, "Cannot format recorded events: cannot format event #0: expected", tb.String())
+}
+
+func Test_Snapshot_Format(t *testing.T) {
+	logger := recording.NewProvider().GetRootLogger()
+	logger.Log(logger.NewEvent(level.Warn, map[string]interface{}{
+		"message":   "foo",
+		"timestamp": time.Now(),
+		"took":      time.Second,
+	}), 0)
+
+	actual, err := NewSnapshot(func(v *Snapshot) {
+		v.Normalizers = []Normalizer{NormalizeLogger, NormalizeTimestamp}
+	}).Format(logger.(Source))
+
+	assert.ToBeNoError(t, err)
+	assert.ToBeEqual(t, "00:00:00.000[ WARN] foo                                                took=1s\n", string(actual))
+}
+
+type recordingTB struct {
+	testing.TB
+	errors []string
+}
+
+func (instance *recordingTB) Helper() {}
+
+func (instance *recordingTB) Errorf(format string, args ...interface{}) {
+	instance.errors = append(instance.errors, fmt.Sprintf(format, args...))
+}
+
+func (instance *recordingTB) String() string {
+	if len(instance.errors) == 0 {
+		return ""
+	}
+	return instance.errors[0]
+}
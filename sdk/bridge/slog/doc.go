@@ -12,4 +12,13 @@
 //	import (
 //	   _ "github.com/echocat/slf4g/hooks/sdkslog"
 //	)
+//
+// # Provider
+//
+// The other way around is also possible: [Provider] converts all events logged
+// using slf4g into [log/slog.Record]s and forwards them to the
+// [log/slog.Handler] of an existing [log/slog.Logger]. This allows to use any
+// [log/slog.Handler] (like [log/slog.JSONHandler]) as the backend of slf4g:
+//
+//	log.SetProvider(sdk.NewProvider(slog.New(slog.NewJSONHandler(os.Stdout, nil))))
 package sdk
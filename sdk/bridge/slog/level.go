@@ -5,6 +5,7 @@ package sdk
 import (
 	"fmt"
 	sdk "log/slog"
+	"math"
 
 	"github.com/echocat/slf4g/level"
 )
@@ -18,6 +19,14 @@ const (
 	LevelFatal = sdk.Level(12)
 )
 
+// levelsPerSdkLevel is the distance between two level.Level ordinals
+// which are represented by neighboring sdk.Level values. Custom levels
+// (see level.Register()) are mapped using this ratio, rounded to the nearest
+// sdk.Level; like a level.Level of 3500 (between level.Info and level.Warn)
+// is mapped to sdk.Level(2) and 3400 to sdk.Level(2), too. The way back
+// resolves to the registered level which is mapped to the given sdk.Level.
+const levelsPerSdkLevel = 250
+
 type LevelMapper interface {
 	FromSdk(sdk.Level) (level.Level, error)
 	ToSdk(level.Level) (sdk.Level, error)
@@ -46,10 +55,45 @@ func (instance *defaultLevelMapper) FromSdk(v sdk.Level) (level.Level, error) {
 	case LevelFatal:
 		return level.Fatal, nil
 	default:
+		if l, ok := registeredLevelOf(v); ok {
+			return l, nil
+		}
 		return 0, fmt.Errorf("unknown slog level: %d", v)
 	}
 }
 
+// registeredLevelOf returns the registered level.Level (see level.Register())
+// which is mapped to the given sdk.Level. If there are several, the one which
+// is closest to the exact ratio is used.
+func registeredLevelOf(v sdk.Level) (level.Level, bool) {
+	exact := int(level.Info) + int(v)*levelsPerSdkLevel
+	if exact > 0 && exact <= math.MaxUint16 {
+		if _, ok := level.GetDefinition(level.Level(exact)); ok {
+			return level.Level(exact), true
+		}
+	}
+
+	var result level.Level
+	distance := -1
+	for _, d := range level.GetAllDefinitions() {
+		if sdkLevelOf(d.Level) != v {
+			continue
+		}
+		candidate := int(d.Level) - exact
+		if candidate < 0 {
+			candidate = -candidate
+		}
+		if distance < 0 || candidate < distance {
+			result, distance = d.Level, candidate
+		}
+	}
+	return result, distance >= 0
+}
+
+func sdkLevelOf(v level.Level) sdk.Level {
+	return sdk.Level(math.Round(float64(int(v)-int(level.Info)) / levelsPerSdkLevel))
+}
+
 func (instance *defaultLevelMapper) ToSdk(v level.Level) (sdk.Level, error) {
 	switch v {
 	case level.Trace:
@@ -65,6 +109,9 @@ func (instance *defaultLevelMapper) ToSdk(v level.Level) (sdk.Level, error) {
 	case level.Fatal:
 		return LevelFatal, nil
 	default:
+		if _, ok := level.GetDefinition(v); ok {
+			return sdkLevelOf(v), nil
+		}
 		return 0, fmt.Errorf("unknown log level: %d", v)
 	}
 }
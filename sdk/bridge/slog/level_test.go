@@ -24,6 +24,12 @@ func TestNewLevelMapper(t *testing.T) {
 
 func TestDefaultLevelMapper_FromSdk(t *testing.T) {
 	instance := &defaultLevelMapper{}
+	level.Register(level.Definition{Level: 3500, Name: "NOTICE"})
+	defer level.Unregister(3500)
+	level.Register(level.Definition{Level: 2600, Name: "VERBOSE"})
+	defer level.Unregister(2600)
+	level.Register(level.Definition{Level: 4390, Name: "ALERT"})
+	defer level.Unregister(4390)
 
 	cases := []struct {
 		input       sdk.Level
@@ -36,6 +42,10 @@ func TestDefaultLevelMapper_FromSdk(t *testing.T) {
 		{LevelWarn, level.Warn, ""},
 		{LevelError, level.Error, ""},
 		{LevelFatal, level.Fatal, ""},
+		{2, 3500, ""},
+		{-2, 2600, ""},
+		{6, 4390, ""},
+		{3, 0, "unknown slog level: 3"},
 		{666, 0, "unknown slog level: 666"},
 	}
 
@@ -55,6 +65,12 @@ func TestDefaultLevelMapper_FromSdk(t *testing.T) {
 
 func TestDefaultLevelMapper_ToSdk(t *testing.T) {
 	instance := &defaultLevelMapper{}
+	level.Register(level.Definition{Level: 3500, Name: "NOTICE"})
+	defer level.Unregister(3500)
+	level.Register(level.Definition{Level: 2600, Name: "VERBOSE"})
+	defer level.Unregister(2600)
+	level.Register(level.Definition{Level: 4390, Name: "ALERT"})
+	defer level.Unregister(4390)
 
 	cases := []struct {
 		input       level.Level
@@ -67,6 +83,9 @@ func TestDefaultLevelMapper_ToSdk(t *testing.T) {
 		{level.Warn, LevelWarn, ""},
 		{level.Error, LevelError, ""},
 		{level.Fatal, LevelFatal, ""},
+		{3500, 2, ""},
+		{2600, -2, ""},
+		{4390, 6, ""},
 		{666, 0, "unknown log level: 666"},
 	}
 
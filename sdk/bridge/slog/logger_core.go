@@ -0,0 +1,126 @@
+//go:build go1.21
+
+package sdk
+
+import (
+	"context"
+	sdk "log/slog"
+	"runtime"
+	"time"
+
+	log "github.com/echocat/slf4g"
+	"github.com/echocat/slf4g/fields"
+	"github.com/echocat/slf4g/internal/events"
+	"github.com/echocat/slf4g/level"
+)
+
+// CoreLogger implements [log.CoreLogger] of the slf4g framework which converts
+// all logged events into [sdk.Record]s.
+//
+// You cannot create a working instance of this by yourself. It can only be done
+// by the [Provider] instance.
+type CoreLogger struct {
+	provider *Provider
+	name     string
+}
+
+// Log implements [log.CoreLogger.Log]
+func (instance *CoreLogger) Log(event log.Event, skipFrames uint16) {
+	if event == nil {
+		return
+	}
+	ctx := context.Background()
+	handler := instance.provider.getTarget().Handler()
+
+	sl, err := instance.provider.getLevelMapper().ToSdk(event.GetLevel())
+	if err != nil || !handler.Enabled(ctx, sl) {
+		return
+	}
+
+	var pcs [1]uintptr
+	runtime.Callers(int(skipFrames)+2, pcs[:])
+
+	record := instance.recordOf(event, sl, pcs[0])
+	_ = handler.Handle(ctx, record)
+}
+
+func (instance *CoreLogger) recordOf(event log.Event, sl sdk.Level, pc uintptr) sdk.Record {
+	provider := instance.provider
+	spec := provider.GetFieldKeysSpec()
+
+	var msg string
+	if v := log.GetMessageOf(event, provider); v != nil {
+		msg = *v
+	}
+	ts := time.Now()
+	if v := log.GetTimestampOf(event, provider); v != nil {
+		ts = *v
+	}
+
+	result := sdk.NewRecord(ts, sl, msg, pc)
+	if instance.name != rootLoggerName {
+		result.AddAttrs(sdk.String(spec.GetLogger(), instance.name))
+	}
+
+	_ = fields.SortedForEach(event, nil, func(k string, v interface{}) error {
+		switch k {
+		case spec.GetMessage(), spec.GetTimestamp(), spec.GetLogger():
+			return nil
+		}
+		if vf, ok := v.(fields.Filtered); ok {
+			fv, shouldBeRespected := vf.Filter(event)
+			if !shouldBeRespected {
+				return nil
+			}
+			v = fv
+		} else if vl, ok := v.(fields.Lazy); ok {
+			v = vl.Get()
+		}
+		if v == fields.Exclude {
+			return nil
+		}
+		result.AddAttrs(sdk.Any(k, v))
+		return nil
+	})
+
+	return result
+}
+
+// IsLevelEnabled implements [log.CoreLogger.IsLevelEnabled]
+func (instance *CoreLogger) IsLevelEnabled(l level.Level) bool {
+	sl, err := instance.provider.getLevelMapper().ToSdk(l)
+	if err != nil {
+		return false
+	}
+	return instance.provider.getTarget().Handler().Enabled(context.Background(), sl)
+}
+
+// GetName implements [log.CoreLogger.GetName]
+func (instance *CoreLogger) GetName() string {
+	return instance.name
+}
+
+// NewEvent implements [log.CoreLogger.NewEvent]
+func (instance *CoreLogger) NewEvent(l level.Level, values map[string]interface{}) log.Event {
+	return instance.NewEventWithFields(l, fields.WithAll(values))
+}
+
+// NewEventWithFields provides a shortcut if an event should directly be created
+// from fields.
+func (instance *CoreLogger) NewEventWithFields(l level.Level, f fields.ForEachEnabled) log.Event {
+	asFields, err := fields.AsFields(f)
+	if err != nil {
+		panic(err)
+	}
+	return events.New(instance.provider, asFields, l)
+}
+
+// Accepts implements [log.CoreLogger.Accepts]
+func (instance *CoreLogger) Accepts(e log.Event) bool {
+	return e != nil
+}
+
+// GetProvider implements [log.CoreLogger.GetProvider]
+func (instance *CoreLogger) GetProvider() log.Provider {
+	return instance.provider
+}
@@ -0,0 +1,113 @@
+//go:build go1.21
+
+package sdk
+
+import (
+	"context"
+	"errors"
+	sdk "log/slog"
+	"runtime"
+	"testing"
+	"time"
+
+	"github.com/echocat/slf4g/fields"
+	"github.com/echocat/slf4g/internal/test/assert"
+	"github.com/echocat/slf4g/level"
+)
+
+func TestCoreLogger_Log(t *testing.T) {
+	aTime, err := time.Parse(time.RFC3339, "2025-10-01T15:30:15Z")
+	assert.ToBeNoError(t, err)
+	anError := errors.New("expected")
+
+	handler := &recordingSdkHandler{level: LevelDebug}
+	instance := NewProvider(sdk.New(handler)).GetLogger("foo")
+
+	instance.Log(instance.NewEvent(level.Warn, map[string]interface{}{
+		"message":   "aMessage",
+		"timestamp": aTime,
+		"error":     anError,
+		"b":         fields.LazyFunc(func() interface{} { return 2 }),
+		"a":         1,
+		"excluded":  fields.Exclude,
+		"filtered":  fields.RequireMaximalLevel(level.Info, 3),
+	}), 0)
+	instance.Log(instance.NewEvent(level.Trace, nil), 0)
+	instance.Log(instance.NewEvent(666, nil), 0)
+	instance.Log(nil, 0)
+
+	assert.ToBeEqual(t, 1, len(handler.records))
+	actual := handler.records[0]
+	assert.ToBeEqual(t, LevelWarn, actual.Level)
+	assert.ToBeEqual(t, "aMessage", actual.Message)
+	assert.ToBeEqual(t, aTime, actual.Time)
+
+	frame, _ := runtime.CallersFrames([]uintptr{actual.PC}).Next()
+	assert.ToBeEqual(t, "github.com/echocat/slf4g/sdk/bridge/slog.TestCoreLogger_Log", frame.Function)
+
+	var actualAttrs []sdk.Attr
+	actual.Attrs(func(a sdk.Attr) bool {
+		actualAttrs = append(actualAttrs, a)
+		return true
+	})
+	assert.ToBeEqual(t, []sdk.Attr{
+		sdk.String("logger", "foo"),
+		sdk.Int("a", 1),
+		sdk.Int("b", 2),
+		sdk.Any("error", anError),
+	}, actualAttrs)
+}
+
+func TestCoreLogger_Log_root(t *testing.T) {
+	handler := &recordingSdkHandler{level: LevelDebug}
+	instance := NewProvider(sdk.New(handler)).GetRootLogger()
+
+	before := time.Now()
+	instance.Info("aMessage")
+
+	assert.ToBeEqual(t, 1, len(handler.records))
+	actual := handler.records[0]
+	assert.ToBeEqual(t, LevelInfo, actual.Level)
+	assert.ToBeEqual(t, "aMessage", actual.Message)
+	assert.ToBeEqual(t, 0, actual.NumAttrs())
+	assert.ToBeEqual(t, false, actual.Time.Before(before))
+}
+
+func TestCoreLogger_IsLevelEnabled(t *testing.T) {
+	instance := NewProvider(sdk.New(&recordingSdkHandler{level: LevelInfo})).GetRootLogger()
+
+	assert.ToBeEqual(t, false, instance.IsLevelEnabled(level.Trace))
+	assert.ToBeEqual(t, false, instance.IsLevelEnabled(level.Debug))
+	assert.ToBeEqual(t, true, instance.IsLevelEnabled(level.Info))
+	assert.ToBeEqual(t, true, instance.IsLevelEnabled(level.Fatal))
+	assert.ToBeEqual(t, false, instance.IsLevelEnabled(666))
+}
+
+func TestCoreLogger_Accepts(t *testing.T) {
+	instance := NewProvider(sdk.New(&recordingSdkHandler{})).GetRootLogger()
+
+	assert.ToBeEqual(t, true, instance.Accepts(instance.NewEvent(level.Info, nil)))
+	assert.ToBeEqual(t, false, instance.Accepts(nil))
+}
+
+type recordingSdkHandler struct {
+	level   sdk.Level
+	records []sdk.Record
+}
+
+func (instance *recordingSdkHandler) Enabled(_ context.Context, l sdk.Level) bool {
+	return l >= instance.level
+}
+
+func (instance *recordingSdkHandler) Handle(_ context.Context, r sdk.Record) error {
+	instance.records = append(instance.records, r)
+	return nil
+}
+
+func (instance *recordingSdkHandler) WithAttrs([]sdk.Attr) sdk.Handler {
+	panic("should never be called")
+}
+
+func (instance *recordingSdkHandler) WithGroup(string) sdk.Handler {
+	panic("should never be called")
+}
@@ -0,0 +1,161 @@
+//go:build go1.21
+
+package sdk
+
+import (
+	sdklog "log"
+	sdk "log/slog"
+	"reflect"
+	"sync"
+
+	log "github.com/echocat/slf4g"
+	"github.com/echocat/slf4g/fields"
+	"github.com/echocat/slf4g/level"
+)
+
+const (
+	rootLoggerName = "ROOT"
+)
+
+// NewProvider creates a new instance of [Provider] which forwards everything
+// to the given target.
+//
+// It panics if the target is nil or if it would forward everything back into
+// slf4g; like a [Handler] or the [sdk.Default] logger while the SDK logging is
+// routed into slf4g (see [Configure] and
+// [github.com/echocat/slf4g/sdk/bridge.Configure]). This would end up in an
+// endless recursion.
+func NewProvider(target *sdk.Logger, customizer ...func(*Provider)) *Provider {
+	if target == nil {
+		panic("the target of a slog provider must not be nil")
+	}
+	if routesIntoSlf4g(target) {
+		panic("the target of a slog provider must not forward into slf4g itself; as this provider will be used by slf4g this would end up in an endless recursion")
+	}
+	result := &Provider{
+		Target: target,
+	}
+
+	for _, c := range customizer {
+		c(result)
+	}
+
+	return result
+}
+
+// Provider implements [log.Provider] of the slf4g framework which converts all
+// logged events into [sdk.Record]s and forwards them to the [sdk.Handler] of
+// an existing [sdk.Logger]. This is the reverse direction of [Handler].
+type Provider struct {
+	// Target is the [sdk.Logger] where to forward all logged events to. It is
+	// required and must not forward into slf4g itself (see [NewProvider]).
+	Target *sdk.Logger
+
+	// Name represents the name of this Provider. If empty it will be "slog"
+	// by default.
+	Name string
+
+	// LevelMapper holds the mapper which is used to transform the levels
+	// between [sdk.Level] and [github.com/echocat/slf4g/level.Level].
+	//
+	// If empty [DefaultLevelMapper] will be used.
+	LevelMapper LevelMapper
+
+	// LevelProvider is used to determine the [level.Levels] support by this
+	// Provider and all of its managed loggers. If this is not set it will be
+	// [level.GetProvider] by default.
+	LevelProvider level.Provider
+
+	// FieldKeysSpec defines what are the keys of the major fields managed by
+	// this Provider and its managed loggers. If this is not set it will be
+	// [fields.KeysSpecImpl] by default.
+	FieldKeysSpec fields.KeysSpec
+
+	cacheOnce sync.Once
+	cache     log.LoggerCache
+}
+
+// GetName implements [log.Provider.GetName]
+func (instance *Provider) GetName() string {
+	if v := instance.Name; v != "" {
+		return v
+	}
+	return "slog"
+}
+
+// GetRootLogger implements [log.Provider.GetRootLogger]
+func (instance *Provider) GetRootLogger() log.Logger {
+	return instance.getCache().GetRootLogger()
+}
+
+// GetLogger implements [log.Provider.GetLogger]
+func (instance *Provider) GetLogger(name string) log.Logger {
+	return instance.getCache().GetLogger(name)
+}
+
+// GetAllLevels implements [log.Provider.GetAllLevels]
+func (instance *Provider) GetAllLevels() level.Levels {
+	p := instance.LevelProvider
+	if p == nil {
+		p = level.GetProvider()
+	}
+	return p.GetLevels()
+}
+
+// GetFieldKeysSpec implements [log.Provider.GetFieldKeysSpec]
+func (instance *Provider) GetFieldKeysSpec() fields.KeysSpec {
+	if v := instance.FieldKeysSpec; v != nil {
+		return v
+	}
+	return fields.KeysSpecImpl{}
+}
+
+func (instance *Provider) getTarget() *sdk.Logger {
+	if v := instance.Target; v != nil {
+		return v
+	}
+	panic("the target of a slog provider must not be nil")
+}
+
+// defaultHandlerType is the type of the handler of [sdk.Default] as long it
+// was not replaced using [sdk.SetDefault]. It writes everything into the
+// logger of the SDK's [sdklog] package.
+var defaultHandlerType = reflect.TypeOf(sdk.Default().Handler())
+
+func routesIntoSlf4g(target *sdk.Logger) bool {
+	switch h := target.Handler().(type) {
+	case *Handler:
+		return true
+	default:
+		if reflect.TypeOf(h) != defaultHandlerType {
+			return false
+		}
+		_, ok := sdklog.Writer().(*log.LoggingWriter)
+		return ok
+	}
+}
+
+func (instance *Provider) getLevelMapper() LevelMapper {
+	if v := instance.LevelMapper; v != nil {
+		return v
+	}
+	return DefaultLevelMapper
+}
+
+func (instance *Provider) getCache() log.LoggerCache {
+	instance.cacheOnce.Do(func() {
+		instance.cache = log.NewLoggerCache(instance.rootFactory, instance.factory)
+	})
+	return instance.cache
+}
+
+func (instance *Provider) rootFactory() log.Logger {
+	return instance.factory(rootLoggerName)
+}
+
+func (instance *Provider) factory(name string) log.Logger {
+	return log.NewLogger(&CoreLogger{
+		provider: instance,
+		name:     name,
+	})
+}
@@ -0,0 +1,75 @@
+//go:build go1.21
+
+package sdk
+
+import (
+	"bytes"
+	sdk "log/slog"
+	"testing"
+
+	"github.com/echocat/slf4g/fields"
+	"github.com/echocat/slf4g/internal/test/assert"
+	"github.com/echocat/slf4g/level"
+)
+
+func TestNewProvider(t *testing.T) {
+	target := sdk.New(sdk.NewTextHandler(&bytes.Buffer{}, nil))
+
+	actual := NewProvider(target, func(v *Provider) {
+		v.Name = "foo"
+	})
+
+	assert.ToBeNotNil(t, actual)
+	assert.ToBeSame(t, target, actual.Target)
+	assert.ToBeEqual(t, "foo", actual.GetName())
+}
+
+func TestProvider_GetName(t *testing.T) {
+	assert.ToBeEqual(t, "slog", (&Provider{}).GetName())
+	assert.ToBeEqual(t, "foo", (&Provider{Name: "foo"}).GetName())
+}
+
+func TestProvider_GetAllLevels(t *testing.T) {
+	assert.ToBeEqual(t, level.GetProvider().GetLevels(), (&Provider{}).GetAllLevels())
+}
+
+func TestProvider_GetFieldKeysSpec(t *testing.T) {
+	givenSpec := &fields.KeysSpecImpl{Message: "msg"}
+
+	assert.ToBeEqual(t, fields.KeysSpecImpl{}, (&Provider{}).GetFieldKeysSpec())
+	assert.ToBeSame(t, givenSpec, (&Provider{FieldKeysSpec: givenSpec}).GetFieldKeysSpec())
+}
+
+func TestProvider_getTarget(t *testing.T) {
+	target := sdk.New(sdk.NewTextHandler(&bytes.Buffer{}, nil))
+
+	assert.ToBeSame(t, target, (&Provider{Target: target}).getTarget())
+	assert.Execution(t, func() {
+		(&Provider{}).getTarget()
+	}).WillPanicWith("^the target of a slog provider must not be nil$")
+}
+
+func TestNewProvider_rejectsIllegalTargets(t *testing.T) {
+	assert.Execution(t, func() {
+		NewProvider(nil)
+	}).WillPanicWith("^the target of a slog provider must not be nil$")
+	assert.Execution(t, func() {
+		NewProvider(sdk.New(NewHandler(nil)))
+	}).WillPanicWith("^the target of a slog provider must not forward into slf4g itself")
+
+	// As long the SDK logging is not routed into slf4g the default is fine.
+	assert.ToBeSame(t, sdk.Default(), NewProvider(sdk.Default()).Target)
+}
+
+func TestProvider_GetLogger(t *testing.T) {
+	instance := &Provider{}
+
+	root := instance.GetRootLogger()
+	assert.ToBeSame(t, root, instance.GetRootLogger())
+	assert.ToBeEqual(t, rootLoggerName, root.GetName())
+
+	foo := instance.GetLogger("foo")
+	assert.ToBeSame(t, foo, instance.GetLogger("foo"))
+	assert.ToBeEqual(t, "foo", foo.GetName())
+	assert.ToBeSame(t, instance, foo.GetProvider())
+}
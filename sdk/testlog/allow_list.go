@@ -0,0 +1,229 @@
+package testlog
+
+import (
+	"fmt"
+	"path/filepath"
+	"regexp"
+	"runtime"
+	"strings"
+
+	log "github.com/echocat/slf4g"
+	"github.com/echocat/slf4g/level"
+	tlevel "github.com/echocat/slf4g/sdk/testlog/level"
+)
+
+// DefaultAllowListLevel is used if AllowListLevel was not used.
+var DefaultAllowListLevel = level.Warn
+
+// ExpectedEvent describes a log.Event which is expected to be logged while a
+// test run. See AllowList(..) and Provider.Expect(..) for more details.
+type ExpectedEvent struct {
+	// Logger is the name of the logger the event is expected at. If empty,
+	// every logger matches.
+	Logger string
+
+	// Level is the level.Level the event is expected with. If 0, every
+	// level.Level matches.
+	Level level.Level
+
+	// Message is a regular expression the message of the event is expected to
+	// match. If empty, every message matches.
+	Message string
+}
+
+// String returns a human-readable representation of this ExpectedEvent. The
+// level is described using level.DefaultNames.
+func (instance ExpectedEvent) String() string {
+	return instance.format(tlevel.DefaultNames)
+}
+
+func (instance ExpectedEvent) format(names level.Names) string {
+	var parts []string
+	if v := instance.Logger; v != "" {
+		parts = append(parts, fmt.Sprintf("logger=%q", v))
+	}
+	if v := instance.Level; v != 0 {
+		parts = append(parts, "level="+formatLevel(names, v))
+	}
+	if v := instance.Message; v != "" {
+		parts = append(parts, fmt.Sprintf("message=~/%s/", v))
+	}
+	if len(parts) == 0 {
+		return "<any>"
+	}
+	return strings.Join(parts, " ")
+}
+
+func formatLevel(names level.Names, l level.Level) string {
+	if names != nil {
+		if name, err := names.ToName(l); err == nil {
+			return name
+		}
+	}
+	return fmt.Sprintf("%d", l)
+}
+
+// AllowList enables the allow-list mode of the Provider and registers the
+// given events as expected. See Provider.Expect(..) for more details.
+func AllowList(expected ...ExpectedEvent) func(*Provider) {
+	return func(provider *Provider) {
+		provider.Expect(expected...)
+	}
+}
+
+// AllowListLevel defines the level.Level from which on each log.Event has to
+// be expected if the allow-list mode is enabled. By default, the Provider will
+// use DefaultAllowListLevel.
+func AllowListLevel(v level.Level) func(*Provider) {
+	return func(provider *Provider) {
+		provider.allowListLevel = v
+	}
+}
+
+// Expect enables the allow-list mode of this Provider (if not already done)
+// and registers the given events as expected.
+//
+// In allow-list mode every log.Event with at least the AllowListLevel has to
+// match at least one ExpectedEvent. FailAtLevel and FailNowAtLevel are not
+// respected anymore. Instead, at the end of the related test run (see
+// testing.TB#Cleanup) the test fails with a report of all events which were
+// not expected (including their locations) and all expected events which never
+// occurred.
+func (instance *Provider) Expect(expected ...ExpectedEvent) {
+	instance.allowListMutex.Lock()
+	defer instance.allowListMutex.Unlock()
+
+	for _, e := range expected {
+		var messagePattern *regexp.Regexp
+		if e.Message != "" {
+			messagePattern = regexp.MustCompile(e.Message)
+		}
+		instance.expectedEvents = append(instance.expectedEvents, &expectedEvent{
+			ExpectedEvent:  e,
+			messagePattern: messagePattern,
+		})
+	}
+
+	if !instance.allowListEnabled {
+		instance.allowListEnabled = true
+		instance.tb.Cleanup(instance.reportAllowList)
+	}
+}
+
+type expectedEvent struct {
+	ExpectedEvent
+	messagePattern *regexp.Regexp
+	occurred       int
+}
+
+func (instance *expectedEvent) matches(loggerName string, l level.Level, message string) bool {
+	if v := instance.Logger; v != "" && v != loggerName {
+		return false
+	}
+	if v := instance.Level; v != 0 && v != l {
+		return false
+	}
+	if v := instance.messagePattern; v != nil && !v.MatchString(message) {
+		return false
+	}
+	return true
+}
+
+type unexpectedEvent struct {
+	formatted string
+	location  string
+}
+
+func (instance *Provider) isAllowListEnabled() bool {
+	instance.allowListMutex.Lock()
+	defer instance.allowListMutex.Unlock()
+
+	return instance.allowListEnabled
+}
+
+func (instance *Provider) getAllowListLevel() level.Level {
+	if v := instance.allowListLevel; v != 0 {
+		return v
+	}
+	return DefaultAllowListLevel
+}
+
+func (instance *coreLogger) checkAllowList(loggerName string, event log.Event, formatted string, skipFrames uint16) {
+	l := event.GetLevel()
+	if l < instance.getAllowListLevel() {
+		return
+	}
+
+	var message string
+	if v := log.GetMessageOf(event, instance); v != nil {
+		message = *v
+	}
+
+	instance.allowListMutex.Lock()
+	defer instance.allowListMutex.Unlock()
+
+	matched := false
+	for _, candidate := range instance.expectedEvents {
+		if candidate.matches(loggerName, l, message) {
+			candidate.occurred++
+			matched = true
+		}
+	}
+	if matched {
+		return
+	}
+
+	location := "<unknown>"
+	if _, file, line, ok := runtime.Caller(int(skipFrames) + 1); ok {
+		location = fmt.Sprintf("%s:%d", filepath.Base(file), line)
+	}
+	instance.unexpectedEvents = append(instance.unexpectedEvents, unexpectedEvent{
+		formatted: formatted,
+		location:  location,
+	})
+}
+
+func (instance *Provider) reportAllowList() {
+	instance.allowListMutex.Lock()
+	defer instance.allowListMutex.Unlock()
+
+	var buf strings.Builder
+	if n := len(instance.unexpectedEvents); n > 0 {
+		buf.WriteString(fmt.Sprintf("Unexpected log events (%d):", n))
+		for _, e := range instance.unexpectedEvents {
+			buf.WriteString("\n\t")
+			buf.WriteString(e.location)
+			buf.WriteString(": ")
+			buf.WriteString(e.formatted)
+		}
+	}
+
+	var missing []string
+	for _, e := range instance.expectedEvents {
+		if e.occurred == 0 {
+			missing = append(missing, e.format(instance.GetLevelNames()))
+		}
+	}
+	if n := len(missing); n > 0 {
+		if buf.Len() > 0 {
+			buf.WriteString("\n")
+		}
+		buf.WriteString(fmt.Sprintf("Expected log events which never occurred (%d):", n))
+		for _, e := range missing {
+			buf.WriteString("\n\t")
+			buf.WriteString(e)
+		}
+	}
+
+	if buf.Len() == 0 {
+		return
+	}
+
+	i := instance.interceptReport
+	if i == nil {
+		i = func(msg string) {
+			instance.tb.Error(msg)
+		}
+	}
+	i(buf.String())
+}
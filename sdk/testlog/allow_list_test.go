@@ -0,0 +1,157 @@
+package testlog
+
+import (
+	"fmt"
+	"runtime"
+	"testing"
+
+	log "github.com/echocat/slf4g"
+	"github.com/echocat/slf4g/level"
+
+	"github.com/echocat/slf4g/internal/test/assert"
+)
+
+func TestAllowList_allExpected(t *testing.T) {
+	var actualReport string
+	var actualFailed bool
+
+	t.Run("inner", func(t *testing.T) {
+		provider := NewProvider(t,
+			TimeFormat(NoopTimeFormat),
+			AllowList(ExpectedEvent{Level: level.Warn, Message: "^foo"}),
+			func(p *Provider) {
+				p.interceptLogDepth = func(string, uint16) {}
+				p.interceptFail = func() { actualFailed = true }
+				p.interceptFailNow = func() { actualFailed = true }
+				p.interceptReport = func(msg string) { actualReport = msg }
+			},
+		)
+		provider.Expect(ExpectedEvent{Logger: "bar", Level: level.Error})
+
+		provider.GetRootLogger().Info("not relevant because below allow-list level")
+		provider.GetRootLogger().Warn("foo 1")
+		provider.GetRootLogger().Warn("foo 2")
+		provider.GetLogger("bar").Error("anything")
+	})
+
+	assert.ToBeEqual(t, "", actualReport)
+	assert.ToBeEqual(t, false, actualFailed)
+}
+
+func TestAllowList_unexpectedAndMissing(t *testing.T) {
+	var actualReport string
+
+	t.Run("inner", func(t *testing.T) {
+		provider := NewProvider(t,
+			TimeFormat(NoopTimeFormat),
+			AllowList(
+				ExpectedEvent{Level: level.Warn, Message: "^foo"},
+				ExpectedEvent{Logger: "bar", Level: level.Error, Message: "^never$"},
+			),
+			func(p *Provider) {
+				p.interceptLogDepth = func(string, uint16) {}
+				p.interceptFail = func() { t.Error("should not fail") }
+				p.interceptFailNow = func() { t.Error("should not fail now") }
+				p.interceptReport = func(msg string) { actualReport = msg }
+			},
+		)
+
+		provider.GetRootLogger().Warn("foo")
+		provider.GetRootLogger().Warn("unexpected")
+		provider.GetLogger("bar").With("a", 1).Error("unexpected too")
+	})
+
+	assert.ToBeMatching(t, `^Unexpected log events \(2\):
+	allow_list_test\.go:\d+: \[ WARN] unexpected
+	allow_list_test\.go:\d+: \[ERROR] unexpected too a=1 logger="bar"
+Expected log events which never occurred \(1\):
+	logger="bar" level=ERROR message=~/\^never\$/$`, actualReport)
+}
+
+func TestAllowList_reportsLocationOfCaller(t *testing.T) {
+	var actualReport string
+	var line int
+
+	t.Run("inner", func(t *testing.T) {
+		provider := NewProvider(t,
+			TimeFormat(NoopTimeFormat),
+			AllowList(),
+			func(p *Provider) {
+				p.interceptLogDepth = func(string, uint16) {}
+				p.interceptReport = func(msg string) { actualReport = msg }
+			},
+		)
+		before := log.SetProvider(provider)
+		defer log.SetProvider(before)
+
+		_, _, line, _ = runtime.Caller(0)
+		provider.GetRootLogger().Warn("direct")
+		provider.GetLogger("foo").Warnf("direct %d", 2)
+		log.Warn("global")
+		log.Warnf("global %d", 2)
+		log.NewEventBuilder(provider.GetRootLogger(), level.Warn).Msg("builder")
+	})
+
+	assert.ToBeEqual(t, fmt.Sprintf(`Unexpected log events (5):
+	allow_list_test.go:%d: [ WARN] direct
+	allow_list_test.go:%d: [ WARN] direct 2 logger="foo"
+	allow_list_test.go:%d: [ WARN] global
+	allow_list_test.go:%d: [ WARN] global 2
+	allow_list_test.go:%d: [ WARN] builder`, line+1, line+2, line+3, line+4, line+5), actualReport)
+}
+
+func TestAllowList_reportsLevelsUsingLevelNames(t *testing.T) {
+	var actualReport string
+
+	t.Run("inner", func(t *testing.T) {
+		NewProvider(t,
+			AllowList(ExpectedEvent{Level: level.Error}),
+			LevelNames(&lowerCaseLevelNames{}),
+			func(p *Provider) {
+				p.interceptReport = func(msg string) { actualReport = msg }
+			},
+		)
+	})
+
+	assert.ToBeEqual(t, `Expected log events which never occurred (1):
+	level=error`, actualReport)
+}
+
+func TestAllowListLevel(t *testing.T) {
+	var actualReport string
+
+	t.Run("inner", func(t *testing.T) {
+		provider := NewProvider(t,
+			TimeFormat(NoopTimeFormat),
+			AllowList(),
+			AllowListLevel(level.Error),
+			func(p *Provider) {
+				p.interceptLogDepth = func(string, uint16) {}
+				p.interceptReport = func(msg string) { actualReport = msg }
+			},
+		)
+
+		provider.GetRootLogger().Warn("not relevant")
+		provider.GetRootLogger().Error("relevant")
+	})
+
+	assert.ToBeMatching(t, `^Unexpected log events \(1\):
+	allow_list_test\.go:\d+: \[ERROR] relevant$`, actualReport)
+}
+
+func TestExpectedEvent_String(t *testing.T) {
+	cases := []struct {
+		given    ExpectedEvent
+		expected string
+	}{
+		{ExpectedEvent{}, "<any>"},
+		{ExpectedEvent{Logger: "foo"}, `logger="foo"`},
+		{ExpectedEvent{Level: level.Warn, Message: "bar"}, `level=WARN message=~/bar/`},
+	}
+
+	for _, c := range cases {
+		t.Run(c.expected, func(t *testing.T) {
+			assert.ToBeEqual(t, c.expected, c.given.String())
+		})
+	}
+}
@@ -22,5 +22,9 @@
 //
 // ... that's it!
 //
-// See Hook(..) for more details.
+// See Hook(..) for more details. If your tests are running in parallel (see
+// testing.T#Parallel()) use HookIsolated(..) instead.
+//
+// Use AllowList(..) to declare the warnings and errors a test expects. Every
+// other event at level.Warn or above fails the test at its end.
 package testlog
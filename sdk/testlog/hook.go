@@ -4,6 +4,7 @@ import (
 	"testing"
 
 	log "github.com/echocat/slf4g"
+	"github.com/echocat/slf4g/testing/routing"
 )
 
 // Hook creates and registers for the given *testing.T, *testing.B or *testing.F
@@ -27,3 +28,20 @@ func Hook(tb testing.TB, customizer ...func(*Provider)) *Provider {
 
 	return provider
 }
+
+// HookIsolated is like Hook but only everything which is logged within the
+// goroutine of the given *testing.T, *testing.B or *testing.F (and all
+// goroutines created by it) will be handled by the created Provider. This
+// allows tests which are running in parallel (see testing.T#Parallel()) to
+// have their own output.
+//
+// The method returns the related Provider instance but while the test run it
+// is also available via log.GetProvider() for the test's goroutine. See
+// routing.Hook() for more details.
+func HookIsolated(tb testing.TB, customizer ...func(*Provider)) *Provider {
+	provider := NewProvider(tb, customizer...)
+
+	routing.Hook(tb, provider)
+
+	return provider
+}
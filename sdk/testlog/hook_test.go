@@ -1,6 +1,7 @@
 package testlog
 
 import (
+	"fmt"
 	"testing"
 
 	log "github.com/echocat/slf4g"
@@ -27,3 +28,33 @@ func TestHook(t *testing.T) {
 	assert.ToBeEqual(t, level.Level(666), actual2)
 	assert.ToBeEqual(t, true, actualOk2)
 }
+
+func TestHookIsolated(t *testing.T) {
+	before := log.UnwrapProvider(log.GetProvider())
+	messages := make([][]string, 3)
+
+	t.Run("parallel", func(t *testing.T) {
+		for i := range messages {
+			i := i
+			t.Run(fmt.Sprint(i), func(t *testing.T) {
+				t.Parallel()
+
+				provider := HookIsolated(t, func(p *Provider) {
+					p.interceptLogDepth = func(msg string, _ uint16) {
+						messages[i] = append(messages[i], msg)
+					}
+				})
+
+				log.Infof("%d", i)
+
+				assert.ToBeSame(t, provider, log.UnwrapProvider(log.UnwrapProvider(log.GetProvider())))
+			})
+		}
+	})
+
+	for i, actual := range messages {
+		assert.ToBeEqual(t, 1, len(actual))
+		assert.ToBeMatching(t, fmt.Sprintf(`^\d+ \[ INFO] %d$`, i), actual[0])
+	}
+	assert.ToBeSame(t, before, log.UnwrapProvider(log.GetProvider()))
+}
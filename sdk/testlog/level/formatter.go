@@ -31,6 +31,9 @@ var DefaultFormatter Formatter = FormatterFunc(func(l level.Level) string {
 	case level.Fatal:
 		return "FATAL"
 	default:
+		if d, ok := level.GetDefinition(l); ok {
+			return fmt.Sprintf("%5s", d.Name)
+		}
 		return fmt.Sprintf("%5d", l)
 	}
 })
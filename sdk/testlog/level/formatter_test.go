@@ -10,6 +10,9 @@ import (
 )
 
 func Test_DefaultFormatter(t *testing.T) {
+	level.Register(level.Definition{Level: 3500, Name: "NOTE"})
+	defer level.Unregister(3500)
+
 	cases := []struct {
 		given    level.Level
 		expected string
@@ -20,6 +23,7 @@ func Test_DefaultFormatter(t *testing.T) {
 		{level.Warn, " WARN"},
 		{level.Error, "ERROR"},
 		{level.Fatal, "FATAL"},
+		{level.Level(3500), " NOTE"},
 		{level.Level(666), "  666"},
 	}
 
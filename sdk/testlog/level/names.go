@@ -0,0 +1,62 @@
+package level
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/echocat/slf4g/level"
+)
+
+// DefaultNames is the default instance of level.Names which is used by the
+// testlog.Provider to describe a level.Level in a human-readable format.
+var DefaultNames level.Names = &names{}
+
+type names struct{}
+
+func (instance *names) ToName(lvl level.Level) (string, error) {
+	switch lvl {
+	case level.Trace:
+		return "TRACE", nil
+	case level.Debug:
+		return "DEBUG", nil
+	case level.Info:
+		return "INFO", nil
+	case level.Warn:
+		return "WARN", nil
+	case level.Error:
+		return "ERROR", nil
+	case level.Fatal:
+		return "FATAL", nil
+	default:
+		if d, ok := level.GetDefinition(lvl); ok {
+			return d.Name, nil
+		}
+		return fmt.Sprintf("%d", lvl), nil
+	}
+}
+
+func (instance *names) ToLevel(name string) (level.Level, error) {
+	switch strings.ToUpper(name) {
+	case "TRACE":
+		return level.Trace, nil
+	case "DEBUG":
+		return level.Debug, nil
+	case "INFO":
+		return level.Info, nil
+	case "WARN":
+		return level.Warn, nil
+	case "ERROR":
+		return level.Error, nil
+	case "FATAL":
+		return level.Fatal, nil
+	default:
+		if d, ok := level.GetDefinitionByName(name); ok {
+			return d.Level, nil
+		}
+		if result, err := strconv.ParseUint(name, 10, 16); err == nil {
+			return level.Level(result), nil
+		}
+		return 0, fmt.Errorf("%w: %s", level.ErrIllegalLevel, name)
+	}
+}
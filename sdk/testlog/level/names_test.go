@@ -0,0 +1,49 @@
+package level
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/echocat/slf4g/level"
+
+	"github.com/echocat/slf4g/internal/test/assert"
+)
+
+func Test_DefaultNames(t *testing.T) {
+	level.Register(level.Definition{Level: 3500, Name: "NOTICE"})
+	defer level.Unregister(3500)
+	instance := DefaultNames
+
+	cases := []struct {
+		level level.Level
+		name  string
+	}{
+		{level.Trace, "TRACE"},
+		{level.Debug, "DEBUG"},
+		{level.Info, "INFO"},
+		{level.Warn, "WARN"},
+		{level.Error, "ERROR"},
+		{level.Fatal, "FATAL"},
+		{3500, "NOTICE"},
+		{666, "666"},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			actualName, actualErr := instance.ToName(c.level)
+			assert.ToBeNoError(t, actualErr)
+			assert.ToBeEqual(t, c.name, actualName)
+
+			actualLevel, actualErr := instance.ToLevel(c.name)
+			assert.ToBeNoError(t, actualErr)
+			assert.ToBeEqual(t, c.level, actualLevel)
+		})
+	}
+}
+
+func Test_DefaultNames_ToLevel_failing(t *testing.T) {
+	actual, actualErr := DefaultNames.ToLevel("foo")
+
+	assert.ToBeMatching(t, fmt.Sprintf("^%v: foo$", level.ErrIllegalLevel), actualErr)
+	assert.ToBeEqual(t, level.Level(0), actual)
+}
@@ -70,7 +70,13 @@ func (instance *coreLogger) log(loggerName string, event log.Event, skipFrames u
 		event = event.With(instance.GetFieldKeysSpec().GetLogger(), loggerName)
 	}
 
-	instance.logDepth(instance.format(event), skipFrames+1)
+	formatted := instance.format(event)
+	instance.logDepth(formatted, skipFrames+1)
+
+	if instance.isAllowListEnabled() {
+		instance.checkAllowList(loggerName, event, formatted, skipFrames+1)
+		return
+	}
 
 	failNowAtLevel := instance.getFailNowAtLevel()
 	if failNowAtLevel < NeverFailLevel && l >= failNowAtLevel {
@@ -38,7 +38,7 @@ func Test_coreLogger_Log_regular(t *testing.T) {
 		Info("foo")
 
 	assert.ToBeMatching(t, `^\d+ \[ INFO] foo error="testError" intField=123 lazyField="lazy" nilField=null respectedByLevelField="respected" stringField="bar"$`, actualMsg)
-	assert.ToBeEqual(t, uint16(6), actualSkipFrames)
+	assert.ToBeEqual(t, uint16(5), actualSkipFrames)
 }
 
 func Test_coreLogger_NewEvent(t *testing.T) {
@@ -112,7 +112,7 @@ func Test_coreLogger_Log_fail(t *testing.T) {
 	provider.GetRootLogger().Error("foo")
 
 	assert.ToBeMatching(t, `^\d+ \[ERROR] foo$`, actualMsg)
-	assert.ToBeEqual(t, uint16(6), actualSkipFrames)
+	assert.ToBeEqual(t, uint16(5), actualSkipFrames)
 	assert.ToBeEqual(t, true, actualFail)
 	assert.ToBeEqual(t, false, actualFailNow)
 }
@@ -140,7 +140,7 @@ func Test_coreLogger_Log_failNow(t *testing.T) {
 	provider.GetRootLogger().Fatal("foo")
 
 	assert.ToBeMatching(t, `^\d+ \[FATAL] foo$`, actualMsg)
-	assert.ToBeEqual(t, uint16(6), actualSkipFrames)
+	assert.ToBeEqual(t, uint16(5), actualSkipFrames)
 	assert.ToBeEqual(t, false, actualFail)
 	assert.ToBeEqual(t, true, actualFailNow)
 }
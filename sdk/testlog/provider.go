@@ -21,9 +21,12 @@ import (
 //   - FailNowAtLevel
 //   - TimeFormat
 //   - LevelFormatter
+//   - LevelNames
 //   - Name
 //   - AllLevels
 //   - FieldKeysSpec
+//   - AllowList
+//   - AllowListLevel
 func NewProvider(tb testing.TB, customizer ...func(*Provider)) *Provider {
 	result := &Provider{tb: tb, startedNs: runtimeNano()}
 
@@ -77,6 +80,13 @@ type Provider struct {
 	failNowAtLevel level.Level
 	timeFormat     string
 	levelFormatter tlevel.Formatter
+	levelNames     level.Names
+	allowListLevel level.Level
+
+	allowListEnabled bool
+	expectedEvents   []*expectedEvent
+	unexpectedEvents []unexpectedEvent
+	allowListMutex   sync.Mutex
 
 	coreRootLogger    *coreLogger
 	rootLogger        log.Logger
@@ -87,6 +97,7 @@ type Provider struct {
 	interceptLogDepth func(string, uint16)
 	interceptFail     func()
 	interceptFailNow  func()
+	interceptReport   func(string)
 }
 
 //go:linkname runtimeNano runtime.nanotime
@@ -191,6 +202,14 @@ func (instance *Provider) getLevelFormatter() tlevel.Formatter {
 	return tlevel.DefaultFormatter
 }
 
+// GetLevelNames implements level.NamesAware#GetLevelNames()
+func (instance *Provider) GetLevelNames() level.Names {
+	if v := instance.levelNames; v != nil {
+		return v
+	}
+	return tlevel.DefaultNames
+}
+
 // Level specifies the level of the Provider which will be also inherited
 // by all of its loggers. By default, the Provider will use DefaultLevel.
 func Level(v level.Level) func(*Provider) {
@@ -239,6 +258,15 @@ func LevelFormatter(v tlevel.Formatter) func(*Provider) {
 	}
 }
 
+// LevelNames is used to describe levels in a human-readable format (for
+// example in the report of AllowList). By default, the Provider will use
+// level.DefaultNames.
+func LevelNames(v level.Names) func(*Provider) {
+	return func(provider *Provider) {
+		provider.levelNames = v
+	}
+}
+
 // Name specifies the name of the Provider. By default, the Provider will use
 // testing.TB#Name().
 func Name(v string) func(*Provider) {
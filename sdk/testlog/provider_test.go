@@ -1,6 +1,7 @@
 package testlog
 
 import (
+	"strings"
 	"testing"
 
 	log "github.com/echocat/slf4g"
@@ -111,6 +112,17 @@ func TestProvider_getLevelFormatter_specific(t *testing.T) {
 	assert.ToBeEqual(t, given, instance.getLevelFormatter())
 }
 
+func TestProvider_GetLevelNames_default(t *testing.T) {
+	instance := NewProvider(t)
+	assert.ToBeSame(t, tlevel.DefaultNames, instance.GetLevelNames())
+}
+
+func TestProvider_GetLevelNames_specific(t *testing.T) {
+	given := &lowerCaseLevelNames{}
+	instance := NewProvider(t, LevelNames(given))
+	assert.ToBeSame(t, given, instance.GetLevelNames())
+}
+
 func TestProvider_GetRootLogger(t *testing.T) {
 	instance := NewProvider(t)
 
@@ -169,3 +181,14 @@ func (instance *mockFieldKeysSpec) GetError() string {
 func (instance *mockFieldKeysSpec) GetLogger() string {
 	panic("not implemented in tests")
 }
+
+type lowerCaseLevelNames struct{}
+
+func (instance *lowerCaseLevelNames) ToName(lvl level.Level) (string, error) {
+	name, err := tlevel.DefaultNames.ToName(lvl)
+	return strings.ToLower(name), err
+}
+
+func (instance *lowerCaseLevelNames) ToLevel(name string) (level.Level, error) {
+	return tlevel.DefaultNames.ToLevel(name)
+}
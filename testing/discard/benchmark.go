@@ -0,0 +1,139 @@
+package discard
+
+import (
+	"testing"
+
+	log "github.com/echocat/slf4g"
+	"github.com/echocat/slf4g/level"
+)
+
+// Scenario is a typical usage of a log.Logger which is measured by Benchmark().
+type Scenario struct {
+	// Name of this Scenario. It will be part of the name of the benchmark.
+	Name string
+
+	// Run executes this Scenario once using the given log.Logger at the given
+	// level.Level.
+	Run func(logger log.Logger, at LevelMethods)
+}
+
+// LevelMethods provides access to the methods of a log.Logger which are
+// related to one specific level.Level.
+type LevelMethods struct {
+	// Name of the level.Level. It will be part of the name of the benchmark.
+	Name string
+
+	// Level is the level.Level itself.
+	Level level.Level
+
+	// Log is the log.Logger method like log.Logger#Info().
+	Log func(logger log.Logger, args ...interface{})
+
+	// Logf is the log.Logger method like log.Logger#Infof().
+	Logf func(logger log.Logger, format string, args ...interface{})
+}
+
+// DefaultScenarios are used by Benchmark().
+var DefaultScenarios = []Scenario{{
+	Name: "Message",
+	Run: func(logger log.Logger, at LevelMethods) {
+		at.Log(logger, "hello world")
+	},
+}, {
+	Name: "Messagef",
+	Run: func(logger log.Logger, at LevelMethods) {
+		at.Logf(logger, "hello %s", "world")
+	},
+}, {
+	Name: "Fields",
+	Run: func(logger log.Logger, at LevelMethods) {
+		at.Log(logger.With("foo", 1).With("bar", "baz"), "hello world")
+	},
+}, {
+	Name: "Builder",
+	Run: func(logger log.Logger, at LevelMethods) {
+		log.NewEventBuilder(logger, at.Level).Str("bar", "baz").Int("foo", 1).Msg("hello world")
+	},
+}}
+
+// DefaultLevelMethods are used by Benchmark(). level.Fatal is not part of
+// it, because implementations could exit the whole process at this level.
+var DefaultLevelMethods = []LevelMethods{
+	{"Trace", level.Trace, log.Logger.Trace, log.Logger.Tracef},
+	{"Debug", level.Debug, log.Logger.Debug, log.Logger.Debugf},
+	{"Info", level.Info, log.Logger.Info, log.Logger.Infof},
+	{"Warn", level.Warn, log.Logger.Warn, log.Logger.Warnf},
+	{"Error", level.Error, log.Logger.Error, log.Logger.Errorf},
+}
+
+// Benchmark runs all DefaultScenarios against the root logger and a named
+// logger of the given log.Provider for each of DefaultLevelMethods as
+// sub-benchmarks (named <level>/<logger>/<scenario>).
+//
+// Each of them reports the allocations per operation. If the given
+// log.Provider is (or wraps) a Provider of this package the amount of events
+// which reached the CoreLogger per operation is reported, too. Levels which
+// are not enabled for the given log.Provider measure the overhead of disabled
+// logging calls.
+func Benchmark(b *testing.B, provider log.Provider) {
+	b.Helper()
+
+	loggers := []struct {
+		name   string
+		logger log.Logger
+	}{
+		{"root", provider.GetRootLogger()},
+		{"named", provider.GetLogger("benchmark")},
+	}
+
+	counting := asProvider(provider)
+
+	for _, at := range DefaultLevelMethods {
+		at := at
+		b.Run(at.Name, func(b *testing.B) {
+			for _, l := range loggers {
+				l := l
+				b.Run(l.name, func(b *testing.B) {
+					for _, s := range DefaultScenarios {
+						s := s
+						b.Run(s.Name, func(b *testing.B) {
+							var before uint64
+							if counting != nil {
+								before = counting.countOf(l.logger, at.Level)
+							}
+
+							b.ReportAllocs()
+							b.ResetTimer()
+							for i := 0; i < b.N; i++ {
+								s.Run(l.logger, at)
+							}
+							b.StopTimer()
+
+							if counting != nil {
+								events := counting.countOf(l.logger, at.Level) - before
+								b.ReportMetric(float64(events)/float64(b.N), "events/op")
+							}
+						})
+					}
+				})
+			}
+		})
+	}
+}
+
+func asProvider(candidate log.Provider) *Provider {
+	for candidate != nil {
+		if v, ok := candidate.(*Provider); ok {
+			return v
+		}
+		candidate = log.UnwrapProvider(candidate)
+	}
+	return nil
+}
+
+func (instance *Provider) countOf(logger log.Logger, l level.Level) uint64 {
+	if name := logger.GetName(); name != RootLoggerName {
+		return instance.GetCountsOf(name)[l]
+	}
+	return instance.GetCountsRoot()[l]
+}
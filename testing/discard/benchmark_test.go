@@ -0,0 +1,9 @@
+package discard
+
+import (
+	"testing"
+)
+
+func BenchmarkProvider(b *testing.B) {
+	Benchmark(b, NewProvider())
+}
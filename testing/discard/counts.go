@@ -0,0 +1,22 @@
+package discard
+
+import (
+	"github.com/echocat/slf4g/level"
+)
+
+// Counts holds the amount of events per level.Level.
+type Counts map[level.Level]uint64
+
+// Total returns the sum of all counted events.
+func (instance Counts) Total() (result uint64) {
+	for _, v := range instance {
+		result += v
+	}
+	return
+}
+
+func (instance Counts) addAll(other Counts) {
+	for l, v := range other {
+		instance[l] += v
+	}
+}
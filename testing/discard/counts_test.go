@@ -0,0 +1,26 @@
+package discard
+
+import (
+	"testing"
+
+	"github.com/echocat/slf4g/level"
+
+	"github.com/echocat/slf4g/internal/test/assert"
+)
+
+func Test_Counts_Total(t *testing.T) {
+	cases := []struct {
+		given    Counts
+		expected uint64
+	}{
+		{nil, 0},
+		{Counts{}, 0},
+		{Counts{level.Info: 2, level.Warn: 3}, 5},
+	}
+
+	for _, c := range cases {
+		t.Run("", func(t *testing.T) {
+			assert.ToBeEqual(t, c.expected, c.given.Total())
+		})
+	}
+}
@@ -0,0 +1,14 @@
+// Package discard provides an implementation of log.Provider which simply
+// discards every event - but only after it passed the full path from
+// log.Logger over log.CoreLogger including the construction of the event.
+//
+// This is useful to measure the pure overhead of logging calls without the
+// costs of any formatting or I/O. Each CoreLogger counts the events it
+// received by their level.Level, which can be received using
+// Provider.GetCounts(), Provider.GetCountsRoot() or Provider.GetCountsOf().
+//
+// Benchmark() runs a common set of scenarios against any log.Provider and
+// reports the allocations per operation and (for Provider of this package) the
+// events per operation for each level.Level and logger. This allows comparing
+// different implementations with each other.
+package discard
@@ -0,0 +1,106 @@
+package discard
+
+import (
+	"sync"
+
+	log "github.com/echocat/slf4g"
+	"github.com/echocat/slf4g/fields"
+	"github.com/echocat/slf4g/internal/events"
+	"github.com/echocat/slf4g/level"
+)
+
+// RootLoggerName specifies the name of the root version of CoreLogger
+// instances which are managed by Provider.
+const RootLoggerName = "ROOT"
+
+// CoreLogger implements log.CoreLogger and discards every logged event. It
+// only counts the received events by their level.Level, which can be received
+// using GetCounts().
+type CoreLogger struct {
+	provider *Provider
+	name     string
+
+	counts Counts
+	mutex  sync.Mutex
+}
+
+// Log implements log.CoreLogger#Log(event).
+//
+// All fields of the event will be visited (including the resolution of
+// fields.Lazy values) to simulate what a real implementation would do, before
+// the event will be discarded.
+func (instance *CoreLogger) Log(event log.Event, _ uint16) {
+	if event == nil {
+		return
+	}
+	l := event.GetLevel()
+	if !instance.IsLevelEnabled(l) {
+		return
+	}
+
+	_ = event.ForEach(visitField)
+
+	instance.mutex.Lock()
+	instance.counts[l]++
+	instance.mutex.Unlock()
+}
+
+func visitField(_ string, value interface{}) error {
+	if v, ok := value.(fields.Lazy); ok {
+		_ = v.Get()
+	}
+	return nil
+}
+
+// GetCounts returns the amount of all events received by this CoreLogger per
+// level.Level.
+func (instance *CoreLogger) GetCounts() Counts {
+	instance.mutex.Lock()
+	defer instance.mutex.Unlock()
+
+	result := make(Counts, len(instance.counts))
+	result.addAll(instance.counts)
+	return result
+}
+
+// Reset sets all counts of this CoreLogger back to 0.
+func (instance *CoreLogger) Reset() {
+	instance.mutex.Lock()
+	defer instance.mutex.Unlock()
+
+	instance.counts = Counts{}
+}
+
+// IsLevelEnabled implements log.CoreLogger#IsLevelEnabled()
+func (instance *CoreLogger) IsLevelEnabled(v level.Level) bool {
+	return instance.provider.GetLevel().CompareTo(v) <= 0
+}
+
+// GetName implements log.CoreLogger#GetName()
+func (instance *CoreLogger) GetName() string {
+	return instance.name
+}
+
+// GetProvider implements log.CoreLogger#GetProvider()
+func (instance *CoreLogger) GetProvider() log.Provider {
+	return instance.provider
+}
+
+// NewEvent implements log.CoreLogger#NewEvent()
+func (instance *CoreLogger) NewEvent(l level.Level, values map[string]interface{}) log.Event {
+	return instance.NewEventWithFields(l, fields.WithAll(values))
+}
+
+// NewEventWithFields implements log.CoreLogger#NewEventWithFields()
+func (instance *CoreLogger) NewEventWithFields(l level.Level, f fields.ForEachEnabled) log.Event {
+	asFields, err := fields.AsFields(f)
+	if err != nil {
+		panic(err)
+	}
+	return events.New(instance.provider, asFields, l)
+}
+
+// Accepts implements log.CoreLogger#Accepts()
+func (instance *CoreLogger) Accepts(e log.Event) bool {
+	return e != nil
+}
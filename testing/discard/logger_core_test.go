@@ -0,0 +1,84 @@
+package discard
+
+import (
+	"testing"
+
+	log "github.com/echocat/slf4g"
+
+	"github.com/echocat/slf4g/fields"
+	"github.com/echocat/slf4g/level"
+
+	"github.com/echocat/slf4g/internal/test/assert"
+)
+
+func Test_CoreLogger_Log(t *testing.T) {
+	provider := NewProvider()
+	instance := provider.getLogger("foo")
+
+	lazyCalled := false
+	instance.Log(instance.NewEvent(level.Info, map[string]interface{}{
+		"foo": fields.LazyFunc(func() interface{} {
+			lazyCalled = true
+			return "bar"
+		}),
+	}), 0)
+	instance.Log(instance.NewEvent(level.Debug, nil), 0)
+	instance.Log(nil, 0)
+
+	assert.ToBeEqual(t, Counts{level.Info: 1}, instance.GetCounts())
+	assert.ToBeEqual(t, true, lazyCalled)
+
+	instance.Reset()
+
+	assert.ToBeEqual(t, Counts{}, instance.GetCounts())
+}
+
+func Test_CoreLogger_Log_withoutAllocations(t *testing.T) {
+	provider := NewProvider()
+	instance := provider.getLogger("foo")
+	event := instance.NewEvent(level.Info, map[string]interface{}{"foo": 1})
+	instance.Log(event, 0)
+
+	actual := testing.AllocsPerRun(100, func() {
+		instance.Log(event, 0)
+	})
+
+	assert.ToBeEqual(t, float64(0), actual)
+}
+
+func Test_CoreLogger_disabledBuilderWithoutAllocations(t *testing.T) {
+	provider := NewProvider(func(p *Provider) {
+		p.Level = level.Warn
+	})
+	logger := provider.GetLogger("foo")
+
+	actual := testing.AllocsPerRun(100, func() {
+		log.NewEventBuilder(logger, level.Info).Str("foo", "bar").Msg("hello world")
+	})
+
+	assert.ToBeEqual(t, float64(0), actual)
+	assert.ToBeEqual(t, Counts{}, provider.GetCounts())
+}
+
+func Test_CoreLogger_NewEvent(t *testing.T) {
+	provider := NewProvider()
+	instance := provider.getRootLogger()
+
+	actual := instance.NewEvent(level.Warn, map[string]interface{}{"foo": 1}).
+		With("bar", 2).
+		Withf("baz", "%d", 3).
+		WithAll(map[string]interface{}{"a": 4}).
+		Without("a")
+
+	assert.ToBeEqual(t, level.Warn, actual.GetLevel())
+	assert.ToBeEqual(t, 3, actual.Len())
+	actualFoo, _ := actual.Get("foo")
+	assert.ToBeEqual(t, 1, actualFoo)
+}
+
+func Test_CoreLogger_Accepts(t *testing.T) {
+	instance := NewProvider().getRootLogger()
+
+	assert.ToBeEqual(t, true, instance.Accepts(instance.NewEvent(level.Info, nil)))
+	assert.ToBeEqual(t, false, instance.Accepts(nil))
+}
@@ -0,0 +1,170 @@
+package discard
+
+import (
+	"sync/atomic"
+	"unsafe"
+
+	log "github.com/echocat/slf4g"
+	"github.com/echocat/slf4g/fields"
+	"github.com/echocat/slf4g/level"
+)
+
+// DefaultProviderName specifies the default name an instance of Provider which
+// will be used if no other name was defined.
+const DefaultProviderName = "discard"
+
+// DefaultLevel specifies the default level.Level of an instance of Provider
+// which be used if no other level was defined.
+const DefaultLevel = level.Info
+
+// Provider is an implementation of log.Provider which discards all events
+// which are logged with its loggers. It only counts them, see GetCounts(),
+// GetCountsRoot() or GetCountsOf().
+type Provider struct {
+	// Name specifies the name of this Provider. If empty this Provider will
+	// use DefaultProviderName.
+	Name string
+
+	// Level specifies the level of this Provider which will be also inherited
+	// by all of its loggers. If 0 this Provider will use DefaultLevel.
+	Level level.Level
+
+	// AllLevels specifies the levels which are supported by this Provider and
+	// all of its loggers. If nil this Provider will use
+	// level.GetProvider()#GetLevels().
+	AllLevels level.Levels
+
+	// FieldKeysSpec specifies the spec of the fields are supported by this
+	// Provider and all of its loggers. If nil this Provider will use the
+	// default instance of fields.KeysSpecImpl.
+	FieldKeysSpec fields.KeysSpec
+
+	cachePointer unsafe.Pointer
+}
+
+// NewProvider creates a new instance of Provider which is ready to use.
+func NewProvider(customizer ...func(*Provider)) *Provider {
+	result := &Provider{}
+
+	for _, c := range customizer {
+		c(result)
+	}
+
+	return result
+}
+
+// GetCounts returns the amount of all events received by all instances of
+// CoreLogger of this Provider per level.Level.
+func (instance *Provider) GetCounts() Counts {
+	result := instance.GetCountsRoot()
+	for _, name := range instance.getCache().GetNames() {
+		result.addAll(instance.GetCountsOf(name))
+	}
+	return result
+}
+
+// GetCountsRoot returns the amount of all events received by the root
+// CoreLogger of this Provider per level.Level.
+func (instance *Provider) GetCountsRoot() Counts {
+	return instance.getRootLogger().GetCounts()
+}
+
+// GetCountsOf returns the amount of all events received by the named
+// CoreLogger of this Provider per level.Level.
+func (instance *Provider) GetCountsOf(name string) Counts {
+	return instance.getLogger(name).GetCounts()
+}
+
+// ResetAll sets the counts of all instances of CoreLogger of this Provider
+// back to 0.
+func (instance *Provider) ResetAll() {
+	instance.getRootLogger().Reset()
+	for _, name := range instance.getCache().GetNames() {
+		instance.getLogger(name).Reset()
+	}
+}
+
+func (instance *Provider) getRootLogger() *CoreLogger {
+	return log.UnwrapCoreLogger(instance.GetRootLogger()).(*CoreLogger)
+}
+
+func (instance *Provider) getLogger(name string) *CoreLogger {
+	return log.UnwrapCoreLogger(instance.GetLogger(name)).(*CoreLogger)
+}
+
+func (instance *Provider) rootFactory() log.Logger {
+	return instance.factory(RootLoggerName)
+}
+
+func (instance *Provider) factory(name string) log.Logger {
+	return log.NewLogger(&CoreLogger{
+		provider: instance,
+		name:     name,
+		counts:   Counts{},
+	})
+}
+
+// GetRootLogger implements log.Provider#GetRootLogger()
+func (instance *Provider) GetRootLogger() log.Logger {
+	return instance.getCache().GetRootLogger()
+}
+
+// GetLogger implements log.Provider#GetLogger()
+func (instance *Provider) GetLogger(name string) log.Logger {
+	return instance.getCache().GetLogger(name)
+}
+
+// GetName implements log.Provider#GetName()
+func (instance *Provider) GetName() string {
+	if v := instance.Name; v != "" {
+		return v
+	}
+	return DefaultProviderName
+}
+
+// GetAllLevels implements log.Provider#GetAllLevels()
+func (instance *Provider) GetAllLevels() level.Levels {
+	if v := instance.AllLevels; v != nil {
+		return v
+	}
+	return level.GetProvider().GetLevels()
+}
+
+// GetFieldKeysSpec implements log.Provider#GetFieldKeysSpec()
+func (instance *Provider) GetFieldKeysSpec() fields.KeysSpec {
+	if v := instance.FieldKeysSpec; v != nil {
+		return v
+	}
+	return fieldKeysSpecV
+}
+
+// GetLevel returns the current level.Level where this log.Provider is set to.
+func (instance *Provider) GetLevel() level.Level {
+	if v := instance.Level; v != 0 {
+		return v
+	}
+	return DefaultLevel
+}
+
+// SetLevel changes the current level.Level of this log.Provider. If set to
+// 0 it will force this Provider to use DefaultLevel.
+func (instance *Provider) SetLevel(v level.Level) {
+	instance.Level = v
+}
+
+func (instance *Provider) getCache() log.LoggerCache {
+	for {
+		v := (*log.LoggerCache)(atomic.LoadPointer(&instance.cachePointer))
+		if v != nil && *v != nil {
+			return *v
+		}
+
+		c := log.NewLoggerCache(instance.rootFactory, instance.factory)
+
+		if atomic.CompareAndSwapPointer(&instance.cachePointer, unsafe.Pointer(v), unsafe.Pointer(&c)) {
+			return c
+		}
+	}
+}
+
+var fieldKeysSpecV = &fields.KeysSpecImpl{}
@@ -0,0 +1,78 @@
+package discard
+
+import (
+	"testing"
+
+	log "github.com/echocat/slf4g"
+	"github.com/echocat/slf4g/fields"
+	"github.com/echocat/slf4g/level"
+
+	"github.com/echocat/slf4g/internal/test/assert"
+)
+
+func Test_NewProvider(t *testing.T) {
+	actual := NewProvider(func(p *Provider) {
+		p.Name = "foo"
+	})
+
+	assert.ToBeEqual(t, &Provider{Name: "foo"}, actual)
+}
+
+func Test_Provider_GetCounts(t *testing.T) {
+	instance := NewProvider()
+
+	instance.GetRootLogger().Info("a")
+	instance.GetRootLogger().Debug("not enabled")
+	instance.GetLogger("foo").Info("b")
+	instance.GetLogger("foo").Warn("c")
+	instance.GetLogger("bar").With("a", 1).Error("d")
+
+	assert.ToBeEqual(t, Counts{level.Info: 2, level.Warn: 1, level.Error: 1}, instance.GetCounts())
+	assert.ToBeEqual(t, Counts{level.Info: 1}, instance.GetCountsRoot())
+	assert.ToBeEqual(t, Counts{level.Info: 1, level.Warn: 1}, instance.GetCountsOf("foo"))
+	assert.ToBeEqual(t, Counts{level.Error: 1}, instance.GetCountsOf("bar"))
+	assert.ToBeEqual(t, Counts{}, instance.GetCountsOf("unknown"))
+
+	instance.ResetAll()
+
+	assert.ToBeEqual(t, Counts{}, instance.GetCounts())
+}
+
+func Test_Provider_GetName(t *testing.T) {
+	assert.ToBeEqual(t, DefaultProviderName, NewProvider().GetName())
+	assert.ToBeEqual(t, "foo", (&Provider{Name: "foo"}).GetName())
+}
+
+func Test_Provider_GetLevel(t *testing.T) {
+	instance := NewProvider()
+	assert.ToBeEqual(t, DefaultLevel, instance.GetLevel())
+
+	instance.SetLevel(level.Warn)
+	assert.ToBeEqual(t, level.Warn, instance.GetLevel())
+}
+
+func Test_Provider_GetAllLevels(t *testing.T) {
+	assert.ToBeEqual(t, level.GetProvider().GetLevels(), NewProvider().GetAllLevels())
+
+	given := level.Levels{level.Info}
+	assert.ToBeEqual(t, given, (&Provider{AllLevels: given}).GetAllLevels())
+}
+
+func Test_Provider_GetFieldKeysSpec(t *testing.T) {
+	assert.ToBeSame(t, fieldKeysSpecV, NewProvider().GetFieldKeysSpec())
+
+	given := &fields.KeysSpecImpl{}
+	assert.ToBeSame(t, given, (&Provider{FieldKeysSpec: given}).GetFieldKeysSpec())
+}
+
+func Test_Provider_GetLogger(t *testing.T) {
+	instance := NewProvider()
+
+	actualRoot := instance.GetRootLogger()
+	actualFoo := instance.GetLogger("foo")
+
+	assert.ToBeEqual(t, RootLoggerName, actualRoot.GetName())
+	assert.ToBeEqual(t, "foo", actualFoo.GetName())
+	assert.ToBeSame(t, actualFoo, instance.GetLogger("foo"))
+	assert.ToBeSame(t, log.Provider(instance), actualFoo.GetProvider())
+}
@@ -6,4 +6,18 @@
 // ... or hook it fully into the full application by calling NewProvider() and
 // using then afterwards Provider.HookGlobally() to make it available for every
 // piece of code that tries to log something. See the example for more details.
+//
+// # Expectations
+//
+// Recorded events can be asserted using Provider.Expect() or
+// CoreLogger.Expect() together with matchers like HasLevel(),
+// HasMessageMatching(), HasFieldValue() or HasErrorIs():
+//
+//	provider.Expect(t, HasLogger("foo"), HasLevelAtLeast(level.Warn)).
+//		NeverToBeLogged()
+//	provider.Expect(t, HasMessage("started")).
+//		ToBeLoggedBefore(HasMessage("stopped"))
+//
+// If an expectation fails, the closest recorded event is reported together
+// with the reason why it does not match.
 package recording
@@ -0,0 +1,236 @@
+package recording
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	log "github.com/echocat/slf4g"
+)
+
+// Expect creates a new Expectation for all events recorded by all instances of
+// CoreLogger of this Provider which matches all the given matchers. If no
+// matcher is provided every recorded event matches. Example:
+//
+//	provider.Expect(t, HasLevel(level.Warn), HasMessageMatching("^foo")).
+//		ToBeLoggedTimes(2)
+func (instance *Provider) Expect(t testing.TB, matchers ...Matcher) *Expectation {
+	return &Expectation{
+		t:        t,
+		provider: instance,
+		source:   instance.getAllSequenced,
+		matchers: matchers,
+	}
+}
+
+// Expect creates a new Expectation for all events recorded by this CoreLogger
+// which matches all the given matchers. See Provider.Expect() for more
+// details.
+func (instance *CoreLogger) Expect(t testing.TB, matchers ...Matcher) *Expectation {
+	return &Expectation{
+		t:        t,
+		provider: instance.GetProvider(),
+		source:   instance.getAllSequenced,
+		matchers: matchers,
+	}
+}
+
+// Expectation asserts how often and in which order events, matching the given
+// matchers, were recorded. Each of its methods reports a failure using
+// testing.TB.Errorf() and returns false if the expectation is not fulfilled.
+//
+// In case of failures, it reports the closest recorded event (the one which
+// fulfills most of the matchers) together with the description why it does not
+// match.
+type Expectation struct {
+	t        testing.TB
+	provider log.Provider
+	source   func() []sequencedEvent
+	matchers []Matcher
+}
+
+// Get returns all recorded events which matches this Expectation.
+func (instance *Expectation) Get() []log.Event {
+	matching := instance.matching()
+	result := make([]log.Event, len(matching))
+	for i, v := range matching {
+		result[i] = v.event
+	}
+	return result
+}
+
+// ToBeLogged asserts that at least one event matching this Expectation was
+// recorded.
+func (instance *Expectation) ToBeLogged() bool {
+	instance.t.Helper()
+	return instance.ToBeLoggedAtLeast(1)
+}
+
+// NeverToBeLogged asserts that no event matching this Expectation was
+// recorded.
+func (instance *Expectation) NeverToBeLogged() bool {
+	instance.t.Helper()
+	matching := instance.matching()
+	if len(matching) == 0 {
+		return true
+	}
+	instance.t.Errorf("Expected event matching <%s> never to be logged; but it was logged %d time(s):%s",
+		instance, len(matching), instance.describeEvents(matching))
+	return false
+}
+
+// ToBeLoggedTimes asserts that exactly the given amount of events matching this
+// Expectation were recorded.
+func (instance *Expectation) ToBeLoggedTimes(n int) bool {
+	instance.t.Helper()
+	return instance.toBeLoggedCount(n, n, fmt.Sprintf("exactly %d time(s)", n))
+}
+
+// ToBeLoggedAtLeast asserts that at least the given amount of events matching
+// this Expectation were recorded.
+func (instance *Expectation) ToBeLoggedAtLeast(n int) bool {
+	instance.t.Helper()
+	return instance.toBeLoggedCount(n, -1, fmt.Sprintf("at least %d time(s)", n))
+}
+
+// ToBeLoggedAtMost asserts that at most the given amount of events matching
+// this Expectation were recorded.
+func (instance *Expectation) ToBeLoggedAtMost(n int) bool {
+	instance.t.Helper()
+	return instance.toBeLoggedCount(0, n, fmt.Sprintf("at most %d time(s)", n))
+}
+
+// ToBeLoggedBefore asserts that the first event matching this Expectation was
+// recorded before the first event matching all the given matchers. Both
+// events have to be recorded.
+func (instance *Expectation) ToBeLoggedBefore(matchers ...Matcher) bool {
+	instance.t.Helper()
+	other := instance.other(matchers)
+	if !instance.ToBeLogged() || !other.ToBeLogged() {
+		return false
+	}
+	this, that := instance.matching()[0], other.matching()[0]
+	if this.sequence < that.sequence {
+		return true
+	}
+	instance.t.Errorf("Expected event matching <%s> to be logged before event matching <%s>; but it was logged after it:\n\t%v\n\t%v",
+		instance, other, that.event, this.event)
+	return false
+}
+
+// ToBeLoggedAfter asserts that the first event matching this Expectation was
+// recorded after the first event matching all the given matchers. Both events
+// have to be recorded.
+func (instance *Expectation) ToBeLoggedAfter(matchers ...Matcher) bool {
+	instance.t.Helper()
+	other := instance.other(matchers)
+	if !instance.ToBeLogged() || !other.ToBeLogged() {
+		return false
+	}
+	this, that := instance.matching()[0], other.matching()[0]
+	if this.sequence > that.sequence {
+		return true
+	}
+	instance.t.Errorf("Expected event matching <%s> to be logged after event matching <%s>; but it was logged before it:\n\t%v\n\t%v",
+		instance, other, this.event, that.event)
+	return false
+}
+
+// String returns a human-readable description of all matchers of this
+// Expectation.
+func (instance *Expectation) String() string {
+	return describeMatchers(instance.matchers, " and ")
+}
+
+func (instance *Expectation) other(matchers []Matcher) *Expectation {
+	return &Expectation{
+		t:        instance.t,
+		provider: instance.provider,
+		source:   instance.source,
+		matchers: matchers,
+	}
+}
+
+func (instance *Expectation) toBeLoggedCount(min, max int, expectation string) bool {
+	instance.t.Helper()
+	matching := instance.matching()
+	n := len(matching)
+	if n >= min && (max < 0 || n <= max) {
+		return true
+	}
+	if n == 0 {
+		instance.t.Errorf("Expected event matching <%s> to be logged %s; but it was never logged.%s",
+			instance, expectation, instance.describeClosest())
+		return false
+	}
+	instance.t.Errorf("Expected event matching <%s> to be logged %s; but it was logged %d time(s):%s",
+		instance, expectation, n, instance.describeEvents(matching))
+	return false
+}
+
+func (instance *Expectation) matches(event log.Event) bool {
+	for _, m := range instance.matchers {
+		if !m.Matches(event, instance.provider) {
+			return false
+		}
+	}
+	return true
+}
+
+func (instance *Expectation) matching() []sequencedEvent {
+	var result []sequencedEvent
+	for _, candidate := range instance.source() {
+		if instance.matches(candidate.event) {
+			result = append(result, candidate)
+		}
+	}
+	return result
+}
+
+func (instance *Expectation) describeEvents(events []sequencedEvent) string {
+	var buf strings.Builder
+	for _, v := range events {
+		buf.WriteString("\n\t")
+		buf.WriteString(fmt.Sprintf("%v", v.event))
+	}
+	return buf.String()
+}
+
+func (instance *Expectation) describeClosest() string {
+	all := instance.source()
+	if len(all) == 0 {
+		return " No events were recorded at all."
+	}
+
+	var closest log.Event
+	closestScore := -1
+	for _, candidate := range all {
+		score := 0
+		for _, m := range instance.matchers {
+			if m.Matches(candidate.event, instance.provider) {
+				score++
+			}
+		}
+		if score > closestScore {
+			closest, closestScore = candidate.event, score
+		}
+	}
+
+	var buf strings.Builder
+	buf.WriteString(fmt.Sprintf("\nClosest recorded event (%d of %d criteria matched):\n\t%v\nMismatches:",
+		closestScore, len(instance.matchers), closest))
+	for _, m := range instance.matchers {
+		if !m.Matches(closest, instance.provider) {
+			for _, line := range strings.Split(m.DescribeMismatch(closest, instance.provider), "\n") {
+				buf.WriteString("\n\t")
+				buf.WriteString(line)
+			}
+		}
+	}
+	return buf.String()
+}
+
+type sequencedEvent struct {
+	event    log.Event
+	sequence uint64
+}
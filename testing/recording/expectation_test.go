@@ -0,0 +1,173 @@
+package recording
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/echocat/slf4g/level"
+
+	"github.com/echocat/slf4g/internal/test/assert"
+)
+
+func Test_Expectation_ToBeLogged(t *testing.T) {
+	provider := NewProvider()
+	provider.GetRootLogger().Info("foo")
+	provider.GetLogger("bar").With("a", 1).Warn("bar")
+
+	cases := []struct {
+		name     string
+		matchers []Matcher
+		expected string
+	}{{
+		name:     "matchesRoot",
+		matchers: []Matcher{HasMessage("foo")},
+	}, {
+		name:     "matchesNamed",
+		matchers: []Matcher{HasLogger("bar"), HasLevel(level.Warn), HasFieldValue("a", 1)},
+	}, {
+		name:     "matchesWithoutMatchers",
+		matchers: nil,
+	}, {
+		name:     "doesNotMatch",
+		matchers: []Matcher{HasLogger("bar"), HasMessage("foo"), HasFieldValue("a", 2)},
+		expected: "Expected event matching <logger=\"bar\" and message=\"foo\" and a=2> to be logged at least 1 time(s); but it was never logged.\n" +
+			"Closest recorded event (1 of 3 criteria matched):\n" +
+			"\t[3000] {message=foo}\n" +
+			"Mismatches:\n" +
+			"\tlogger: expected \"bar\"; but got \"ROOT\"\n" +
+			"\ta: expected 2; but it is absent",
+	}, {
+		name:     "doesNotMatchWithClosest",
+		matchers: []Matcher{HasLogger("bar"), HasLevel(level.Warn), HasFieldValue("a", 2)},
+		expected: "Expected event matching <logger=\"bar\" and level=WARN and a=2> to be logged at least 1 time(s); but it was never logged.\n" +
+			"Closest recorded event (2 of 3 criteria matched):\n" +
+			"\t[4000] {message=bar, a=1}\n" +
+			"Mismatches:\n" +
+			"\ta: expected 2; but got 1",
+	}}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			tb := &recordingTB{TB: t}
+
+			actual := provider.Expect(tb, c.matchers...).ToBeLogged()
+
+			assert.ToBeEqual(t, c.expected == "", actual)
+			assert.ToBeEqual(t, c.expected, tb.String())
+		})
+	}
+}
+
+func Test_Expectation_ToBeLogged_nothingRecorded(t *testing.T) {
+	provider := NewProvider()
+	tb := &recordingTB{TB: t}
+
+	actual := provider.Expect(tb, HasMessage("foo")).ToBeLogged()
+
+	assert.ToBeEqual(t, false, actual)
+	assert.ToBeEqual(t, "Expected event matching <message=\"foo\"> to be logged at least 1 time(s); but it was never logged. No events were recorded at all.", tb.String())
+}
+
+func Test_Expectation_counts(t *testing.T) {
+	logger := NewLogger()
+	logger.Info("foo")
+	logger.Info("foo")
+	logger.Info("bar")
+
+	cases := []struct {
+		name     string
+		assert   func(*Expectation) bool
+		expected string
+	}{
+		{"timesMatches", func(e *Expectation) bool { return e.ToBeLoggedTimes(2) }, ""},
+		{"timesDoesNotMatch", func(e *Expectation) bool { return e.ToBeLoggedTimes(1) }, "Expected event matching <message=\"foo\"> to be logged exactly 1 time(s); but it was logged 2 time(s):\n\t[3000] {message=foo}\n\t[3000] {message=foo}"},
+		{"atLeastMatches", func(e *Expectation) bool { return e.ToBeLoggedAtLeast(2) }, ""},
+		{"atLeastDoesNotMatch", func(e *Expectation) bool { return e.ToBeLoggedAtLeast(3) }, "Expected event matching <message=\"foo\"> to be logged at least 3 time(s); but it was logged 2 time(s):\n\t[3000] {message=foo}\n\t[3000] {message=foo}"},
+		{"atMostMatches", func(e *Expectation) bool { return e.ToBeLoggedAtMost(2) }, ""},
+		{"atMostDoesNotMatch", func(e *Expectation) bool { return e.ToBeLoggedAtMost(1) }, "Expected event matching <message=\"foo\"> to be logged at most 1 time(s); but it was logged 2 time(s):\n\t[3000] {message=foo}\n\t[3000] {message=foo}"},
+		{"neverDoesNotMatch", func(e *Expectation) bool { return e.NeverToBeLogged() }, "Expected event matching <message=\"foo\"> never to be logged; but it was logged 2 time(s):\n\t[3000] {message=foo}\n\t[3000] {message=foo}"},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			tb := &recordingTB{TB: t}
+
+			actual := c.assert(logger.Expect(tb, HasMessage("foo")))
+
+			assert.ToBeEqual(t, c.expected == "", actual)
+			assert.ToBeEqual(t, c.expected, tb.String())
+		})
+	}
+}
+
+func Test_Expectation_NeverToBeLogged(t *testing.T) {
+	logger := NewLogger()
+	logger.Info("foo")
+	tb := &recordingTB{TB: t}
+
+	actual := logger.Expect(tb, HasLevelAtLeast(level.Warn)).NeverToBeLogged()
+
+	assert.ToBeEqual(t, true, actual)
+	assert.ToBeEqual(t, "", tb.String())
+}
+
+func Test_Expectation_ordering(t *testing.T) {
+	provider := NewProvider()
+	provider.GetLogger("a").Info("first")
+	provider.GetRootLogger().Info("second")
+	provider.GetLogger("b").Info("third")
+
+	cases := []struct {
+		name     string
+		assert   func(*Expectation) bool
+		expected string
+	}{
+		{"beforeMatches", func(e *Expectation) bool { return e.ToBeLoggedBefore(HasMessage("third")) }, ""},
+		{"beforeDoesNotMatch", func(e *Expectation) bool { return e.ToBeLoggedBefore(HasMessage("first")) }, "Expected event matching <message=\"second\"> to be logged before event matching <message=\"first\">; but it was logged after it:\n\t[3000] {message=first}\n\t[3000] {message=second}"},
+		{"beforeOtherMissing", func(e *Expectation) bool { return e.ToBeLoggedBefore(HasMessage("fourth")) }, "Expected event matching <message=\"fourth\"> to be logged at least 1 time(s); but it was never logged.\nClosest recorded event (0 of 1 criteria matched):\n\t[3000] {message=first}\nMismatches:\n\tmessage: expected \"fourth\"; but got \"first\""},
+		{"afterMatches", func(e *Expectation) bool { return e.ToBeLoggedAfter(HasMessage("first")) }, ""},
+		{"afterDoesNotMatch", func(e *Expectation) bool { return e.ToBeLoggedAfter(HasMessage("third")) }, "Expected event matching <message=\"second\"> to be logged after event matching <message=\"third\">; but it was logged before it:\n\t[3000] {message=second}\n\t[3000] {message=third}"},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			tb := &recordingTB{TB: t}
+
+			actual := c.assert(provider.Expect(tb, HasMessage("second")))
+
+			assert.ToBeEqual(t, c.expected == "", actual)
+			assert.ToBeEqual(t, c.expected, tb.String())
+		})
+	}
+}
+
+func Test_Expectation_Get(t *testing.T) {
+	provider := NewProvider()
+	provider.GetLogger("a").Info("foo")
+	provider.GetRootLogger().Warn("bar")
+	provider.GetLogger("b").Info("foo")
+
+	actual := provider.Expect(t, HasLevel(level.Info)).Get()
+
+	assert.ToBeEqual(t, 2, len(actual))
+	assert.ToBeEqual(t, true, HasLogger("a").Matches(actual[0], provider))
+	assert.ToBeEqual(t, true, HasLogger("b").Matches(actual[1], provider))
+}
+
+type recordingTB struct {
+	testing.TB
+	errors []string
+}
+
+func (instance *recordingTB) Helper() {}
+
+func (instance *recordingTB) Errorf(format string, args ...interface{}) {
+	instance.errors = append(instance.errors, fmt.Sprintf(format, args...))
+}
+
+func (instance *recordingTB) String() string {
+	if len(instance.errors) == 0 {
+		return ""
+	}
+	return instance.errors[0]
+}
@@ -3,6 +3,7 @@ package recording
 import (
 	"fmt"
 	"sync"
+	"sync/atomic"
 	"time"
 
 	"github.com/echocat/slf4g/fields"
@@ -42,10 +43,16 @@ type CoreLogger struct {
 	// to receive the level of its Provider it will use DefaultLevel instead.
 	Level level.Level
 
-	recorded []log.Event
-	mutex    sync.RWMutex
+	recorded  []log.Event
+	sequences []uint64
+	mutex     sync.RWMutex
 }
 
+// sequence is increased for every recorded event across all instances of
+// CoreLogger. It is used to restore the order in which the events where
+// recorded by different instances.
+var sequence uint64
+
 // NewCoreLogger creates a new instance of CoreLogger which is ready to use.
 func NewCoreLogger() *CoreLogger {
 	return &CoreLogger{}
@@ -134,6 +141,7 @@ func (instance *CoreLogger) Reset() {
 	defer instance.mutex.Unlock()
 
 	instance.recorded = []log.Event{}
+	instance.sequences = nil
 }
 
 // Log implements log.CoreLogger#Log(event).
@@ -154,6 +162,7 @@ func (instance *CoreLogger) Log(event log.Event, _ uint16) {
 	}
 
 	instance.recorded = append(instance.recorded, event)
+	instance.sequences = append(instance.sequences, atomic.AddUint64(&sequence, 1))
 }
 
 // GetLevel returns the current level.Level where this log.CoreLogger is set to.
@@ -210,6 +219,18 @@ func (instance *CoreLogger) NewEventWithFields(l level.Level, f fields.ForEachEn
 	}
 }
 
+func (instance *CoreLogger) getAllSequenced() []sequencedEvent {
+	instance.mutex.RLock()
+	defer instance.mutex.RUnlock()
+
+	result := make([]sequencedEvent, len(instance.recorded))
+	for i, e := range instance.recorded {
+		result[i] = sequencedEvent{e, instance.sequences[i]}
+	}
+
+	return result
+}
+
 func (instance *CoreLogger) Accepts(e log.Event) bool {
 	return e != nil
 }
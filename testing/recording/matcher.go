@@ -0,0 +1,318 @@
+package recording
+
+import (
+	"errors"
+	"fmt"
+	"reflect"
+	"regexp"
+	"strings"
+
+	log "github.com/echocat/slf4g"
+	"github.com/echocat/slf4g/fields"
+	"github.com/echocat/slf4g/level"
+)
+
+// Matcher checks if a recorded log.Event fulfills a criteria. It is usually
+// used together with Provider.Expect() or CoreLogger.Expect().
+type Matcher interface {
+	// Matches returns true if the given log.Event fulfills the criteria of
+	// this Matcher. The given log.Provider should be used to resolve the
+	// fields.KeysSpec.
+	Matches(event log.Event, using log.Provider) bool
+
+	// DescribeMismatch returns a human-readable description why the given
+	// log.Event does not fulfill the criteria of this Matcher.
+	DescribeMismatch(event log.Event, using log.Provider) string
+
+	// String returns a human-readable description of the criteria of this
+	// Matcher.
+	String() string
+}
+
+// HasLogger creates a Matcher which matches every log.Event which was logged
+// by a logger with the given name.
+func HasLogger(name string) Matcher {
+	return &matcher{
+		description: fmt.Sprintf("logger=%q", name),
+		matches: func(event log.Event, using log.Provider) bool {
+			v := log.GetLoggerOf(event, using)
+			return v != nil && *v == name
+		},
+		mismatch: func(event log.Event, using log.Provider) string {
+			return fmt.Sprintf("logger: expected %q; but got %s", name, describeStringPointer(log.GetLoggerOf(event, using)))
+		},
+	}
+}
+
+// HasLevel creates a Matcher which matches every log.Event with exactly the
+// given level.Level.
+func HasLevel(l level.Level) Matcher {
+	return HasLevelBetween(l, l)
+}
+
+// HasLevelBetween creates a Matcher which matches every log.Event with a
+// level.Level between (inclusive) the given minimum and maximum. The levels
+// are described using DefaultLevelNames or the level.Names of the used
+// log.Provider (see level.NamesAware).
+func HasLevelBetween(min, max level.Level) Matcher {
+	describe := func(names level.Names) string {
+		if min == max {
+			return describeLevel(names, min)
+		}
+		return fmt.Sprintf("[%s..%s]", describeLevel(names, min), describeLevel(names, max))
+	}
+	return &matcher{
+		description: "level=" + describe(DefaultLevelNames),
+		matches: func(event log.Event, _ log.Provider) bool {
+			v := event.GetLevel()
+			return v.CompareTo(min) >= 0 && v.CompareTo(max) <= 0
+		},
+		mismatch: func(event log.Event, using log.Provider) string {
+			names := levelNamesOf(using)
+			return fmt.Sprintf("level: expected %s; but got %s", describe(names), describeLevel(names, event.GetLevel()))
+		},
+	}
+}
+
+// HasLevelAtLeast creates a Matcher which matches every log.Event with a
+// level.Level which is equal or greater than the given one.
+func HasLevelAtLeast(min level.Level) Matcher {
+	return HasLevelBetween(min, level.Level(^uint16(0)))
+}
+
+// HasMessage creates a Matcher which matches every log.Event which has exactly
+// the given message.
+func HasMessage(message string) Matcher {
+	return &matcher{
+		description: fmt.Sprintf("message=%q", message),
+		matches: func(event log.Event, using log.Provider) bool {
+			v := log.GetMessageOf(event, using)
+			return v != nil && *v == message
+		},
+		mismatch: func(event log.Event, using log.Provider) string {
+			return fmt.Sprintf("message: expected %q; but got %s", message, describeStringPointer(log.GetMessageOf(event, using)))
+		},
+	}
+}
+
+// HasMessageMatching creates a Matcher which matches every log.Event which has
+// a message that matches the given regular expression. It panics if the
+// given pattern is not a valid regular expression.
+func HasMessageMatching(pattern string) Matcher {
+	r := regexp.MustCompile(pattern)
+	return &matcher{
+		description: fmt.Sprintf("message=~/%s/", pattern),
+		matches: func(event log.Event, using log.Provider) bool {
+			v := log.GetMessageOf(event, using)
+			return v != nil && r.MatchString(*v)
+		},
+		mismatch: func(event log.Event, using log.Provider) string {
+			return fmt.Sprintf("message: expected to match /%s/; but got %s", pattern, describeStringPointer(log.GetMessageOf(event, using)))
+		},
+	}
+}
+
+// HasField creates a Matcher which matches every log.Event which contains a
+// field with the given key - regardless of its value.
+func HasField(key string) Matcher {
+	return &matcher{
+		description: fmt.Sprintf("%s=<present>", key),
+		matches: func(event log.Event, _ log.Provider) bool {
+			_, exists := event.Get(key)
+			return exists
+		},
+		mismatch: func(event log.Event, _ log.Provider) string {
+			return fmt.Sprintf("%s: expected to be present; but it is absent", key)
+		},
+	}
+}
+
+// HasFieldValue creates a Matcher which matches every log.Event which contains
+// a field with the given key and the given value. The values are compared
+// using fields.DefaultValueEquality.
+func HasFieldValue(key string, value interface{}) Matcher {
+	return &matcher{
+		description: fmt.Sprintf("%s=%+v", key, value),
+		matches: func(event log.Event, _ log.Provider) bool {
+			actual, exists := event.Get(key)
+			if !exists {
+				return false
+			}
+			equal, err := fields.DefaultValueEquality.AreValuesEqual(key, value, actual)
+			return err == nil && equal
+		},
+		mismatch: func(event log.Event, _ log.Provider) string {
+			actual, exists := event.Get(key)
+			if !exists {
+				return fmt.Sprintf("%s: expected %+v; but it is absent", key, value)
+			}
+			if lv, ok := actual.(fields.Lazy); ok {
+				actual = lv.Get()
+			}
+			return fmt.Sprintf("%s: expected %+v; but got %+v", key, value, actual)
+		},
+	}
+}
+
+// HasErrorIs creates a Matcher which matches every log.Event which contains
+// an error which fulfills errors.Is() with the given target.
+func HasErrorIs(target error) Matcher {
+	return &matcher{
+		description: fmt.Sprintf("error is <%v>", target),
+		matches: func(event log.Event, using log.Provider) bool {
+			return errors.Is(log.GetErrorOf(event, using), target)
+		},
+		mismatch: func(event log.Event, using log.Provider) string {
+			return fmt.Sprintf("error: expected to be <%v>; but got %s", target, describeError(log.GetErrorOf(event, using)))
+		},
+	}
+}
+
+// HasErrorAs creates a Matcher which matches every log.Event which contains
+// an error which fulfills errors.As() with the given target. The target has to
+// be a non-nil pointer to either a type that implements error, or to any
+// interface type - like for errors.As() itself; otherwise this function
+// panics.
+func HasErrorAs(target interface{}) Matcher {
+	tt := reflect.TypeOf(target)
+	if tt == nil || tt.Kind() != reflect.Ptr {
+		panic("target must be a non-nil pointer")
+	}
+	return &matcher{
+		description: fmt.Sprintf("error as <%v>", tt.Elem()),
+		matches: func(event log.Event, using log.Provider) bool {
+			err := log.GetErrorOf(event, using)
+			if err == nil {
+				return false
+			}
+			// Use a fresh instance of the target for every check to not
+			// leak the result of one event to another.
+			return errors.As(err, reflect.New(tt.Elem()).Interface())
+		},
+		mismatch: func(event log.Event, using log.Provider) string {
+			return fmt.Sprintf("error: expected to be assignable to <%v>; but got %s", tt.Elem(), describeError(log.GetErrorOf(event, using)))
+		},
+	}
+}
+
+// AllOf creates a Matcher which matches every log.Event that matches all the
+// given matchers.
+func AllOf(matchers ...Matcher) Matcher {
+	return &matcher{
+		description: describeMatchers(matchers, " and "),
+		matches: func(event log.Event, using log.Provider) bool {
+			for _, m := range matchers {
+				if !m.Matches(event, using) {
+					return false
+				}
+			}
+			return true
+		},
+		mismatch: func(event log.Event, using log.Provider) string {
+			var mismatches []string
+			for _, m := range matchers {
+				if !m.Matches(event, using) {
+					mismatches = append(mismatches, m.DescribeMismatch(event, using))
+				}
+			}
+			return strings.Join(mismatches, "\n")
+		},
+	}
+}
+
+// AnyOf creates a Matcher which matches every log.Event that matches at least
+// one of the given matchers.
+func AnyOf(matchers ...Matcher) Matcher {
+	return &matcher{
+		description: "(" + describeMatchers(matchers, " or ") + ")",
+		matches: func(event log.Event, using log.Provider) bool {
+			for _, m := range matchers {
+				if m.Matches(event, using) {
+					return true
+				}
+			}
+			return false
+		},
+		mismatch: func(event log.Event, using log.Provider) string {
+			mismatches := make([]string, len(matchers))
+			for i, m := range matchers {
+				mismatches[i] = m.DescribeMismatch(event, using)
+			}
+			return strings.Join(mismatches, "\n")
+		},
+	}
+}
+
+// Not creates a Matcher which matches every log.Event that does not match the
+// given one.
+func Not(m Matcher) Matcher {
+	description := "not(" + m.String() + ")"
+	return &matcher{
+		description: description,
+		matches: func(event log.Event, using log.Provider) bool {
+			return !m.Matches(event, using)
+		},
+		mismatch: func(event log.Event, _ log.Provider) string {
+			return fmt.Sprintf("expected %s; but it matches", description)
+		},
+	}
+}
+
+type matcher struct {
+	description string
+	matches     func(log.Event, log.Provider) bool
+	mismatch    func(log.Event, log.Provider) string
+}
+
+func (instance *matcher) Matches(event log.Event, using log.Provider) bool {
+	return instance.matches(event, using)
+}
+
+func (instance *matcher) DescribeMismatch(event log.Event, using log.Provider) string {
+	return instance.mismatch(event, using)
+}
+
+func (instance *matcher) String() string {
+	return instance.description
+}
+
+func describeMatchers(matchers []Matcher, separator string) string {
+	if len(matchers) == 0 {
+		return "<any>"
+	}
+	descriptions := make([]string, len(matchers))
+	for i, m := range matchers {
+		descriptions[i] = m.String()
+	}
+	return strings.Join(descriptions, separator)
+}
+
+func describeLevel(names level.Names, l level.Level) string {
+	if v, err := names.ToName(l); err == nil {
+		return v
+	}
+	return fmt.Sprintf("%d", l)
+}
+
+func levelNamesOf(using log.Provider) level.Names {
+	if na, ok := using.(level.NamesAware); ok {
+		if v := na.GetLevelNames(); v != nil {
+			return v
+		}
+	}
+	return DefaultLevelNames
+}
+
+func describeStringPointer(v *string) string {
+	if v == nil {
+		return "<absent>"
+	}
+	return fmt.Sprintf("%q", *v)
+}
+
+func describeError(v error) string {
+	if v == nil {
+		return "<absent>"
+	}
+	return fmt.Sprintf("<%v> (%T)", v, v)
+}
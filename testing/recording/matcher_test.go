@@ -0,0 +1,140 @@
+package recording
+
+import (
+	"errors"
+	"fmt"
+	"io/fs"
+	"os"
+	"testing"
+
+	"github.com/echocat/slf4g/fields"
+	"github.com/echocat/slf4g/level"
+
+	"github.com/echocat/slf4g/internal/test/assert"
+)
+
+func Test_Matchers(t *testing.T) {
+	provider := NewProvider()
+	givenErr := fmt.Errorf("wrapped: %w", os.ErrNotExist)
+	givenEvent := provider.GetRootLogger().NewEvent(level.Warn, map[string]interface{}{
+		"message": "hello world",
+		"logger":  "foo",
+		"error":   givenErr,
+		"a":       1,
+		"b":       fields.LazyFormat("%d", 2),
+	})
+	var pathError *fs.PathError
+	var anError interface{ Error() string }
+
+	cases := []struct {
+		matcher          Matcher
+		expected         bool
+		expectedString   string
+		expectedMismatch string
+	}{
+		{HasLogger("foo"), true, `logger="foo"`, ""},
+		{HasLogger("bar"), false, `logger="bar"`, `logger: expected "bar"; but got "foo"`},
+		{HasLevel(level.Warn), true, `level=WARN`, ""},
+		{HasLevel(level.Info), false, `level=INFO`, `level: expected INFO; but got WARN`},
+		{HasLevelBetween(level.Info, level.Error), true, `level=[INFO..ERROR]`, ""},
+		{HasLevelBetween(level.Error, level.Fatal), false, `level=[ERROR..FATAL]`, `level: expected [ERROR..FATAL]; but got WARN`},
+		{HasLevelAtLeast(level.Warn), true, `level=[WARN..65535]`, ""},
+		{HasMessage("hello world"), true, `message="hello world"`, ""},
+		{HasMessage("hello"), false, `message="hello"`, `message: expected "hello"; but got "hello world"`},
+		{HasMessageMatching("^hello"), true, `message=~/^hello/`, ""},
+		{HasMessageMatching("^world"), false, `message=~/^world/`, `message: expected to match /^world/; but got "hello world"`},
+		{HasField("a"), true, `a=<present>`, ""},
+		{HasField("c"), false, `c=<present>`, `c: expected to be present; but it is absent`},
+		{HasFieldValue("a", 1), true, `a=1`, ""},
+		{HasFieldValue("b", "2"), true, `b=2`, ""},
+		{HasFieldValue("a", 2), false, `a=2`, `a: expected 2; but got 1`},
+		{HasFieldValue("c", 2), false, `c=2`, `c: expected 2; but it is absent`},
+		{HasErrorIs(os.ErrNotExist), true, `error is <file does not exist>`, ""},
+		{HasErrorIs(os.ErrExist), false, `error is <file already exists>`, `error: expected to be <file already exists>; but got <wrapped: file does not exist> (*fmt.wrapError)`},
+		{HasErrorAs(&anError), true, `error as <interface { Error() string }>`, ""},
+		{HasErrorAs(&pathError), false, `error as <*fs.PathError>`, `error: expected to be assignable to <*fs.PathError>; but got <wrapped: file does not exist> (*fmt.wrapError)`},
+		{AllOf(HasLevel(level.Warn), HasField("a")), true, `level=WARN and a=<present>`, ""},
+		{AllOf(HasLevel(level.Info), HasField("a"), HasField("c")), false, `level=INFO and a=<present> and c=<present>`, "level: expected INFO; but got WARN\nc: expected to be present; but it is absent"},
+		{AnyOf(HasLevel(level.Info), HasField("a")), true, `(level=INFO or a=<present>)`, ""},
+		{AnyOf(HasLevel(level.Info), HasField("c")), false, `(level=INFO or c=<present>)`, "level: expected INFO; but got WARN\nc: expected to be present; but it is absent"},
+		{Not(HasField("c")), true, `not(c=<present>)`, ""},
+		{Not(HasField("a")), false, `not(a=<present>)`, `expected not(a=<present>); but it matches`},
+	}
+
+	for _, c := range cases {
+		t.Run(c.matcher.String(), func(t *testing.T) {
+			assert.ToBeEqual(t, c.expected, c.matcher.Matches(givenEvent, provider))
+			assert.ToBeEqual(t, c.expectedString, c.matcher.String())
+			if !c.expected {
+				assert.ToBeEqual(t, c.expectedMismatch, c.matcher.DescribeMismatch(givenEvent, provider))
+			}
+		})
+	}
+}
+
+func Test_Matchers_absentValues(t *testing.T) {
+	provider := NewProvider()
+	givenEvent := provider.GetRootLogger().NewEvent(level.Info, nil)
+
+	cases := []struct {
+		matcher          Matcher
+		expectedMismatch string
+	}{
+		{HasLogger("foo"), `logger: expected "foo"; but got <absent>`},
+		{HasMessage("foo"), `message: expected "foo"; but got <absent>`},
+		{HasMessageMatching("foo"), `message: expected to match /foo/; but got <absent>`},
+		{HasErrorIs(errors.New("foo")), `error: expected to be <foo>; but got <absent>`},
+		{HasErrorAs(new(error)), `error: expected to be assignable to <error>; but got <absent>`},
+	}
+
+	for _, c := range cases {
+		t.Run(c.matcher.String(), func(t *testing.T) {
+			assert.ToBeEqual(t, false, c.matcher.Matches(givenEvent, provider))
+			assert.ToBeEqual(t, c.expectedMismatch, c.matcher.DescribeMismatch(givenEvent, provider))
+		})
+	}
+}
+
+func Test_HasErrorAs_panicsOnNonPointer(t *testing.T) {
+	assert.Execution(t, func() {
+		HasErrorAs(nil)
+	}).WillPanicWith("^target must be a non-nil pointer$")
+}
+
+func Test_HasLogger_usingRecordedLogger(t *testing.T) {
+	provider := NewProvider()
+	provider.GetLogger("foo").Info("bar")
+
+	actual := provider.GetAll()
+
+	assert.ToBeEqual(t, 1, len(actual))
+	assert.ToBeEqual(t, true, HasLogger("foo").Matches(actual[0], provider))
+	assert.ToBeEqual(t, false, HasLogger(RootLoggerName).Matches(actual[0], provider))
+}
+
+func Test_HasLevel_usesLevelNamesOfProvider(t *testing.T) {
+	provider := &providerWithLevelNames{NewProvider()}
+	givenEvent := provider.GetRootLogger().NewEvent(level.Warn, nil)
+
+	actual := HasLevel(level.Info).DescribeMismatch(givenEvent, provider)
+
+	assert.ToBeEqual(t, "level: expected level-3000; but got level-4000", actual)
+}
+
+type providerWithLevelNames struct {
+	*Provider
+}
+
+func (instance *providerWithLevelNames) GetLevelNames() level.Names {
+	return &prefixedLevelNames{}
+}
+
+type prefixedLevelNames struct{}
+
+func (instance *prefixedLevelNames) ToName(l level.Level) (string, error) {
+	return fmt.Sprintf("level-%d", l), nil
+}
+
+func (instance *prefixedLevelNames) ToLevel(string) (level.Level, error) {
+	return 0, level.ErrIllegalLevel
+}
@@ -1,12 +1,15 @@
 package recording
 
 import (
+	"sort"
 	"sync/atomic"
+	"testing"
 	"unsafe"
 
 	log "github.com/echocat/slf4g"
 	"github.com/echocat/slf4g/fields"
 	"github.com/echocat/slf4g/level"
+	"github.com/echocat/slf4g/testing/routing"
 )
 
 // DefaultProviderName specifies the default name an instance of Provider which
@@ -62,6 +65,18 @@ func (instance *Provider) HookGlobally() func() {
 	}
 }
 
+// HookIsolated is like HookGlobally but only everything which is logged
+// within the goroutine of the given *testing.T, *testing.B or *testing.F (and
+// all goroutines created by it) will be recorded by this Provider. This
+// allows to use an own instance of Provider for each test which are running in
+// parallel (see testing.T#Parallel()).
+//
+// Everything will be reset automatically at the end of the related test run
+// (see testing.TB#Cleanup). See routing.Hook() for more details.
+func (instance *Provider) HookIsolated(tb testing.TB) {
+	routing.Hook(tb, instance)
+}
+
 // Contains checks if the given log.Event was recorded by at least of one
 // CoreLogger of this Provider. It will use the
 // fields.DefaultEntryEqualityFunction to checks the equality but will ignore
@@ -166,6 +181,17 @@ func (instance *Provider) Reset(name string) {
 	instance.getLogger(name).Reset()
 }
 
+func (instance *Provider) getAllSequenced() []sequencedEvent {
+	result := instance.getRootLogger().getAllSequenced()
+	for _, name := range instance.getCache().GetNames() {
+		result = append(result, instance.getLogger(name).getAllSequenced()...)
+	}
+	sort.SliceStable(result, func(i, j int) bool {
+		return result[i].sequence < result[j].sequence
+	})
+	return result
+}
+
 func (instance *Provider) getRootLogger() *Logger {
 	return instance.GetRootLogger().(*Logger)
 }
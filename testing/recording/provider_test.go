@@ -2,6 +2,7 @@ package recording
 
 import (
 	"errors"
+	"fmt"
 	"testing"
 
 	"github.com/echocat/slf4g/fields"
@@ -44,6 +45,31 @@ func Test_Provider_HookGlobally(t *testing.T) {
 	assert.ToBeEqual(t, true, log.IsFallbackProvider(log.GetProvider()))
 }
 
+func Test_Provider_HookIsolated(t *testing.T) {
+	before := log.UnwrapProvider(log.GetProvider())
+	instances := make([]*Provider, 3)
+
+	t.Run("parallel", func(t *testing.T) {
+		for i := range instances {
+			i := i
+			t.Run(fmt.Sprint(i), func(t *testing.T) {
+				t.Parallel()
+
+				instances[i] = NewProvider()
+				instances[i].HookIsolated(t)
+
+				log.Infof("%d", i)
+			})
+		}
+	})
+
+	for i, instance := range instances {
+		instance.Expect(t, HasMessage(fmt.Sprint(i))).ToBeLoggedTimes(1)
+		assert.ToBeEqual(t, 1, instance.Len())
+	}
+	assert.ToBeSame(t, before, log.UnwrapProvider(log.GetProvider()))
+}
+
 func Test_Provider_MustContains(t *testing.T) {
 	instance := NewProvider()
 	instanceRootLogger := instance.GetRootLogger()
@@ -0,0 +1,25 @@
+// Package routing provides a log.Provider which dispatches everything to the
+// log.Provider which is routed for the current goroutine.
+//
+// The global log.Provider of slf4g (see log.SetProvider()) exists exactly once
+// per process. This is a problem for tests which are running in parallel (see
+// testing.T#Parallel()) and are all replacing this global instance. With this
+// package each test could use its own instance:
+//
+//	func TestMyGreatStuff(t *testing.T) {
+//		t.Parallel()
+//
+//		provider := recording.NewProvider()
+//		routing.Hook(t, provider)
+//
+//		log.Info("This will be only recorded by the provider of this test.")
+//	}
+//
+// Everything which is logged by goroutines created directly by the test's
+// goroutine is also routed to the same log.Provider. For goroutines which are
+// nested deeper use Go(..). See Hook(..) and Provider for more details.
+//
+// Usually you do not need to use this package directly, see
+// github.com/echocat/slf4g/testing/recording.Provider#HookIsolated() and
+// github.com/echocat/slf4g/sdk/testlog.HookIsolated().
+package routing
@@ -0,0 +1,72 @@
+package routing
+
+import (
+	"bytes"
+	"runtime"
+	"strconv"
+)
+
+var (
+	goroutinePrefix = []byte("goroutine ")
+	parentMarker    = []byte(" in goroutine ")
+)
+
+// currentGoroutine returns the id of the current goroutine and (if available)
+// the id of the goroutine which created it. There is no official API for that,
+// so it is extracted from the stack trace of the current goroutine. The
+// parent is only available since Go 1.21; with older versions it is always 0.
+func currentGoroutine() (id, parent uint64) {
+	stack := currentStack()
+	return parseGoroutineId(stack), parseParentGoroutineId(stack)
+}
+
+// currentGoroutineId returns only the id of the current goroutine. This is
+// cheaper than currentGoroutine(), because only the first line of the stack
+// trace is required.
+func currentGoroutineId() uint64 {
+	var buf [64]byte
+	n := runtime.Stack(buf[:], false)
+	return parseGoroutineId(buf[:n])
+}
+
+// currentParentGoroutineId returns the id of the goroutine which created the
+// current one. See currentGoroutine() for more details.
+func currentParentGoroutineId() uint64 {
+	return parseParentGoroutineId(currentStack())
+}
+
+func currentStack() []byte {
+	buf := make([]byte, 4096)
+	for {
+		n := runtime.Stack(buf, false)
+		if n < len(buf) {
+			return buf[:n]
+		}
+		buf = make([]byte, len(buf)*2)
+	}
+}
+
+func parseGoroutineId(stack []byte) uint64 {
+	if !bytes.HasPrefix(stack, goroutinePrefix) {
+		return 0
+	}
+	stack = stack[len(goroutinePrefix):]
+	if i := bytes.IndexByte(stack, ' '); i > 0 {
+		stack = stack[:i]
+	}
+	result, _ := strconv.ParseUint(string(stack), 10, 64)
+	return result
+}
+
+func parseParentGoroutineId(stack []byte) uint64 {
+	i := bytes.LastIndex(stack, parentMarker)
+	if i < 0 {
+		return 0
+	}
+	stack = stack[i+len(parentMarker):]
+	if i := bytes.IndexByte(stack, '\n'); i > 0 {
+		stack = stack[:i]
+	}
+	result, _ := strconv.ParseUint(string(bytes.TrimSpace(stack)), 10, 64)
+	return result
+}
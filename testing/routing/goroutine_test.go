@@ -0,0 +1,58 @@
+package routing
+
+import (
+	"testing"
+
+	"github.com/echocat/slf4g/internal/test/assert"
+)
+
+func Test_currentGoroutine(t *testing.T) {
+	id, _ := currentGoroutine()
+
+	var childId, childParent uint64
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		childId, childParent = currentGoroutine()
+	}()
+	<-done
+
+	assert.ToBeNotEqual(t, uint64(0), id)
+	assert.ToBeNotEqual(t, id, childId)
+	assert.ToBeEqual(t, id, childParent)
+}
+
+func Test_parseGoroutineId(t *testing.T) {
+	cases := []struct {
+		given    string
+		expected uint64
+	}{
+		{"goroutine 123 [running]:\nmain.main()", 123},
+		{"goroutine 1 [running]:", 1},
+		{"something else", 0},
+		{"goroutine abc [running]:", 0},
+	}
+
+	for _, c := range cases {
+		t.Run(c.given, func(t *testing.T) {
+			assert.ToBeEqual(t, c.expected, parseGoroutineId([]byte(c.given)))
+		})
+	}
+}
+
+func Test_parseParentGoroutineId(t *testing.T) {
+	cases := []struct {
+		given    string
+		expected uint64
+	}{
+		{"goroutine 7 [running]:\nfoo()\n\t/foo.go:1 +0x1\ncreated by testing.(*T).Run in goroutine 6\n\t/testing.go:1 +0x1\n", 6},
+		{"goroutine 1 [running]:\nmain.main()\n\t/main.go:1 +0x1\n", 0},
+		{"goroutine 7 [running]:\ncreated by foo.bar\n\t/foo.go:1 +0x1\n", 0},
+	}
+
+	for _, c := range cases {
+		t.Run("", func(t *testing.T) {
+			assert.ToBeEqual(t, c.expected, parseParentGoroutineId([]byte(c.given)))
+		})
+	}
+}
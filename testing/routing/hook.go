@@ -0,0 +1,76 @@
+package routing
+
+import (
+	"sync"
+	"testing"
+
+	log "github.com/echocat/slf4g"
+)
+
+var (
+	global         *Provider
+	globalPrevious log.Provider
+	globalUsages   int
+	globalMutex    sync.Mutex
+)
+
+// Hook routes everything which is logged within the current goroutine of the
+// given *testing.T, *testing.B or *testing.F - and the goroutines created by
+// it (see Provider and Go()) - to the given target.
+//
+// On the first usage a Provider is registered as global provider (see
+// log.SetProvider()). All goroutines without a route will use the provider
+// which was the global one before. The route will be automatically removed at
+// the end of the related test run (see testing.TB#Cleanup) and as soon as the
+// last route is removed the previous global provider will be restored.
+//
+// In contrast to log.SetProvider() this can be safely used in tests which are
+// running in parallel (see testing.T#Parallel()). Hook has to be called from
+// the goroutine of the test itself.
+func Hook(tb testing.TB, target log.Provider) {
+	unroute := acquireGlobal().Route(target)
+
+	tb.Cleanup(func() {
+		unroute()
+		releaseGlobal()
+	})
+}
+
+// Go starts the given function in a new goroutine which uses the same route as
+// the current goroutine (see Provider.Go()). If nothing is hooked (see Hook())
+// it simply starts the function as a plain goroutine.
+func Go(f func()) {
+	globalMutex.Lock()
+	provider := global
+	globalMutex.Unlock()
+
+	if provider == nil {
+		go f()
+		return
+	}
+	provider.Go(f)
+}
+
+func acquireGlobal() *Provider {
+	globalMutex.Lock()
+	defer globalMutex.Unlock()
+
+	if globalUsages == 0 {
+		global = NewProvider(log.UnwrapProvider(log.GetProvider()))
+		globalPrevious = log.SetProvider(global)
+	}
+	globalUsages++
+
+	return global
+}
+
+func releaseGlobal() {
+	globalMutex.Lock()
+	defer globalMutex.Unlock()
+
+	globalUsages--
+	if globalUsages == 0 {
+		log.SetProvider(globalPrevious)
+		global, globalPrevious = nil, nil
+	}
+}
@@ -0,0 +1,72 @@
+package routing_test
+
+import (
+	"fmt"
+	"testing"
+
+	log "github.com/echocat/slf4g"
+	"github.com/echocat/slf4g/testing/recording"
+	. "github.com/echocat/slf4g/testing/routing"
+
+	"github.com/echocat/slf4g/internal/test/assert"
+)
+
+func Test_Hook(t *testing.T) {
+	before := log.UnwrapProvider(log.GetProvider())
+	providers := make([]*recording.Provider, 5)
+
+	t.Run("parallel", func(t *testing.T) {
+		for i := range providers {
+			i := i
+			t.Run(fmt.Sprint(i), func(t *testing.T) {
+				t.Parallel()
+
+				providers[i] = recording.NewProvider()
+				Hook(t, providers[i])
+
+				logger := log.GetLogger("foo")
+				for j := 0; j <= i; j++ {
+					log.Infof("%d-%d", i, j)
+					logger.Info(i)
+				}
+
+				done := make(chan struct{})
+				go func() {
+					defer close(done)
+					log.Info("from goroutine")
+				}()
+				<-done
+			})
+		}
+	})
+
+	for i, p := range providers {
+		assert.ToBeEqual(t, i+2, len(p.GetAllRoot()))
+		assert.ToBeEqual(t, i+1, len(p.GetAllOf("foo")))
+	}
+	assert.ToBeSame(t, before, log.UnwrapProvider(log.GetProvider()))
+}
+
+func Test_Go(t *testing.T) {
+	provider := recording.NewProvider()
+	Hook(t, provider)
+
+	done := make(chan struct{})
+	go func() {
+		Go(func() {
+			defer close(done)
+			log.Info("from grandchild")
+		})
+	}()
+	<-done
+
+	assert.ToBeEqual(t, 1, len(provider.GetAllRoot()))
+}
+
+func Test_Go_withoutHook(t *testing.T) {
+	done := make(chan struct{})
+	Go(func() {
+		close(done)
+	})
+	<-done
+}
@@ -0,0 +1,218 @@
+package routing
+
+import (
+	"sync"
+
+	log "github.com/echocat/slf4g"
+	"github.com/echocat/slf4g/fields"
+	"github.com/echocat/slf4g/level"
+)
+
+// DefaultProviderName specifies the default name an instance of Provider which
+// will be used if no other name was defined.
+const DefaultProviderName = "routing"
+
+// NewProvider creates a new instance of Provider which will use the given
+// fallback for every goroutine without a route.
+func NewProvider(fallback log.Provider, customizer ...func(*Provider)) *Provider {
+	result := &Provider{
+		Fallback: fallback,
+	}
+
+	for _, c := range customizer {
+		c(result)
+	}
+
+	return result
+}
+
+// Provider is an implementation of log.Provider which dispatches everything to
+// the log.Provider which was routed (see Route()) for the current goroutine.
+//
+// Goroutines which were created directly by a routed goroutine will use the
+// same route. Goroutines further down (like grandchildren) only inherit the
+// route if their parent has resolved it before creating them (for example by
+// logging something). To be independent of this, create goroutines with Go().
+//
+// This allows to use different instances of log.Provider for tests which are
+// running in parallel (see testing.T#Parallel()), although there is only one
+// global log.Provider (see log.SetProvider()).
+type Provider struct {
+	// Fallback is used for every goroutine without a route. If nil
+	// log.GetProvider() will be used; which is not possible if this Provider
+	// itself is the global one.
+	Fallback log.Provider
+
+	// Name specifies the name of this Provider. If empty this Provider will
+	// use DefaultProviderName.
+	Name string
+
+	routes map[uint64]*route
+	mutex  sync.RWMutex
+}
+
+type route struct {
+	target log.Provider
+
+	// goroutines contains the ids of all goroutines this route is registered
+	// for; including the inherited ones.
+	goroutines map[uint64]struct{}
+}
+
+// Route routes everything which is logged within the current goroutine - and
+// the goroutines created by it (see Provider) - to the given target. It
+// returns a function which removes this route again; including all the
+// inherited ones.
+func (instance *Provider) Route(target log.Provider) (unroute func()) {
+	id := currentGoroutineId()
+	r := &route{target, map[uint64]struct{}{}}
+
+	instance.mutex.Lock()
+	defer instance.mutex.Unlock()
+
+	previous := instance.routes[id]
+	instance.register(id, r)
+
+	return func() {
+		instance.mutex.Lock()
+		defer instance.mutex.Unlock()
+
+		for candidate := range r.goroutines {
+			if instance.routes[candidate] == r {
+				delete(instance.routes, candidate)
+			}
+		}
+		if previous != nil {
+			instance.routes[id] = previous
+		}
+	}
+}
+
+// Go starts the given function in a new goroutine which uses the route of the
+// current goroutine; regardless how deep it is nested. The route is removed
+// from the new goroutine as soon as the function returns.
+func (instance *Provider) Go(f func()) {
+	r := instance.lookup()
+	go func() {
+		if r != nil {
+			id := currentGoroutineId()
+			instance.mutex.Lock()
+			instance.register(id, r)
+			instance.mutex.Unlock()
+
+			defer func() {
+				instance.mutex.Lock()
+				defer instance.mutex.Unlock()
+				if instance.routes[id] == r {
+					delete(instance.routes, id)
+				}
+				delete(r.goroutines, id)
+			}()
+		}
+		f()
+	}()
+}
+
+// Resolve returns the log.Provider which is responsible for the current
+// goroutine.
+func (instance *Provider) Resolve() log.Provider {
+	if r := instance.lookup(); r != nil {
+		return r.target
+	}
+	return instance.getFallback()
+}
+
+// lookup returns the route of the current goroutine; either the direct one or
+// the one of its parent goroutine. It returns nil if there is none.
+func (instance *Provider) lookup() *route {
+	instance.mutex.RLock()
+	if len(instance.routes) == 0 {
+		// Nothing is routed; so we do not need to inspect the goroutine.
+		instance.mutex.RUnlock()
+		return nil
+	}
+	instance.mutex.RUnlock()
+
+	id := currentGoroutineId()
+	instance.mutex.RLock()
+	r, direct := instance.routes[id]
+	instance.mutex.RUnlock()
+	if direct {
+		return r
+	}
+
+	parent := currentParentGoroutineId()
+	if parent == 0 {
+		return nil
+	}
+
+	instance.mutex.Lock()
+	defer instance.mutex.Unlock()
+	r, inherited := instance.routes[parent]
+	if !inherited {
+		return nil
+	}
+	// Remember the route of the parent also for this goroutine; to make it
+	// available for goroutines which are created by this one.
+	if _, exists := instance.routes[id]; !exists {
+		instance.register(id, r)
+	}
+	return r
+}
+
+func (instance *Provider) register(id uint64, r *route) {
+	if instance.routes == nil {
+		instance.routes = map[uint64]*route{}
+	}
+	instance.routes[id] = r
+	r.goroutines[id] = struct{}{}
+}
+
+// Unwrap returns the log.Provider which is responsible for the current
+// goroutine. See Resolve().
+func (instance *Provider) Unwrap() log.Provider {
+	return instance.Resolve()
+}
+
+// GetName implements log.Provider#GetName()
+func (instance *Provider) GetName() string {
+	if v := instance.Name; v != "" {
+		return v
+	}
+	return DefaultProviderName
+}
+
+// GetRootLogger implements log.Provider#GetRootLogger()
+func (instance *Provider) GetRootLogger() log.Logger {
+	return log.NewLoggerFacade(func() log.CoreLogger {
+		return instance.Resolve().GetRootLogger()
+	})
+}
+
+// GetLogger implements log.Provider#GetLogger()
+func (instance *Provider) GetLogger(name string) log.Logger {
+	return log.NewLoggerFacade(func() log.CoreLogger {
+		return instance.Resolve().GetLogger(name)
+	})
+}
+
+// GetAllLevels implements log.Provider#GetAllLevels()
+func (instance *Provider) GetAllLevels() level.Levels {
+	return instance.Resolve().GetAllLevels()
+}
+
+// GetFieldKeysSpec implements log.Provider#GetFieldKeysSpec()
+func (instance *Provider) GetFieldKeysSpec() fields.KeysSpec {
+	return instance.Resolve().GetFieldKeysSpec()
+}
+
+func (instance *Provider) getFallback() log.Provider {
+	if v := instance.Fallback; v != nil {
+		return v
+	}
+	result := log.GetProvider()
+	if log.UnwrapProvider(result) == log.Provider(instance) {
+		panic("the routing provider is registered globally but has no fallback")
+	}
+	return result
+}
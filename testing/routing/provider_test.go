@@ -0,0 +1,189 @@
+package routing_test
+
+import (
+	"testing"
+
+	log "github.com/echocat/slf4g"
+	"github.com/echocat/slf4g/fields"
+	"github.com/echocat/slf4g/testing/recording"
+	. "github.com/echocat/slf4g/testing/routing"
+
+	"github.com/echocat/slf4g/internal/test/assert"
+)
+
+func Test_NewProvider(t *testing.T) {
+	givenFallback := recording.NewProvider()
+
+	actual := NewProvider(givenFallback, func(p *Provider) {
+		p.Name = "foo"
+	})
+
+	assert.ToBeSame(t, givenFallback, actual.Fallback)
+	assert.ToBeEqual(t, "foo", actual.GetName())
+}
+
+func Test_Provider_GetName(t *testing.T) {
+	assert.ToBeEqual(t, DefaultProviderName, (&Provider{}).GetName())
+	assert.ToBeEqual(t, "foo", (&Provider{Name: "foo"}).GetName())
+}
+
+func Test_Provider_Route(t *testing.T) {
+	givenFallback := recording.NewProvider()
+	givenTarget1 := recording.NewProvider()
+	givenTarget2 := recording.NewProvider()
+	instance := NewProvider(givenFallback)
+
+	assert.ToBeSame(t, givenFallback, instance.Resolve())
+
+	unroute1 := instance.Route(givenTarget1)
+	assert.ToBeSame(t, givenTarget1, instance.Resolve())
+
+	unroute2 := instance.Route(givenTarget2)
+	assert.ToBeSame(t, givenTarget2, instance.Resolve())
+	assert.ToBeSame(t, givenTarget2, instance.Unwrap())
+
+	unroute2()
+	assert.ToBeSame(t, givenTarget1, instance.Resolve())
+
+	unroute1()
+	assert.ToBeSame(t, givenFallback, instance.Resolve())
+}
+
+func Test_Provider_Resolve_inheritedByChildGoroutines(t *testing.T) {
+	givenFallback := recording.NewProvider()
+	givenTarget := recording.NewProvider()
+	instance := NewProvider(givenFallback)
+
+	unroute := instance.Route(givenTarget)
+
+	var actualChild, actualGrandChild log.Provider
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		actualChild = instance.Resolve()
+
+		grandChildDone := make(chan struct{})
+		go func() {
+			defer close(grandChildDone)
+			actualGrandChild = instance.Resolve()
+		}()
+		<-grandChildDone
+	}()
+	<-done
+
+	assert.ToBeSame(t, givenTarget, actualChild)
+	assert.ToBeSame(t, givenTarget, actualGrandChild)
+
+	unroute()
+	assert.ToBeSame(t, givenFallback, instance.Resolve())
+}
+
+func Test_Provider_Resolve_inheritedRouteIsRemovedByUnroute(t *testing.T) {
+	givenFallback := recording.NewProvider()
+	givenTarget := recording.NewProvider()
+	instance := NewProvider(givenFallback)
+
+	unroute := instance.Route(givenTarget)
+
+	var actualBefore, actualAfter log.Provider
+	resolved, unrouted, done := make(chan struct{}), make(chan struct{}), make(chan struct{})
+	go func() {
+		defer close(done)
+		actualBefore = instance.Resolve()
+		close(resolved)
+		<-unrouted
+		actualAfter = instance.Resolve()
+	}()
+	<-resolved
+	unroute()
+	close(unrouted)
+	<-done
+
+	assert.ToBeSame(t, givenTarget, actualBefore)
+	assert.ToBeSame(t, givenFallback, actualAfter)
+}
+
+func Test_Provider_Go(t *testing.T) {
+	givenFallback := recording.NewProvider()
+	givenTarget := recording.NewProvider()
+	instance := NewProvider(givenFallback)
+
+	defer instance.Route(givenTarget)()
+
+	var actualGrandChild, actualGreatGrandChild log.Provider
+	done := make(chan struct{})
+	go func() {
+		// This goroutine does never resolve anything before it creates its
+		// children.
+		instance.Go(func() {
+			instance.Go(func() {
+				defer close(done)
+				actualGreatGrandChild = instance.Resolve()
+			})
+			actualGrandChild = instance.Resolve()
+		})
+	}()
+	<-done
+
+	assert.ToBeSame(t, givenTarget, actualGreatGrandChild)
+	assert.ToBeSame(t, givenTarget, actualGrandChild)
+}
+
+func Test_Provider_Go_withoutRoute(t *testing.T) {
+	givenFallback := recording.NewProvider()
+	instance := NewProvider(givenFallback)
+
+	var actual log.Provider
+	done := make(chan struct{})
+	instance.Go(func() {
+		defer close(done)
+		actual = instance.Resolve()
+	})
+	<-done
+
+	assert.ToBeSame(t, givenFallback, actual)
+}
+
+func Test_Provider_Resolve_notRoutedGoroutine(t *testing.T) {
+	givenFallback := recording.NewProvider()
+	instance := NewProvider(givenFallback)
+
+	var actual log.Provider
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		actual = instance.Resolve()
+	}()
+	<-done
+
+	assert.ToBeSame(t, givenFallback, actual)
+}
+
+func Test_Provider_loggers(t *testing.T) {
+	givenFallback := recording.NewProvider()
+	givenTarget := recording.NewProvider()
+	givenTarget.FieldKeysSpec = &fields.KeysSpecImpl{}
+	instance := NewProvider(givenFallback)
+	rootLogger := instance.GetRootLogger()
+	fooLogger := instance.GetLogger("foo")
+
+	rootLogger.Info("a")
+	defer instance.Route(givenTarget)()
+	rootLogger.Info("b")
+	fooLogger.Info("c")
+
+	assert.ToBeEqual(t, 1, givenFallback.Len())
+	assert.ToBeEqual(t, 2, givenTarget.Len())
+	assert.ToBeEqual(t, 1, len(givenTarget.GetAllOf("foo")))
+	assert.ToBeSame(t, givenTarget.GetFieldKeysSpec(), instance.GetFieldKeysSpec())
+	assert.ToBeEqual(t, givenTarget.GetAllLevels(), instance.GetAllLevels())
+}
+
+func Test_Provider_getFallback_failsIfGlobalWithoutFallback(t *testing.T) {
+	instance := NewProvider(nil)
+	defer log.SetProvider(log.SetProvider(instance))
+
+	assert.Execution(t, func() {
+		instance.Resolve()
+	}).WillPanicWith("^the routing provider is registered globally but has no fallback$")
+}